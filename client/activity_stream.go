@@ -0,0 +1,207 @@
+package awx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Enum of the activity stream operations.
+const (
+	ActivityStreamOperationCreate       = "create"
+	ActivityStreamOperationUpdate       = "update"
+	ActivityStreamOperationDelete       = "delete"
+	ActivityStreamOperationAssociate    = "associate"
+	ActivityStreamOperationDisassociate = "disassociate"
+)
+
+// ActivityStreamService implements awx activity stream apis.
+type ActivityStreamService struct {
+	client *Client
+}
+
+// ListActivityStreamResponse represents `ListActivityStream` endpoint response.
+type ListActivityStreamResponse struct {
+	Pagination
+	Results []*ActivityStream `json:"results"`
+}
+
+const activityStreamAPIEndpoint = "/api/v2/activity_stream/"
+
+// ListActivityStream shows list of awx activity stream entries, see
+// `ActivityStreamFilter` to build the params.
+func (a *ActivityStreamService) ListActivityStream(params url.Values) ([]*ActivityStream, *ListActivityStreamResponse, error) {
+	return a.list(activityStreamAPIEndpoint, params)
+}
+
+// GetActivityStreamByID shows the details of an activity stream entry.
+func (a *ActivityStreamService) GetActivityStreamByID(id int, params url.Values) (*ActivityStream, error) {
+	result := new(ActivityStream)
+	endpoint := fmt.Sprintf("%s%d/", activityStreamAPIEndpoint, id)
+	resp, err := a.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListRelatedActivityStream shows the activity stream of a resource, following
+// its `related.activity_stream` link, e.g. `jobTemplate.Related`.
+func (a *ActivityStreamService) ListRelatedActivityStream(related *Related, params url.Values) ([]*ActivityStream, *ListActivityStreamResponse, error) {
+	if related == nil || related.ActivityStream == "" {
+		return nil, nil, errors.New("resource has no related activity stream")
+	}
+	return a.list(related.ActivityStream, params)
+}
+
+func (a *ActivityStreamService) list(endpoint string, params url.Values) ([]*ActivityStream, *ListActivityStreamResponse, error) {
+	result := new(ListActivityStreamResponse)
+	resp, err := a.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// ActivityStreamFilter filters the activity stream, its zero fields are ignored.
+type ActivityStreamFilter struct {
+	// Actor is the username of the user who made the changes.
+	Actor string
+	// Operation is one of the `ActivityStreamOperation*` operations.
+	Operation string
+	// ObjectType is a resource type, e.g. "job_template", matching either
+	// object of the entries.
+	ObjectType string
+	// ObjectID restricts the entries to one resource of ObjectType.
+	ObjectID int
+	// Since and Until bound the entries timestamp, Until excluded.
+	Since time.Time
+	Until time.Time
+}
+
+// Query returns the filter as a query, which params can be passed to
+// `ListActivityStream` and `ListRelatedActivityStream`.
+func (f ActivityStreamFilter) Query() *Query {
+	query := NewQuery()
+	if f.Actor != "" {
+		query.Filter(Field("actor", "username"), Exact, f.Actor)
+	}
+	if f.Operation != "" {
+		query.Filter("operation", Exact, f.Operation)
+	}
+	if f.ObjectType != "" {
+		query.Or("object1", Exact, f.ObjectType).Or("object2", Exact, f.ObjectType)
+		if f.ObjectID > 0 {
+			query.Filter(Field(f.ObjectType, "id"), Exact, f.ObjectID)
+		}
+	}
+	if !f.Since.IsZero() {
+		query.Filter("timestamp", GTE, f.Since)
+	}
+	if !f.Until.IsZero() {
+		query.Filter("timestamp", LT, f.Until)
+	}
+	return query
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it decodes an api entry: the
+// changes of an update are `[old, new]` pairs, the ones of the other
+// operations are the new, or deleted, values. The actor and the objects
+// are read from the summary fields.
+func (a *ActivityStream) UnmarshalJSON(data []byte) error {
+	type activityStream ActivityStream
+	entry := struct {
+		*activityStream
+		Changes       map[string]json.RawMessage `json:"changes"`
+		SummaryFields map[string]json.RawMessage `json:"summary_fields"`
+	}{activityStream: (*activityStream)(a)}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return err
+	}
+
+	a.Changes = make(map[string]ActivityStreamChange, len(entry.Changes))
+	for field, raw := range entry.Changes {
+		change, err := decodeActivityStreamChange(a.Operation, raw)
+		if err != nil {
+			return fmt.Errorf("activity stream %d change %s: %w", a.ID, field, err)
+		}
+		a.Changes[field] = change
+	}
+
+	a.Actor = nil
+	if raw, ok := entry.SummaryFields["actor"]; ok {
+		if err := json.Unmarshal(raw, &a.Actor); err != nil {
+			return err
+		}
+	}
+	var err error
+	if a.Objects1, err = decodeActivityStreamObjects(entry.SummaryFields[a.Object1]); err != nil {
+		return err
+	}
+	if a.Objects2, err = decodeActivityStreamObjects(entry.SummaryFields[a.Object2]); err != nil {
+		return err
+	}
+	return nil
+}
+
+func decodeActivityStreamChange(operation string, raw json.RawMessage) (ActivityStreamChange, error) {
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return ActivityStreamChange{}, err
+	}
+
+	switch operation {
+	case ActivityStreamOperationUpdate:
+		if pair, ok := value.([]interface{}); ok && len(pair) == 2 {
+			return ActivityStreamChange{Old: pair[0], New: pair[1]}, nil
+		}
+	case ActivityStreamOperationDelete:
+		return ActivityStreamChange{Old: value}, nil
+	}
+	return ActivityStreamChange{New: value}, nil
+}
+
+func decodeActivityStreamObjects(raw json.RawMessage) ([]*ActivityStreamObject, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, err
+	}
+
+	objects := make([]*ActivityStreamObject, 0, len(items))
+	for _, item := range items {
+		var summary struct {
+			ID       int    `json:"id"`
+			Name     string `json:"name"`
+			Username string `json:"username"`
+		}
+		object := new(ActivityStreamObject)
+		if err := json.Unmarshal(item, &summary); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(item, &object.Fields); err != nil {
+			return nil, err
+		}
+		object.ID = summary.ID
+		object.Name = summary.Name
+		if object.Name == "" {
+			object.Name = summary.Username
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
@@ -0,0 +1,388 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ActivityStreamFormat is the output format of an ActivityStreamExporter.
+type ActivityStreamFormat int
+
+// Enum of the activity stream export formats.
+const (
+	// ActivityStreamNDJSON writes each entry as a json line.
+	ActivityStreamNDJSON ActivityStreamFormat = iota
+	// ActivityStreamCEF writes each entry as an ArcSight CEF line.
+	ActivityStreamCEF
+)
+
+const (
+	defaultActivityStreamExportInterval = 30 * time.Second
+	defaultActivityStreamExportPageSize = 200
+)
+
+// ActivityStreamExporter tails the activity stream, polling the entries newer
+// than the last exported one, and writes them to Writer. Each page of entries
+// is written, the Writer flushed and synced when it supports it, then the ID
+// of the last entry is saved into the checkpoint file, a restarted exporter
+// resumes after it. An exporter is not safe for concurrent use.
+//
+// When the Writer is a file, the checkpoint also holds its size after the
+// page, a restarted exporter truncates the file back to it: the lines of a
+// page written before a crash or a failed save are removed and written once
+// again, the restarts neither duplicate nor drop entries. A Writer which
+// can't be truncated, e.g. a network connection, can't take back a page
+// written before a crash and receives it again.
+//
+// The entries are polled with an `id__gt` cursor: an entry whose transaction
+// commits after an entry with a higher ID was exported is never exported, the
+// cursor is already past it.
+type ActivityStreamExporter struct {
+	// Stream lists the activity stream, e.g. the `ActivityStreamService` of an `*AWX`.
+	Stream ActivityStreamAPI
+	// Writer receives one line per entry.
+	Writer io.Writer
+	Format ActivityStreamFormat
+	// CheckpointPath is the checkpoint file, the checkpoint is kept in memory only when empty.
+	CheckpointPath string
+	// Filter restricts the exported entries, e.g. its Since bounds the first export.
+	Filter ActivityStreamFilter
+	// Interval is the delay between two polls, 30s by default.
+	Interval time.Duration
+	// PageSize is the number of entries listed per request, 200 by default.
+	PageSize int
+	// DeviceVersion is the AWX version reported in the CEF header.
+	DeviceVersion string
+	// ErrorHandler receives the errors of the polls, Run keeps polling when it is
+	// set and returns the first error otherwise.
+	ErrorHandler func(error)
+
+	lastID int
+	loaded bool
+}
+
+// truncater is implemented by the writers an exporter rolls back to their
+// checkpointed size, e.g. `*os.File`.
+type truncater interface {
+	io.Seeker
+	Truncate(size int64) error
+}
+
+// Run exports the entries until the context is done.
+func (e *ActivityStreamExporter) Run(ctx context.Context) error {
+	interval := e.Interval
+	if interval <= 0 {
+		interval = defaultActivityStreamExportInterval
+	}
+
+	for {
+		if _, err := e.Export(ctx); err != nil {
+			if ctx.Err() != nil || e.ErrorHandler == nil {
+				return err
+			}
+			e.ErrorHandler(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Export writes the entries newer than the checkpoint once, and returns the number of written entries.
+func (e *ActivityStreamExporter) Export(ctx context.Context) (int, error) {
+	if err := e.loadCheckpoint(); err != nil {
+		return 0, err
+	}
+	pageSize := e.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultActivityStreamExportPageSize
+	}
+
+	written := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return written, err
+		}
+
+		query := e.Filter.Query().Filter("id", GT, e.lastID).OrderBy("id").PageSize(pageSize)
+		entries, resp, err := e.Stream.ListActivityStream(query.Values())
+		if err != nil {
+			return written, err
+		}
+		n, err := e.writePage(entries)
+		if err != nil {
+			return written, err
+		}
+		written += n
+		if len(entries) == 0 || resp == nil || resp.Next == nil {
+			return written, nil
+		}
+	}
+}
+
+// writePage writes the entries newer than the checkpoint, flushes and syncs
+// the Writer, then saves the checkpoint once.
+func (e *ActivityStreamExporter) writePage(entries []*ActivityStream) (int, error) {
+	var page []byte
+	lastID, n := e.lastID, 0
+	for _, entry := range entries {
+		if entry.ID <= lastID {
+			continue
+		}
+		line, err := e.format(entry)
+		if err != nil {
+			return 0, err
+		}
+		page = append(page, line...)
+		lastID = entry.ID
+		n++
+	}
+	if n == 0 {
+		return 0, nil
+	}
+
+	if _, err := e.Writer.Write(page); err != nil {
+		return 0, err
+	}
+	if flusher, ok := e.Writer.(interface{ Flush() error }); ok {
+		if err := flusher.Flush(); err != nil {
+			return 0, err
+		}
+	}
+	if syncer, ok := e.Writer.(interface{ Sync() error }); ok {
+		// the pipes and terminals can't be synced, their writes are not buffered
+		if err := syncer.Sync(); err != nil && !errors.Is(err, syscall.EINVAL) && !errors.Is(err, syscall.ENOTSUP) {
+			return 0, err
+		}
+	}
+	if err := e.saveCheckpoint(lastID, e.writerOffset()); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// writerOffset returns the size of a file Writer, -1 for the other writers.
+func (e *ActivityStreamExporter) writerOffset() int64 {
+	file, ok := e.Writer.(truncater)
+	if !ok {
+		return -1
+	}
+	offset, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return -1
+	}
+	return offset
+}
+
+// Checkpoint returns the ID of the last exported entry.
+func (e *ActivityStreamExporter) Checkpoint() (int, error) {
+	if err := e.loadCheckpoint(); err != nil {
+		return 0, err
+	}
+	return e.lastID, nil
+}
+
+// format returns the line of an entry.
+func (e *ActivityStreamExporter) format(entry *ActivityStream) ([]byte, error) {
+	switch e.Format {
+	case ActivityStreamNDJSON:
+		content, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+		return append(content, '\n'), nil
+	case ActivityStreamCEF:
+		content, err := FormatActivityStreamCEF(entry, e.DeviceVersion)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(content + "\n"), nil
+	}
+	return nil, fmt.Errorf("unknown activity stream format %d", e.Format)
+}
+
+// loadCheckpoint reads the checkpoint, the ID of the last exported entry
+// followed by the size of a file Writer, and truncates the file to this size.
+func (e *ActivityStreamExporter) loadCheckpoint() error {
+	if e.loaded {
+		return nil
+	}
+	if e.CheckpointPath != "" {
+		content, err := os.ReadFile(e.CheckpointPath)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+		case err != nil:
+			return err
+		default:
+			fields := strings.Fields(string(content))
+			if len(fields) == 0 || len(fields) > 2 {
+				return fmt.Errorf("invalid activity stream checkpoint %s: %q", e.CheckpointPath, content)
+			}
+			id, err := strconv.Atoi(fields[0])
+			if err != nil {
+				return fmt.Errorf("invalid activity stream checkpoint %s: %w", e.CheckpointPath, err)
+			}
+			if len(fields) == 2 {
+				offset, err := strconv.ParseInt(fields[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid activity stream checkpoint %s: %w", e.CheckpointPath, err)
+				}
+				if err := e.rollback(offset); err != nil {
+					return err
+				}
+			}
+			e.lastID = id
+		}
+	}
+	e.loaded = true
+	return nil
+}
+
+// rollback removes the lines a file Writer received after its checkpointed
+// size. A smaller file, e.g. a rotated one, is left as is.
+func (e *ActivityStreamExporter) rollback(offset int64) error {
+	file, ok := e.Writer.(truncater)
+	if !ok {
+		return nil
+	}
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil || size <= offset {
+		return nil
+	}
+	if err := file.Truncate(offset); err != nil {
+		return fmt.Errorf("roll back the activity stream output to the checkpoint: %w", err)
+	}
+	_, err = file.Seek(offset, io.SeekStart)
+	return err
+}
+
+// saveCheckpoint replaces the checkpoint file through a rename, a crash never
+// leaves it partially written. The file is synced before the rename and its
+// directory after it, so the checkpoint survives a power loss. A negative
+// offset is not saved.
+func (e *ActivityStreamExporter) saveCheckpoint(id int, offset int64) error {
+	e.lastID = id
+	if e.CheckpointPath == "" {
+		return nil
+	}
+
+	content := strconv.Itoa(id)
+	if offset >= 0 {
+		content += " " + strconv.FormatInt(offset, 10)
+	}
+
+	tmp := e.CheckpointPath + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteString(content + "\n"); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, e.CheckpointPath); err != nil {
+		return err
+	}
+	return syncDir(filepath.Dir(e.CheckpointPath))
+}
+
+// syncDir flushes a directory entries, the directories can't be synced on Windows.
+func syncDir(path string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// FormatActivityStreamCEF formats an entry as an ArcSight CEF line, without
+// line break. Deletions have the severity 5, the other operations 3.
+func FormatActivityStreamCEF(entry *ActivityStream, deviceVersion string) (string, error) {
+	severity := 3
+	if entry.Operation == ActivityStreamOperationDelete {
+		severity = 5
+	}
+	name := strings.TrimSpace(fmt.Sprintf("%s %s %s", entry.Operation, entry.Object1, entry.Object2))
+	header := []string{
+		"CEF:0",
+		cefHeaderEscape("Ansible"),
+		cefHeaderEscape("AWX"),
+		cefHeaderEscape(deviceVersion),
+		cefHeaderEscape(entry.Operation),
+		cefHeaderEscape(name),
+		strconv.Itoa(severity),
+	}
+
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return "", err
+	}
+	extension := [][2]string{
+		{"rt", strconv.FormatInt(entry.Timestamp.UnixMilli(), 10)},
+		{"externalId", strconv.Itoa(entry.ID)},
+		{"act", entry.Operation},
+	}
+	if entry.Actor != nil {
+		extension = append(extension, [2]string{"suser", entry.Actor.Username}, [2]string{"suid", strconv.Itoa(entry.Actor.ID)})
+	}
+	if entry.ActionNode != "" {
+		extension = append(extension, [2]string{"dvchost", entry.ActionNode})
+	}
+	extension = append(extension,
+		[2]string{"cs1Label", "object1"}, [2]string{"cs1", cefObjects(entry.Object1, entry.Objects1)},
+		[2]string{"cs2Label", "object2"}, [2]string{"cs2", cefObjects(entry.Object2, entry.Objects2)},
+		[2]string{"msg", string(changes)},
+	)
+
+	pairs := make([]string, 0, len(extension))
+	for _, pair := range extension {
+		pairs = append(pairs, pair[0]+"="+cefExtensionEscape(pair[1]))
+	}
+	return strings.Join(header, "|") + "|" + strings.Join(pairs, " "), nil
+}
+
+// cefObjects formats the objects of an entry as `type:id:name` items separated by commas.
+func cefObjects(objectType string, objects []*ActivityStreamObject) string {
+	if len(objects) == 0 {
+		return objectType
+	}
+	items := make([]string, 0, len(objects))
+	for _, object := range objects {
+		items = append(items, fmt.Sprintf("%s:%d:%s", objectType, object.ID, object.Name))
+	}
+	return strings.Join(items, ",")
+}
+
+func cefHeaderEscape(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, "|", `\|`)
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
+
+func cefExtensionEscape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "=", `\=`, "\r\n", `\n`, "\n", `\n`, "\r", `\r`).Replace(value)
+}
@@ -0,0 +1,241 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeActivityStream serves entries by pages of two, honoring the `id__gt`,
+// `operation`, `actor__username` and `timestamp` filters. Any other filter fails
+// the request, a filter the fake doesn't apply would pass silently.
+type fakeActivityStream struct {
+	ActivityStreamAPI
+	entries []*ActivityStream
+}
+
+func (f *fakeActivityStream) ListActivityStream(params url.Values) ([]*ActivityStream, *ListActivityStreamResponse, error) {
+	for key := range params {
+		switch key {
+		case "id__gt", "operation", "actor__username", "timestamp__gte", "timestamp__lt", "order_by", "page_size":
+		default:
+			return nil, nil, fmt.Errorf("unsupported filter %s", key)
+		}
+	}
+	after, _ := strconv.Atoi(params.Get("id__gt"))
+	since, _ := time.Parse(time.RFC3339, params.Get("timestamp__gte"))
+	until, _ := time.Parse(time.RFC3339, params.Get("timestamp__lt"))
+	matches := func(entry *ActivityStream) bool {
+		switch {
+		case entry.ID <= after:
+			return false
+		case params.Has("operation") && entry.Operation != params.Get("operation"):
+			return false
+		case params.Has("actor__username") && (entry.Actor == nil || entry.Actor.Username != params.Get("actor__username")):
+			return false
+		case params.Has("timestamp__gte") && entry.Timestamp.Before(since):
+			return false
+		case params.Has("timestamp__lt") && !entry.Timestamp.Before(until):
+			return false
+		}
+		return true
+	}
+
+	result := new(ListActivityStreamResponse)
+	for _, entry := range f.entries {
+		if !matches(entry) {
+			continue
+		}
+		if len(result.Results) == 2 {
+			result.Next = "next"
+			break
+		}
+		result.Results = append(result.Results, entry)
+	}
+	return result.Results, result, nil
+}
+
+func TestActivityStreamExporter(t *testing.T) {
+	stream := &fakeActivityStream{}
+	for id := 1; id <= 3; id++ {
+		stream.entries = append(stream.entries, &ActivityStream{
+			ID:        id,
+			Timestamp: time.Unix(1700000000, 0),
+			Operation: ActivityStreamOperationUpdate,
+			Object1:   "job_template",
+			Actor:     &ByUserSummary{ID: 1, Username: "admin"},
+			Changes:   map[string]ActivityStreamChange{"name": {Old: "a=b", New: "c|d"}},
+		})
+	}
+
+	checkpoint := filepath.Join(t.TempDir(), "checkpoint")
+	output := new(bytes.Buffer)
+	exporter := &ActivityStreamExporter{Stream: stream, Writer: output, CheckpointPath: checkpoint}
+	if n, err := exporter.Export(context.Background()); err != nil || n != 3 {
+		t.Fatalf("export: %d, %v", n, err)
+	}
+	if lines := strings.Count(output.String(), "\n"); lines != 3 {
+		t.Errorf("ndjson lines: %d", lines)
+	}
+
+	stream.entries = append(stream.entries, &ActivityStream{ID: 4, Operation: ActivityStreamOperationDelete, Object1: "credential"})
+	output.Reset()
+	restarted := &ActivityStreamExporter{Stream: stream, Writer: output, Format: ActivityStreamCEF, CheckpointPath: checkpoint, DeviceVersion: "23.0.0"}
+	if n, err := restarted.Export(context.Background()); err != nil || n != 1 {
+		t.Fatalf("resume: %d, %v", n, err)
+	}
+	if !strings.HasPrefix(output.String(), "CEF:0|Ansible|AWX|23.0.0|delete|delete credential|5|rt=") || !strings.Contains(output.String(), " externalId=4 ") {
+		t.Errorf("cef: %s", output)
+	}
+	if id, err := restarted.Checkpoint(); err != nil || id != 4 {
+		t.Errorf("checkpoint: %d, %v", id, err)
+	}
+
+	line, err := FormatActivityStreamCEF(stream.entries[0], "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(line, "suser=admin") || !strings.Contains(line, `a\=b`) || !strings.Contains(line, "cs1=job_template ") {
+		t.Errorf("cef escaping: %s", line)
+	}
+}
+
+func TestActivityStreamExporterFilter(t *testing.T) {
+	start := time.Unix(1700000000, 0).UTC()
+	admin := &ByUserSummary{ID: 1, Username: "admin"}
+	operator := &ByUserSummary{ID: 2, Username: "operator"}
+	stream := &fakeActivityStream{entries: []*ActivityStream{
+		{ID: 1, Timestamp: start, Operation: ActivityStreamOperationDelete, Actor: admin},
+		{ID: 2, Timestamp: start.Add(time.Hour), Operation: ActivityStreamOperationUpdate, Actor: admin},
+		{ID: 3, Timestamp: start.Add(2 * time.Hour), Operation: ActivityStreamOperationDelete, Actor: operator},
+		{ID: 4, Timestamp: start.Add(3 * time.Hour), Operation: ActivityStreamOperationDelete, Actor: admin},
+		{ID: 5, Timestamp: start.Add(4 * time.Hour), Operation: ActivityStreamOperationDelete},
+		{ID: 6, Timestamp: start.Add(5 * time.Hour), Operation: ActivityStreamOperationDelete, Actor: admin},
+	}}
+
+	tests := []struct {
+		name       string
+		filter     ActivityStreamFilter
+		checkpoint string
+		want       []int
+	}{
+		{name: "operation", filter: ActivityStreamFilter{Operation: ActivityStreamOperationDelete}, want: []int{1, 3, 4, 5, 6}},
+		{name: "operation after the checkpoint", filter: ActivityStreamFilter{Operation: ActivityStreamOperationDelete}, checkpoint: "3\n", want: []int{4, 5, 6}},
+		{name: "actor after the checkpoint", filter: ActivityStreamFilter{Actor: "admin"}, checkpoint: "1\n", want: []int{2, 4, 6}},
+		{name: "since before the checkpoint", filter: ActivityStreamFilter{Since: start.Add(time.Hour)}, checkpoint: "4\n", want: []int{5, 6}},
+		{name: "since after the checkpoint", filter: ActivityStreamFilter{Since: start.Add(4 * time.Hour)}, checkpoint: "2\n", want: []int{5, 6}},
+		{name: "window", filter: ActivityStreamFilter{Since: start.Add(time.Hour), Until: start.Add(4 * time.Hour), Operation: ActivityStreamOperationDelete}, checkpoint: "3\n", want: []int{4}},
+		{name: "nothing after the checkpoint", filter: ActivityStreamFilter{Actor: "operator"}, checkpoint: "3\n"},
+	}
+	for _, tt := range tests {
+		checkpoint := filepath.Join(t.TempDir(), "checkpoint")
+		if tt.checkpoint != "" {
+			if err := os.WriteFile(checkpoint, []byte(tt.checkpoint), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		output := new(bytes.Buffer)
+		exporter := &ActivityStreamExporter{Stream: stream, Writer: output, Filter: tt.filter, CheckpointPath: checkpoint}
+		n, err := exporter.Export(context.Background())
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+
+		var got []int
+		for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var entry struct {
+				ID int `json:"id"`
+			}
+			if err := json.Unmarshal([]byte(line), &entry); err != nil {
+				t.Fatalf("%s: %s", tt.name, err)
+			}
+			got = append(got, entry.ID)
+		}
+		if n != len(tt.want) || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: exported %d %v, want %v", tt.name, n, got, tt.want)
+		}
+
+		content, err := os.ReadFile(checkpoint)
+		if len(tt.want) == 0 {
+			if string(content) != tt.checkpoint {
+				t.Errorf("%s: checkpoint %q, want it unchanged", tt.name, content)
+			}
+			continue
+		}
+		if want := strconv.Itoa(tt.want[len(tt.want)-1]) + "\n"; err != nil || string(content) != want {
+			t.Errorf("%s: checkpoint %q, %v, want %q", tt.name, content, err, want)
+		}
+		if _, err := os.Stat(checkpoint + ".tmp"); !os.IsNotExist(err) {
+			t.Errorf("%s: the temporary checkpoint was left: %v", tt.name, err)
+		}
+	}
+}
+
+func TestActivityStreamExporterRestart(t *testing.T) {
+	stream := &fakeActivityStream{}
+	for id := 1; id <= 2; id++ {
+		stream.entries = append(stream.entries, &ActivityStream{ID: id, Operation: ActivityStreamOperationCreate, Object1: "host"})
+	}
+	dir := t.TempDir()
+	checkpoint := filepath.Join(dir, "checkpoint")
+	path := filepath.Join(dir, "activity.ndjson")
+	open := func() *os.File {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { file.Close() })
+		return file
+	}
+
+	exporter := &ActivityStreamExporter{Stream: stream, Writer: open(), CheckpointPath: checkpoint}
+	if n, err := exporter.Export(context.Background()); err != nil || n != 2 {
+		t.Fatalf("export: %d, %v", n, err)
+	}
+	exported, _ := os.ReadFile(path)
+	if content, err := os.ReadFile(checkpoint); err != nil || string(content) != fmt.Sprintf("2 %d\n", len(exported)) {
+		t.Errorf("checkpoint %q, %v", content, err)
+	}
+
+	// a crash after writing a page, before saving its checkpoint
+	stream.entries = append(stream.entries, &ActivityStream{ID: 3, Operation: ActivityStreamOperationCreate, Object1: "host"})
+	if err := os.WriteFile(path, append(exported, `{"id":3,"operation":"create"}`+"\n"+`{"id":4,"oper`...), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	stream.entries = append(stream.entries, &ActivityStream{ID: 4, Operation: ActivityStreamOperationDelete, Object1: "host"})
+
+	restarted := &ActivityStreamExporter{Stream: stream, Writer: open(), CheckpointPath: checkpoint}
+	if n, err := restarted.Export(context.Background()); err != nil || n != 2 {
+		t.Fatalf("resume: %d, %v", n, err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		var entry struct {
+			ID int `json:"id"`
+		}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("line %q: %s", line, err)
+		}
+		got = append(got, entry.ID)
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("exported %v, want each entry once", got)
+	}
+}
@@ -0,0 +1,59 @@
+package awx
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestActivityStreamDecoding(t *testing.T) {
+	content := `{
+		"id": 42,
+		"type": "activity_stream",
+		"timestamp": "2024-03-01T10:00:00Z",
+		"operation": "update",
+		"changes": {"playbook": ["old.yml", "new.yml"], "forks": [0, 5]},
+		"object1": "job_template",
+		"object2": "",
+		"summary_fields": {
+			"actor": {"id": 3, "username": "alice", "first_name": "", "last_name": ""},
+			"job_template": [{"id": 7, "name": "Deploy", "description": ""}]
+		}
+	}`
+	entry := new(ActivityStream)
+	if err := json.Unmarshal([]byte(content), entry); err != nil {
+		t.Fatalf("decode: %s", err)
+	}
+	if entry.Actor == nil || entry.Actor.Username != "alice" {
+		t.Errorf("actor: %#v", entry.Actor)
+	}
+	if len(entry.Objects1) != 1 || entry.Objects1[0].ID != 7 || entry.Objects1[0].Name != "Deploy" || entry.Objects2 != nil {
+		t.Errorf("objects: %#v, %#v", entry.Objects1, entry.Objects2)
+	}
+	want := map[string]ActivityStreamChange{
+		"playbook": {Old: "old.yml", New: "new.yml"},
+		"forks":    {Old: float64(0), New: float64(5)},
+	}
+	if !reflect.DeepEqual(entry.Changes, want) {
+		t.Errorf("changes: %#v", entry.Changes)
+	}
+
+	if err := json.Unmarshal([]byte(`{"id": 43, "operation": "delete", "changes": {"name": "Deploy"}}`), entry); err != nil {
+		t.Fatalf("decode: %s", err)
+	}
+	if entry.Actor != nil || entry.Changes["name"] != (ActivityStreamChange{Old: "Deploy"}) {
+		t.Errorf("delete: %#v, %#v", entry.Actor, entry.Changes)
+	}
+
+	query := ActivityStreamFilter{
+		Actor:      "alice",
+		ObjectType: "job_template",
+		ObjectID:   7,
+		Since:      time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}.Query()
+	wantQuery := "actor__username=alice&job_template__id=7&or__object1=job_template&or__object2=job_template&timestamp__gte=2024-03-01T00%3A00%3A00Z"
+	if query.String() != wantQuery {
+		t.Errorf("query: %s", query)
+	}
+}
@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 type ApplicationService struct {
@@ -18,7 +20,7 @@ type ListApplicationResponse struct {
 const applicationAPIEndpoint = "/api/v2/applications/"
 
 // ListApplication shows list of awx authentication applications.
-func (c *ApplicationService) ListApplication(params map[string]string) ([]*Application, *ListApplicationResponse, error) {
+func (c *ApplicationService) ListApplication(params url.Values) ([]*Application, *ListApplicationResponse, error) {
 	result := new(ListApplicationResponse)
 	resp, err := c.client.Requester.GetJSON(applicationAPIEndpoint, result, params)
 	if err != nil {
@@ -33,7 +35,7 @@ func (c *ApplicationService) ListApplication(params map[string]string) ([]*Appli
 }
 
 // GetApplicationByID shows an of awx application by its ID.
-func (c *ApplicationService) GetApplicationByID(id int, params map[string]string) (*Application, error) {
+func (c *ApplicationService) GetApplicationByID(id int, params url.Values) (*Application, error) {
 	result := new(Application)
 	endpoint := fmt.Sprintf("%s%d", applicationAPIEndpoint, id)
 	resp, err := c.client.Requester.GetJSON(endpoint, result, params)
@@ -49,8 +51,19 @@ func (c *ApplicationService) GetApplicationByID(id int, params map[string]string
 	return result, nil
 }
 
+// GetByNamedURL shows the details of an application by its named url identifier, see `NamedURLApplication`.
+func (c *ApplicationService) GetByNamedURL(identifier string, params url.Values) (*Application, error) {
+	return getByNamedURL[Application](c.client, applicationAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only application named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (c *ApplicationService) FindByName(ctx context.Context, name string, scope Scope) (*Application, error) {
+	return findByName[Application](ctx, c.client, "application", applicationAPIEndpoint, "name", name, scope, organizationScope)
+}
+
 // CreateApplication creates an awx authentication application.
-func (c *ApplicationService) CreateApplication(data map[string]interface{}, params map[string]string) (*Application, error) {
+func (c *ApplicationService) CreateApplication(data map[string]interface{}, params url.Values) (*Application, error) {
 	mandatoryFields = []string{"name", "client_type", "authorization_grant_type", "organization"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -80,7 +93,7 @@ func (c *ApplicationService) CreateApplication(data map[string]interface{}, para
 }
 
 // UpdateUser update an awx application.
-func (c *ApplicationService) UpdateApplication(id int, data map[string]interface{}, params map[string]string) (*Application, error) {
+func (c *ApplicationService) UpdateApplication(id int, data map[string]interface{}, params url.Values) (*Application, error) {
 	result := new(Application)
 	endpoint := fmt.Sprintf("%s%d", applicationAPIEndpoint, id)
 	payload, err := json.Marshal(data)
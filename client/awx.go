@@ -9,48 +9,64 @@ import (
 var mandatoryFields []string
 
 // AWX represents awx api endpoints with services, and using
-// client to communicate with awx server.
+// client to communicate with awx server. Services are exposed through
+// interfaces, see the `awxfake` package for in-memory fakes.
 type AWX struct {
 	client *Client
 
-	ApplicationService                              *ApplicationService
-	ExecutionEnvironmentsService                    *ExecutionEnvironmentsService
-	PingService                                     *PingService
-	InventoriesService                              *InventoriesService
-	JobService                                      *JobService
-	WorkflowJobService                              *WorkflowJobService
-	JobTemplateService                              *JobTemplateService
-	JobTemplateNotificationTemplatesService         *JobTemplateNotificationTemplatesService
-	ProjectService                                  *ProjectService
-	ProjectUpdatesService                           *ProjectUpdatesService
-	UserService                                     *UserService
-	GroupService                                    *GroupService
-	HostService                                     *HostService
-	CredentialsService                              *CredentialsService
-	CredentialTypeService                           *CredentialTypeService
-	CredentialInputSourceService                    *CredentialInputSourceService
-	InventorySourcesService                         *InventorySourcesService
-	InventorySourcesSchedulesService                *InventorySourcesSchedulesService
-	InventoryGroupService                           *InventoryGroupService
-	InstanceGroupsService                           *InstanceGroupsService
-	NotificationTemplatesService                    *NotificationTemplatesService
-	OrganizationsService                            *OrganizationsService
-	ScheduleService                                 *SchedulesService
-	SettingService                                  *SettingService
-	TeamService                                     *TeamService
-	WorkflowJobTemplateScheduleService              *WorkflowJobTemplateScheduleService
-	WorkflowJobTemplateService                      *WorkflowJobTemplateService
-	WorkflowJobTemplateNodeService                  *WorkflowJobTemplateNodeService
-	WorkflowJobTemplateNodeAlwaysService            *WorkflowJobTemplateNodeStepService
-	WorkflowJobTemplateNodeFailureService           *WorkflowJobTemplateNodeStepService
-	WorkflowJobTemplateNodeSuccessService           *WorkflowJobTemplateNodeStepService
-	WorkflowJobTemplateNotificationTemplatesService *WorkflowJobTemplateNotificationTemplatesService
+	ActivityStreamService                           ActivityStreamAPI
+	ApplicationService                              ApplicationAPI
+	ConfigService                                   ConfigAPI
+	DashboardService                                DashboardAPI
+	ExecutionEnvironmentsService                    ExecutionEnvironmentsAPI
+	PingService                                     PingAPI
+	InventoriesService                              InventoriesAPI
+	JobService                                      JobAPI
+	WorkflowJobService                              WorkflowJobAPI
+	JobTemplateService                              JobTemplateAPI
+	JobTemplateNotificationTemplatesService         JobTemplateNotificationTemplatesAPI
+	ProjectService                                  ProjectAPI
+	ProjectUpdatesService                           ProjectUpdatesAPI
+	UserService                                     UserAPI
+	GroupService                                    GroupAPI
+	HostService                                     HostAPI
+	HostMetricsService                              HostMetricsAPI
+	HostMetricSummaryMonthlyService                 HostMetricSummaryMonthlyAPI
+	CredentialsService                              CredentialsAPI
+	CredentialTypeService                           CredentialTypeAPI
+	CredentialInputSourceService                    CredentialInputSourceAPI
+	InventorySourcesService                         InventorySourcesAPI
+	InventorySourcesSchedulesService                InventorySourcesSchedulesAPI
+	InventoryGroupService                           InventoryGroupAPI
+	InstanceGroupsService                           InstanceGroupsAPI
+	LabelsService                                   LabelsAPI
+	MeService                                       MeAPI
+	NotificationTemplatesService                    NotificationTemplatesAPI
+	NotificationsService                            NotificationsAPI
+	OrganizationsService                            OrganizationsAPI
+	RolesService                                    RolesAPI
+	ScheduleService                                 SchedulesAPI
+	SettingService                                  SettingAPI
+	SystemJobTemplatesService                       SystemJobTemplatesAPI
+	SystemJobsService                               SystemJobsAPI
+	TeamService                                     TeamAPI
+	UnifiedJobTemplatesService                      UnifiedJobTemplatesAPI
+	UnifiedJobsService                              UnifiedJobsAPI
+	WorkflowJobTemplateScheduleService              WorkflowJobTemplateScheduleAPI
+	WorkflowJobTemplateService                      WorkflowJobTemplateAPI
+	WorkflowJobTemplateNodeService                  WorkflowJobTemplateNodeAPI
+	WorkflowJobTemplateNodeAlwaysService            WorkflowJobTemplateNodeStepAPI
+	WorkflowJobTemplateNodeFailureService           WorkflowJobTemplateNodeStepAPI
+	WorkflowJobTemplateNodeSuccessService           WorkflowJobTemplateNodeStepAPI
+	WorkflowJobTemplateNotificationTemplatesService WorkflowJobTemplateNotificationTemplatesAPI
 }
 
 // Client implement http client.
 type Client struct {
 	BaseURL   string
 	Requester *Requester
+
+	names *nameCache
 }
 
 // CheckResponse do http response check, and return err if not in [200, 300).
@@ -79,30 +95,16 @@ func ValidateParams(data map[string]interface{}, mandatoryFields []string) (notf
 // NewAWX news an awx handler with basic auth support, you could customize the http
 // transport by passing custom client.
 func NewAWX(baseURL, userName, passwd string, client *http.Client) (*AWX, error) {
-	r := &Requester{Base: baseURL, Authenticator: &BasicAuth{Username: userName, Password: passwd}, Client: client}
-	if r.Client == nil {
-		r.Client = http.DefaultClient
-	}
-
-	awxClient := &Client{
-		BaseURL:   baseURL,
-		Requester: r,
-	}
-
-	newAWX := newAWX(awxClient)
-
-	// test the connection and return and error if there's an issue
-	_, err := newAWX.PingService.Ping()
-	if err != nil {
-		return nil, err
-	}
-
-	return newAWX, nil
+	return newAWXWithAuthenticator(baseURL, &BasicAuth{Username: userName, Password: passwd}, client)
 }
 
 // NewAWXToken creates an AWX handler with token support.
 func NewAWXToken(baseURL, token string, client *http.Client) (*AWX, error) {
-	r := &Requester{Base: baseURL, Authenticator: &TokenAuth{Token: token}, Client: client}
+	return newAWXWithAuthenticator(baseURL, &TokenAuth{Token: token}, client)
+}
+
+func newAWXWithAuthenticator(baseURL string, authenticator Authenticator, client *http.Client) (*AWX, error) {
+	r := &Requester{Base: baseURL, Authenticator: authenticator, Client: client}
 	if r.Client == nil {
 		r.Client = http.DefaultClient
 	}
@@ -127,9 +129,18 @@ func newAWX(c *Client) *AWX {
 	return &AWX{
 		client: c,
 
+		ActivityStreamService: &ActivityStreamService{
+			client: c,
+		},
 		ApplicationService: &ApplicationService{
 			client: c,
 		},
+		ConfigService: &ConfigService{
+			client: c,
+		},
+		DashboardService: &DashboardService{
+			client: c,
+		},
 		ExecutionEnvironmentsService: &ExecutionEnvironmentsService{
 			client: c,
 		},
@@ -166,6 +177,12 @@ func newAWX(c *Client) *AWX {
 		HostService: &HostService{
 			client: c,
 		},
+		HostMetricsService: &HostMetricsService{
+			client: c,
+		},
+		HostMetricSummaryMonthlyService: &HostMetricSummaryMonthlyService{
+			client: c,
+		},
 		CredentialsService: &CredentialsService{
 			client: c,
 		},
@@ -187,21 +204,45 @@ func newAWX(c *Client) *AWX {
 		InstanceGroupsService: &InstanceGroupsService{
 			client: c,
 		},
+		LabelsService: &LabelsService{
+			client: c,
+		},
+		MeService: &MeService{
+			client: c,
+		},
 		NotificationTemplatesService: &NotificationTemplatesService{
 			client: c,
 		},
+		NotificationsService: &NotificationsService{
+			client: c,
+		},
 		OrganizationsService: &OrganizationsService{
 			client: c,
 		},
+		RolesService: &RolesService{
+			client: c,
+		},
 		ScheduleService: &SchedulesService{
 			client: c,
 		},
 		SettingService: &SettingService{
 			client: c,
 		},
+		SystemJobTemplatesService: &SystemJobTemplatesService{
+			client: c,
+		},
+		SystemJobsService: &SystemJobsService{
+			client: c,
+		},
 		TeamService: &TeamService{
 			client: c,
 		},
+		UnifiedJobTemplatesService: &UnifiedJobTemplatesService{
+			client: c,
+		},
+		UnifiedJobsService: &UnifiedJobsService{
+			client: c,
+		},
 		WorkflowJobTemplateScheduleService: &WorkflowJobTemplateScheduleService{
 			client: c,
 		},
@@ -0,0 +1,4531 @@
+// Code generated by internal/fakegen. DO NOT EDIT.
+
+package awxfake
+
+import (
+	"context"
+	"net/url"
+
+	awx "github.com/denouche/goawx/client"
+)
+
+// Fakes holds the fake services of an AWX built by NewAWX.
+type Fakes struct {
+	ActivityStreamService                           *ActivityStreamAPI
+	ApplicationService                              *ApplicationAPI
+	ConfigService                                   *ConfigAPI
+	DashboardService                                *DashboardAPI
+	ExecutionEnvironmentsService                    *ExecutionEnvironmentsAPI
+	PingService                                     *PingAPI
+	InventoriesService                              *InventoriesAPI
+	JobService                                      *JobAPI
+	WorkflowJobService                              *WorkflowJobAPI
+	JobTemplateService                              *JobTemplateAPI
+	JobTemplateNotificationTemplatesService         *JobTemplateNotificationTemplatesAPI
+	ProjectService                                  *ProjectAPI
+	ProjectUpdatesService                           *ProjectUpdatesAPI
+	UserService                                     *UserAPI
+	GroupService                                    *GroupAPI
+	HostService                                     *HostAPI
+	HostMetricsService                              *HostMetricsAPI
+	HostMetricSummaryMonthlyService                 *HostMetricSummaryMonthlyAPI
+	CredentialsService                              *CredentialsAPI
+	CredentialTypeService                           *CredentialTypeAPI
+	CredentialInputSourceService                    *CredentialInputSourceAPI
+	InventorySourcesService                         *InventorySourcesAPI
+	InventorySourcesSchedulesService                *InventorySourcesSchedulesAPI
+	InventoryGroupService                           *InventoryGroupAPI
+	InstanceGroupsService                           *InstanceGroupsAPI
+	LabelsService                                   *LabelsAPI
+	MeService                                       *MeAPI
+	NotificationTemplatesService                    *NotificationTemplatesAPI
+	NotificationsService                            *NotificationsAPI
+	OrganizationsService                            *OrganizationsAPI
+	RolesService                                    *RolesAPI
+	ScheduleService                                 *SchedulesAPI
+	SettingService                                  *SettingAPI
+	SystemJobTemplatesService                       *SystemJobTemplatesAPI
+	SystemJobsService                               *SystemJobsAPI
+	TeamService                                     *TeamAPI
+	UnifiedJobTemplatesService                      *UnifiedJobTemplatesAPI
+	UnifiedJobsService                              *UnifiedJobsAPI
+	WorkflowJobTemplateScheduleService              *WorkflowJobTemplateScheduleAPI
+	WorkflowJobTemplateService                      *WorkflowJobTemplateAPI
+	WorkflowJobTemplateNodeService                  *WorkflowJobTemplateNodeAPI
+	WorkflowJobTemplateNodeAlwaysService            *WorkflowJobTemplateNodeStepAPI
+	WorkflowJobTemplateNodeFailureService           *WorkflowJobTemplateNodeStepAPI
+	WorkflowJobTemplateNodeSuccessService           *WorkflowJobTemplateNodeStepAPI
+	WorkflowJobTemplateNotificationTemplatesService *WorkflowJobTemplateNotificationTemplatesAPI
+}
+
+// NewAWX returns an AWX whose services are fakes, along with the fakes to script and inspect.
+// The AWX has no http client, the raw request helpers can't be used with it.
+func NewAWX() (*awx.AWX, *Fakes) {
+	fakes := &Fakes{
+		ActivityStreamService:                           &ActivityStreamAPI{},
+		ApplicationService:                              &ApplicationAPI{},
+		ConfigService:                                   &ConfigAPI{},
+		DashboardService:                                &DashboardAPI{},
+		ExecutionEnvironmentsService:                    &ExecutionEnvironmentsAPI{},
+		PingService:                                     &PingAPI{},
+		InventoriesService:                              &InventoriesAPI{},
+		JobService:                                      &JobAPI{},
+		WorkflowJobService:                              &WorkflowJobAPI{},
+		JobTemplateService:                              &JobTemplateAPI{},
+		JobTemplateNotificationTemplatesService:         &JobTemplateNotificationTemplatesAPI{},
+		ProjectService:                                  &ProjectAPI{},
+		ProjectUpdatesService:                           &ProjectUpdatesAPI{},
+		UserService:                                     &UserAPI{},
+		GroupService:                                    &GroupAPI{},
+		HostService:                                     &HostAPI{},
+		HostMetricsService:                              &HostMetricsAPI{},
+		HostMetricSummaryMonthlyService:                 &HostMetricSummaryMonthlyAPI{},
+		CredentialsService:                              &CredentialsAPI{},
+		CredentialTypeService:                           &CredentialTypeAPI{},
+		CredentialInputSourceService:                    &CredentialInputSourceAPI{},
+		InventorySourcesService:                         &InventorySourcesAPI{},
+		InventorySourcesSchedulesService:                &InventorySourcesSchedulesAPI{},
+		InventoryGroupService:                           &InventoryGroupAPI{},
+		InstanceGroupsService:                           &InstanceGroupsAPI{},
+		LabelsService:                                   &LabelsAPI{},
+		MeService:                                       &MeAPI{},
+		NotificationTemplatesService:                    &NotificationTemplatesAPI{},
+		NotificationsService:                            &NotificationsAPI{},
+		OrganizationsService:                            &OrganizationsAPI{},
+		RolesService:                                    &RolesAPI{},
+		ScheduleService:                                 &SchedulesAPI{},
+		SettingService:                                  &SettingAPI{},
+		SystemJobTemplatesService:                       &SystemJobTemplatesAPI{},
+		SystemJobsService:                               &SystemJobsAPI{},
+		TeamService:                                     &TeamAPI{},
+		UnifiedJobTemplatesService:                      &UnifiedJobTemplatesAPI{},
+		UnifiedJobsService:                              &UnifiedJobsAPI{},
+		WorkflowJobTemplateScheduleService:              &WorkflowJobTemplateScheduleAPI{},
+		WorkflowJobTemplateService:                      &WorkflowJobTemplateAPI{},
+		WorkflowJobTemplateNodeService:                  &WorkflowJobTemplateNodeAPI{},
+		WorkflowJobTemplateNodeAlwaysService:            &WorkflowJobTemplateNodeStepAPI{},
+		WorkflowJobTemplateNodeFailureService:           &WorkflowJobTemplateNodeStepAPI{},
+		WorkflowJobTemplateNodeSuccessService:           &WorkflowJobTemplateNodeStepAPI{},
+		WorkflowJobTemplateNotificationTemplatesService: &WorkflowJobTemplateNotificationTemplatesAPI{},
+	}
+	return &awx.AWX{
+		ActivityStreamService:                           fakes.ActivityStreamService,
+		ApplicationService:                              fakes.ApplicationService,
+		ConfigService:                                   fakes.ConfigService,
+		DashboardService:                                fakes.DashboardService,
+		ExecutionEnvironmentsService:                    fakes.ExecutionEnvironmentsService,
+		PingService:                                     fakes.PingService,
+		InventoriesService:                              fakes.InventoriesService,
+		JobService:                                      fakes.JobService,
+		WorkflowJobService:                              fakes.WorkflowJobService,
+		JobTemplateService:                              fakes.JobTemplateService,
+		JobTemplateNotificationTemplatesService:         fakes.JobTemplateNotificationTemplatesService,
+		ProjectService:                                  fakes.ProjectService,
+		ProjectUpdatesService:                           fakes.ProjectUpdatesService,
+		UserService:                                     fakes.UserService,
+		GroupService:                                    fakes.GroupService,
+		HostService:                                     fakes.HostService,
+		HostMetricsService:                              fakes.HostMetricsService,
+		HostMetricSummaryMonthlyService:                 fakes.HostMetricSummaryMonthlyService,
+		CredentialsService:                              fakes.CredentialsService,
+		CredentialTypeService:                           fakes.CredentialTypeService,
+		CredentialInputSourceService:                    fakes.CredentialInputSourceService,
+		InventorySourcesService:                         fakes.InventorySourcesService,
+		InventorySourcesSchedulesService:                fakes.InventorySourcesSchedulesService,
+		InventoryGroupService:                           fakes.InventoryGroupService,
+		InstanceGroupsService:                           fakes.InstanceGroupsService,
+		LabelsService:                                   fakes.LabelsService,
+		MeService:                                       fakes.MeService,
+		NotificationTemplatesService:                    fakes.NotificationTemplatesService,
+		NotificationsService:                            fakes.NotificationsService,
+		OrganizationsService:                            fakes.OrganizationsService,
+		RolesService:                                    fakes.RolesService,
+		ScheduleService:                                 fakes.ScheduleService,
+		SettingService:                                  fakes.SettingService,
+		SystemJobTemplatesService:                       fakes.SystemJobTemplatesService,
+		SystemJobsService:                               fakes.SystemJobsService,
+		TeamService:                                     fakes.TeamService,
+		UnifiedJobTemplatesService:                      fakes.UnifiedJobTemplatesService,
+		UnifiedJobsService:                              fakes.UnifiedJobsService,
+		WorkflowJobTemplateScheduleService:              fakes.WorkflowJobTemplateScheduleService,
+		WorkflowJobTemplateService:                      fakes.WorkflowJobTemplateService,
+		WorkflowJobTemplateNodeService:                  fakes.WorkflowJobTemplateNodeService,
+		WorkflowJobTemplateNodeAlwaysService:            fakes.WorkflowJobTemplateNodeAlwaysService,
+		WorkflowJobTemplateNodeFailureService:           fakes.WorkflowJobTemplateNodeFailureService,
+		WorkflowJobTemplateNodeSuccessService:           fakes.WorkflowJobTemplateNodeSuccessService,
+		WorkflowJobTemplateNotificationTemplatesService: fakes.WorkflowJobTemplateNotificationTemplatesService,
+	}, fakes
+}
+
+var _ awx.ActivityStreamAPI = (*ActivityStreamAPI)(nil)
+
+// ActivityStreamAPI is an in-memory fake of awx.ActivityStreamAPI.
+type ActivityStreamAPI struct {
+	Recorder
+
+	ListActivityStreamFunc        func(url.Values) ([]*awx.ActivityStream, *awx.ListActivityStreamResponse, error)
+	GetActivityStreamByIDFunc     func(int, url.Values) (*awx.ActivityStream, error)
+	ListRelatedActivityStreamFunc func(*awx.Related, url.Values) ([]*awx.ActivityStream, *awx.ListActivityStreamResponse, error)
+}
+
+// ListActivityStream records the call and returns the scripted results, zero values by default.
+func (f *ActivityStreamAPI) ListActivityStream(params url.Values) (r0 []*awx.ActivityStream, r1 *awx.ListActivityStreamResponse, r2 error) {
+	f.record("ListActivityStream", params)
+	if fn := f.ListActivityStreamFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListActivityStreamReturns scripts the results of ListActivityStream.
+func (f *ActivityStreamAPI) ListActivityStreamReturns(r0 []*awx.ActivityStream, r1 *awx.ListActivityStreamResponse, r2 error) {
+	f.ListActivityStreamFunc = func(url.Values) ([]*awx.ActivityStream, *awx.ListActivityStreamResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetActivityStreamByID records the call and returns the scripted results, zero values by default.
+func (f *ActivityStreamAPI) GetActivityStreamByID(id int, params url.Values) (r0 *awx.ActivityStream, r1 error) {
+	f.record("GetActivityStreamByID", id, params)
+	if fn := f.GetActivityStreamByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetActivityStreamByIDReturns scripts the results of GetActivityStreamByID.
+func (f *ActivityStreamAPI) GetActivityStreamByIDReturns(r0 *awx.ActivityStream, r1 error) {
+	f.GetActivityStreamByIDFunc = func(int, url.Values) (*awx.ActivityStream, error) {
+		return r0, r1
+	}
+}
+
+// ListRelatedActivityStream records the call and returns the scripted results, zero values by default.
+func (f *ActivityStreamAPI) ListRelatedActivityStream(related *awx.Related, params url.Values) (r0 []*awx.ActivityStream, r1 *awx.ListActivityStreamResponse, r2 error) {
+	f.record("ListRelatedActivityStream", related, params)
+	if fn := f.ListRelatedActivityStreamFunc; fn != nil {
+		return fn(related, params)
+	}
+	return
+}
+
+// ListRelatedActivityStreamReturns scripts the results of ListRelatedActivityStream.
+func (f *ActivityStreamAPI) ListRelatedActivityStreamReturns(r0 []*awx.ActivityStream, r1 *awx.ListActivityStreamResponse, r2 error) {
+	f.ListRelatedActivityStreamFunc = func(*awx.Related, url.Values) ([]*awx.ActivityStream, *awx.ListActivityStreamResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+var _ awx.ApplicationAPI = (*ApplicationAPI)(nil)
+
+// ApplicationAPI is an in-memory fake of awx.ApplicationAPI.
+type ApplicationAPI struct {
+	Recorder
+
+	ListApplicationFunc    func(url.Values) ([]*awx.Application, *awx.ListApplicationResponse, error)
+	GetApplicationByIDFunc func(int, url.Values) (*awx.Application, error)
+	GetByNamedURLFunc      func(string, url.Values) (*awx.Application, error)
+	FindByNameFunc         func(context.Context, string, awx.Scope) (*awx.Application, error)
+	CreateApplicationFunc  func(map[string]interface{}, url.Values) (*awx.Application, error)
+	UpdateApplicationFunc  func(int, map[string]interface{}, url.Values) (*awx.Application, error)
+	DeleteApplicationFunc  func(int) (*awx.Application, error)
+}
+
+// ListApplication records the call and returns the scripted results, zero values by default.
+func (f *ApplicationAPI) ListApplication(params url.Values) (r0 []*awx.Application, r1 *awx.ListApplicationResponse, r2 error) {
+	f.record("ListApplication", params)
+	if fn := f.ListApplicationFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListApplicationReturns scripts the results of ListApplication.
+func (f *ApplicationAPI) ListApplicationReturns(r0 []*awx.Application, r1 *awx.ListApplicationResponse, r2 error) {
+	f.ListApplicationFunc = func(url.Values) ([]*awx.Application, *awx.ListApplicationResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetApplicationByID records the call and returns the scripted results, zero values by default.
+func (f *ApplicationAPI) GetApplicationByID(id int, params url.Values) (r0 *awx.Application, r1 error) {
+	f.record("GetApplicationByID", id, params)
+	if fn := f.GetApplicationByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetApplicationByIDReturns scripts the results of GetApplicationByID.
+func (f *ApplicationAPI) GetApplicationByIDReturns(r0 *awx.Application, r1 error) {
+	f.GetApplicationByIDFunc = func(int, url.Values) (*awx.Application, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *ApplicationAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.Application, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *ApplicationAPI) GetByNamedURLReturns(r0 *awx.Application, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.Application, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *ApplicationAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.Application, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *ApplicationAPI) FindByNameReturns(r0 *awx.Application, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.Application, error) {
+		return r0, r1
+	}
+}
+
+// CreateApplication records the call and returns the scripted results, zero values by default.
+func (f *ApplicationAPI) CreateApplication(data map[string]interface{}, params url.Values) (r0 *awx.Application, r1 error) {
+	f.record("CreateApplication", data, params)
+	if fn := f.CreateApplicationFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateApplicationReturns scripts the results of CreateApplication.
+func (f *ApplicationAPI) CreateApplicationReturns(r0 *awx.Application, r1 error) {
+	f.CreateApplicationFunc = func(map[string]interface{}, url.Values) (*awx.Application, error) {
+		return r0, r1
+	}
+}
+
+// UpdateApplication records the call and returns the scripted results, zero values by default.
+func (f *ApplicationAPI) UpdateApplication(id int, data map[string]interface{}, params url.Values) (r0 *awx.Application, r1 error) {
+	f.record("UpdateApplication", id, data, params)
+	if fn := f.UpdateApplicationFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateApplicationReturns scripts the results of UpdateApplication.
+func (f *ApplicationAPI) UpdateApplicationReturns(r0 *awx.Application, r1 error) {
+	f.UpdateApplicationFunc = func(int, map[string]interface{}, url.Values) (*awx.Application, error) {
+		return r0, r1
+	}
+}
+
+// DeleteApplication records the call and returns the scripted results, zero values by default.
+func (f *ApplicationAPI) DeleteApplication(id int) (r0 *awx.Application, r1 error) {
+	f.record("DeleteApplication", id)
+	if fn := f.DeleteApplicationFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteApplicationReturns scripts the results of DeleteApplication.
+func (f *ApplicationAPI) DeleteApplicationReturns(r0 *awx.Application, r1 error) {
+	f.DeleteApplicationFunc = func(int) (*awx.Application, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.ConfigAPI = (*ConfigAPI)(nil)
+
+// ConfigAPI is an in-memory fake of awx.ConfigAPI.
+type ConfigAPI struct {
+	Recorder
+
+	GetConfigFunc          func(url.Values) (*awx.Config, error)
+	AttachManifestFunc     func(string) (*awx.LicenseInfo, error)
+	AttachSubscriptionFunc func(string) (*awx.LicenseInfo, error)
+}
+
+// GetConfig records the call and returns the scripted results, zero values by default.
+func (f *ConfigAPI) GetConfig(params url.Values) (r0 *awx.Config, r1 error) {
+	f.record("GetConfig", params)
+	if fn := f.GetConfigFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// GetConfigReturns scripts the results of GetConfig.
+func (f *ConfigAPI) GetConfigReturns(r0 *awx.Config, r1 error) {
+	f.GetConfigFunc = func(url.Values) (*awx.Config, error) {
+		return r0, r1
+	}
+}
+
+// AttachManifest records the call and returns the scripted results, zero values by default.
+func (f *ConfigAPI) AttachManifest(path string) (r0 *awx.LicenseInfo, r1 error) {
+	f.record("AttachManifest", path)
+	if fn := f.AttachManifestFunc; fn != nil {
+		return fn(path)
+	}
+	return
+}
+
+// AttachManifestReturns scripts the results of AttachManifest.
+func (f *ConfigAPI) AttachManifestReturns(r0 *awx.LicenseInfo, r1 error) {
+	f.AttachManifestFunc = func(string) (*awx.LicenseInfo, error) {
+		return r0, r1
+	}
+}
+
+// AttachSubscription records the call and returns the scripted results, zero values by default.
+func (f *ConfigAPI) AttachSubscription(poolID string) (r0 *awx.LicenseInfo, r1 error) {
+	f.record("AttachSubscription", poolID)
+	if fn := f.AttachSubscriptionFunc; fn != nil {
+		return fn(poolID)
+	}
+	return
+}
+
+// AttachSubscriptionReturns scripts the results of AttachSubscription.
+func (f *ConfigAPI) AttachSubscriptionReturns(r0 *awx.LicenseInfo, r1 error) {
+	f.AttachSubscriptionFunc = func(string) (*awx.LicenseInfo, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.DashboardAPI = (*DashboardAPI)(nil)
+
+// DashboardAPI is an in-memory fake of awx.DashboardAPI.
+type DashboardAPI struct {
+	Recorder
+
+	GetDashboardFunc func(url.Values) (*awx.Dashboard, error)
+	GetJobsGraphFunc func(url.Values) (*awx.DashboardJobsGraph, error)
+}
+
+// GetDashboard records the call and returns the scripted results, zero values by default.
+func (f *DashboardAPI) GetDashboard(params url.Values) (r0 *awx.Dashboard, r1 error) {
+	f.record("GetDashboard", params)
+	if fn := f.GetDashboardFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// GetDashboardReturns scripts the results of GetDashboard.
+func (f *DashboardAPI) GetDashboardReturns(r0 *awx.Dashboard, r1 error) {
+	f.GetDashboardFunc = func(url.Values) (*awx.Dashboard, error) {
+		return r0, r1
+	}
+}
+
+// GetJobsGraph records the call and returns the scripted results, zero values by default.
+func (f *DashboardAPI) GetJobsGraph(params url.Values) (r0 *awx.DashboardJobsGraph, r1 error) {
+	f.record("GetJobsGraph", params)
+	if fn := f.GetJobsGraphFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// GetJobsGraphReturns scripts the results of GetJobsGraph.
+func (f *DashboardAPI) GetJobsGraphReturns(r0 *awx.DashboardJobsGraph, r1 error) {
+	f.GetJobsGraphFunc = func(url.Values) (*awx.DashboardJobsGraph, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.ExecutionEnvironmentsAPI = (*ExecutionEnvironmentsAPI)(nil)
+
+// ExecutionEnvironmentsAPI is an in-memory fake of awx.ExecutionEnvironmentsAPI.
+type ExecutionEnvironmentsAPI struct {
+	Recorder
+
+	ListExecutionEnvironmentsFunc   func(url.Values) ([]*awx.ExecutionEnvironment, *awx.ListExecutionEnvironmentsResponse, error)
+	GetExecutionEnvironmentByIDFunc func(int, url.Values) (*awx.ExecutionEnvironment, error)
+	GetByNamedURLFunc               func(string, url.Values) (*awx.ExecutionEnvironment, error)
+	FindByNameFunc                  func(context.Context, string, awx.Scope) (*awx.ExecutionEnvironment, error)
+	CreateExecutionEnvironmentFunc  func(map[string]interface{}, url.Values) (*awx.ExecutionEnvironment, error)
+	UpdateExecutionEnvironmentFunc  func(int, map[string]interface{}, url.Values) (*awx.ExecutionEnvironment, error)
+	DeleteExecutionEnvironmentFunc  func(int) (*awx.ExecutionEnvironment, error)
+}
+
+// ListExecutionEnvironments records the call and returns the scripted results, zero values by default.
+func (f *ExecutionEnvironmentsAPI) ListExecutionEnvironments(params url.Values) (r0 []*awx.ExecutionEnvironment, r1 *awx.ListExecutionEnvironmentsResponse, r2 error) {
+	f.record("ListExecutionEnvironments", params)
+	if fn := f.ListExecutionEnvironmentsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListExecutionEnvironmentsReturns scripts the results of ListExecutionEnvironments.
+func (f *ExecutionEnvironmentsAPI) ListExecutionEnvironmentsReturns(r0 []*awx.ExecutionEnvironment, r1 *awx.ListExecutionEnvironmentsResponse, r2 error) {
+	f.ListExecutionEnvironmentsFunc = func(url.Values) ([]*awx.ExecutionEnvironment, *awx.ListExecutionEnvironmentsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetExecutionEnvironmentByID records the call and returns the scripted results, zero values by default.
+func (f *ExecutionEnvironmentsAPI) GetExecutionEnvironmentByID(id int, params url.Values) (r0 *awx.ExecutionEnvironment, r1 error) {
+	f.record("GetExecutionEnvironmentByID", id, params)
+	if fn := f.GetExecutionEnvironmentByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetExecutionEnvironmentByIDReturns scripts the results of GetExecutionEnvironmentByID.
+func (f *ExecutionEnvironmentsAPI) GetExecutionEnvironmentByIDReturns(r0 *awx.ExecutionEnvironment, r1 error) {
+	f.GetExecutionEnvironmentByIDFunc = func(int, url.Values) (*awx.ExecutionEnvironment, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *ExecutionEnvironmentsAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.ExecutionEnvironment, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *ExecutionEnvironmentsAPI) GetByNamedURLReturns(r0 *awx.ExecutionEnvironment, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.ExecutionEnvironment, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *ExecutionEnvironmentsAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.ExecutionEnvironment, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *ExecutionEnvironmentsAPI) FindByNameReturns(r0 *awx.ExecutionEnvironment, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.ExecutionEnvironment, error) {
+		return r0, r1
+	}
+}
+
+// CreateExecutionEnvironment records the call and returns the scripted results, zero values by default.
+func (f *ExecutionEnvironmentsAPI) CreateExecutionEnvironment(data map[string]interface{}, params url.Values) (r0 *awx.ExecutionEnvironment, r1 error) {
+	f.record("CreateExecutionEnvironment", data, params)
+	if fn := f.CreateExecutionEnvironmentFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateExecutionEnvironmentReturns scripts the results of CreateExecutionEnvironment.
+func (f *ExecutionEnvironmentsAPI) CreateExecutionEnvironmentReturns(r0 *awx.ExecutionEnvironment, r1 error) {
+	f.CreateExecutionEnvironmentFunc = func(map[string]interface{}, url.Values) (*awx.ExecutionEnvironment, error) {
+		return r0, r1
+	}
+}
+
+// UpdateExecutionEnvironment records the call and returns the scripted results, zero values by default.
+func (f *ExecutionEnvironmentsAPI) UpdateExecutionEnvironment(id int, data map[string]interface{}, params url.Values) (r0 *awx.ExecutionEnvironment, r1 error) {
+	f.record("UpdateExecutionEnvironment", id, data, params)
+	if fn := f.UpdateExecutionEnvironmentFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateExecutionEnvironmentReturns scripts the results of UpdateExecutionEnvironment.
+func (f *ExecutionEnvironmentsAPI) UpdateExecutionEnvironmentReturns(r0 *awx.ExecutionEnvironment, r1 error) {
+	f.UpdateExecutionEnvironmentFunc = func(int, map[string]interface{}, url.Values) (*awx.ExecutionEnvironment, error) {
+		return r0, r1
+	}
+}
+
+// DeleteExecutionEnvironment records the call and returns the scripted results, zero values by default.
+func (f *ExecutionEnvironmentsAPI) DeleteExecutionEnvironment(id int) (r0 *awx.ExecutionEnvironment, r1 error) {
+	f.record("DeleteExecutionEnvironment", id)
+	if fn := f.DeleteExecutionEnvironmentFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteExecutionEnvironmentReturns scripts the results of DeleteExecutionEnvironment.
+func (f *ExecutionEnvironmentsAPI) DeleteExecutionEnvironmentReturns(r0 *awx.ExecutionEnvironment, r1 error) {
+	f.DeleteExecutionEnvironmentFunc = func(int) (*awx.ExecutionEnvironment, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.PingAPI = (*PingAPI)(nil)
+
+// PingAPI is an in-memory fake of awx.PingAPI.
+type PingAPI struct {
+	Recorder
+
+	PingFunc func() (*awx.Ping, error)
+}
+
+// Ping records the call and returns the scripted results, zero values by default.
+func (f *PingAPI) Ping() (r0 *awx.Ping, r1 error) {
+	f.record("Ping")
+	if fn := f.PingFunc; fn != nil {
+		return fn()
+	}
+	return
+}
+
+// PingReturns scripts the results of Ping.
+func (f *PingAPI) PingReturns(r0 *awx.Ping, r1 error) {
+	f.PingFunc = func() (*awx.Ping, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.InventoriesAPI = (*InventoriesAPI)(nil)
+
+// InventoriesAPI is an in-memory fake of awx.InventoriesAPI.
+type InventoriesAPI struct {
+	Recorder
+
+	GetInventoryByIDFunc func(int, url.Values) (*awx.Inventory, error)
+	GetByNamedURLFunc    func(string, url.Values) (*awx.Inventory, error)
+	FindByNameFunc       func(context.Context, string, awx.Scope) (*awx.Inventory, error)
+	ListInventoriesFunc  func(url.Values) ([]*awx.Inventory, *awx.ListInventoriesResponse, error)
+	CreateInventoryFunc  func(map[string]interface{}, url.Values) (*awx.Inventory, error)
+	UpdateInventoryFunc  func(int, map[string]interface{}, url.Values) (*awx.Inventory, error)
+	GetInventoryFunc     func(int, url.Values) (*awx.Inventory, error)
+	DeleteInventoryFunc  func(int) (*awx.Inventory, error)
+}
+
+// GetInventoryByID records the call and returns the scripted results, zero values by default.
+func (f *InventoriesAPI) GetInventoryByID(id int, params url.Values) (r0 *awx.Inventory, r1 error) {
+	f.record("GetInventoryByID", id, params)
+	if fn := f.GetInventoryByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetInventoryByIDReturns scripts the results of GetInventoryByID.
+func (f *InventoriesAPI) GetInventoryByIDReturns(r0 *awx.Inventory, r1 error) {
+	f.GetInventoryByIDFunc = func(int, url.Values) (*awx.Inventory, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *InventoriesAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.Inventory, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *InventoriesAPI) GetByNamedURLReturns(r0 *awx.Inventory, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.Inventory, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *InventoriesAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.Inventory, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *InventoriesAPI) FindByNameReturns(r0 *awx.Inventory, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.Inventory, error) {
+		return r0, r1
+	}
+}
+
+// ListInventories records the call and returns the scripted results, zero values by default.
+func (f *InventoriesAPI) ListInventories(params url.Values) (r0 []*awx.Inventory, r1 *awx.ListInventoriesResponse, r2 error) {
+	f.record("ListInventories", params)
+	if fn := f.ListInventoriesFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListInventoriesReturns scripts the results of ListInventories.
+func (f *InventoriesAPI) ListInventoriesReturns(r0 []*awx.Inventory, r1 *awx.ListInventoriesResponse, r2 error) {
+	f.ListInventoriesFunc = func(url.Values) ([]*awx.Inventory, *awx.ListInventoriesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateInventory records the call and returns the scripted results, zero values by default.
+func (f *InventoriesAPI) CreateInventory(data map[string]interface{}, params url.Values) (r0 *awx.Inventory, r1 error) {
+	f.record("CreateInventory", data, params)
+	if fn := f.CreateInventoryFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateInventoryReturns scripts the results of CreateInventory.
+func (f *InventoriesAPI) CreateInventoryReturns(r0 *awx.Inventory, r1 error) {
+	f.CreateInventoryFunc = func(map[string]interface{}, url.Values) (*awx.Inventory, error) {
+		return r0, r1
+	}
+}
+
+// UpdateInventory records the call and returns the scripted results, zero values by default.
+func (f *InventoriesAPI) UpdateInventory(id int, data map[string]interface{}, params url.Values) (r0 *awx.Inventory, r1 error) {
+	f.record("UpdateInventory", id, data, params)
+	if fn := f.UpdateInventoryFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateInventoryReturns scripts the results of UpdateInventory.
+func (f *InventoriesAPI) UpdateInventoryReturns(r0 *awx.Inventory, r1 error) {
+	f.UpdateInventoryFunc = func(int, map[string]interface{}, url.Values) (*awx.Inventory, error) {
+		return r0, r1
+	}
+}
+
+// GetInventory records the call and returns the scripted results, zero values by default.
+func (f *InventoriesAPI) GetInventory(id int, params url.Values) (r0 *awx.Inventory, r1 error) {
+	f.record("GetInventory", id, params)
+	if fn := f.GetInventoryFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetInventoryReturns scripts the results of GetInventory.
+func (f *InventoriesAPI) GetInventoryReturns(r0 *awx.Inventory, r1 error) {
+	f.GetInventoryFunc = func(int, url.Values) (*awx.Inventory, error) {
+		return r0, r1
+	}
+}
+
+// DeleteInventory records the call and returns the scripted results, zero values by default.
+func (f *InventoriesAPI) DeleteInventory(id int) (r0 *awx.Inventory, r1 error) {
+	f.record("DeleteInventory", id)
+	if fn := f.DeleteInventoryFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteInventoryReturns scripts the results of DeleteInventory.
+func (f *InventoriesAPI) DeleteInventoryReturns(r0 *awx.Inventory, r1 error) {
+	f.DeleteInventoryFunc = func(int) (*awx.Inventory, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.JobAPI = (*JobAPI)(nil)
+
+// JobAPI is an in-memory fake of awx.JobAPI.
+type JobAPI struct {
+	Recorder
+
+	GetJobFunc           func(int, url.Values) (*awx.Job, error)
+	CancelJobFunc        func(int, map[string]interface{}, url.Values) (*awx.CancelJobResponse, error)
+	RelaunchJobFunc      func(int, map[string]interface{}, url.Values) (*awx.JobLaunch, error)
+	GetHostSummariesFunc func(int, url.Values) ([]awx.HostSummary, *awx.HostSummariesResponse, error)
+	GetJobEventsFunc     func(int, url.Values) ([]awx.JobEvent, *awx.JobEventsResponse, error)
+}
+
+// GetJob records the call and returns the scripted results, zero values by default.
+func (f *JobAPI) GetJob(id int, params url.Values) (r0 *awx.Job, r1 error) {
+	f.record("GetJob", id, params)
+	if fn := f.GetJobFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetJobReturns scripts the results of GetJob.
+func (f *JobAPI) GetJobReturns(r0 *awx.Job, r1 error) {
+	f.GetJobFunc = func(int, url.Values) (*awx.Job, error) {
+		return r0, r1
+	}
+}
+
+// CancelJob records the call and returns the scripted results, zero values by default.
+func (f *JobAPI) CancelJob(id int, data map[string]interface{}, params url.Values) (r0 *awx.CancelJobResponse, r1 error) {
+	f.record("CancelJob", id, data, params)
+	if fn := f.CancelJobFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// CancelJobReturns scripts the results of CancelJob.
+func (f *JobAPI) CancelJobReturns(r0 *awx.CancelJobResponse, r1 error) {
+	f.CancelJobFunc = func(int, map[string]interface{}, url.Values) (*awx.CancelJobResponse, error) {
+		return r0, r1
+	}
+}
+
+// RelaunchJob records the call and returns the scripted results, zero values by default.
+func (f *JobAPI) RelaunchJob(id int, data map[string]interface{}, params url.Values) (r0 *awx.JobLaunch, r1 error) {
+	f.record("RelaunchJob", id, data, params)
+	if fn := f.RelaunchJobFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// RelaunchJobReturns scripts the results of RelaunchJob.
+func (f *JobAPI) RelaunchJobReturns(r0 *awx.JobLaunch, r1 error) {
+	f.RelaunchJobFunc = func(int, map[string]interface{}, url.Values) (*awx.JobLaunch, error) {
+		return r0, r1
+	}
+}
+
+// GetHostSummaries records the call and returns the scripted results, zero values by default.
+func (f *JobAPI) GetHostSummaries(id int, params url.Values) (r0 []awx.HostSummary, r1 *awx.HostSummariesResponse, r2 error) {
+	f.record("GetHostSummaries", id, params)
+	if fn := f.GetHostSummariesFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetHostSummariesReturns scripts the results of GetHostSummaries.
+func (f *JobAPI) GetHostSummariesReturns(r0 []awx.HostSummary, r1 *awx.HostSummariesResponse, r2 error) {
+	f.GetHostSummariesFunc = func(int, url.Values) ([]awx.HostSummary, *awx.HostSummariesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetJobEvents records the call and returns the scripted results, zero values by default.
+func (f *JobAPI) GetJobEvents(id int, params url.Values) (r0 []awx.JobEvent, r1 *awx.JobEventsResponse, r2 error) {
+	f.record("GetJobEvents", id, params)
+	if fn := f.GetJobEventsFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetJobEventsReturns scripts the results of GetJobEvents.
+func (f *JobAPI) GetJobEventsReturns(r0 []awx.JobEvent, r1 *awx.JobEventsResponse, r2 error) {
+	f.GetJobEventsFunc = func(int, url.Values) ([]awx.JobEvent, *awx.JobEventsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+var _ awx.WorkflowJobAPI = (*WorkflowJobAPI)(nil)
+
+// WorkflowJobAPI is an in-memory fake of awx.WorkflowJobAPI.
+type WorkflowJobAPI struct {
+	Recorder
+
+	GetWorkflowJobFunc      func(int, url.Values) (*awx.WorkflowJob, error)
+	CancelWorkflowJobFunc   func(int, map[string]interface{}, url.Values) (*awx.CancelWorkflowJobResponse, error)
+	RelaunchWorkflowJobFunc func(int, map[string]interface{}, url.Values) (*awx.WorkflowJobLaunch, error)
+}
+
+// GetWorkflowJob records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobAPI) GetWorkflowJob(id int, params url.Values) (r0 *awx.WorkflowJob, r1 error) {
+	f.record("GetWorkflowJob", id, params)
+	if fn := f.GetWorkflowJobFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetWorkflowJobReturns scripts the results of GetWorkflowJob.
+func (f *WorkflowJobAPI) GetWorkflowJobReturns(r0 *awx.WorkflowJob, r1 error) {
+	f.GetWorkflowJobFunc = func(int, url.Values) (*awx.WorkflowJob, error) {
+		return r0, r1
+	}
+}
+
+// CancelWorkflowJob records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobAPI) CancelWorkflowJob(id int, data map[string]interface{}, params url.Values) (r0 *awx.CancelWorkflowJobResponse, r1 error) {
+	f.record("CancelWorkflowJob", id, data, params)
+	if fn := f.CancelWorkflowJobFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// CancelWorkflowJobReturns scripts the results of CancelWorkflowJob.
+func (f *WorkflowJobAPI) CancelWorkflowJobReturns(r0 *awx.CancelWorkflowJobResponse, r1 error) {
+	f.CancelWorkflowJobFunc = func(int, map[string]interface{}, url.Values) (*awx.CancelWorkflowJobResponse, error) {
+		return r0, r1
+	}
+}
+
+// RelaunchWorkflowJob records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobAPI) RelaunchWorkflowJob(id int, data map[string]interface{}, params url.Values) (r0 *awx.WorkflowJobLaunch, r1 error) {
+	f.record("RelaunchWorkflowJob", id, data, params)
+	if fn := f.RelaunchWorkflowJobFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// RelaunchWorkflowJobReturns scripts the results of RelaunchWorkflowJob.
+func (f *WorkflowJobAPI) RelaunchWorkflowJobReturns(r0 *awx.WorkflowJobLaunch, r1 error) {
+	f.RelaunchWorkflowJobFunc = func(int, map[string]interface{}, url.Values) (*awx.WorkflowJobLaunch, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.JobTemplateAPI = (*JobTemplateAPI)(nil)
+
+// JobTemplateAPI is an in-memory fake of awx.JobTemplateAPI.
+type JobTemplateAPI struct {
+	Recorder
+
+	GetJobTemplateByIDFunc      func(int, url.Values) (*awx.JobTemplate, error)
+	GetByNamedURLFunc           func(string, url.Values) (*awx.JobTemplate, error)
+	GetSurveySpecFunc           func(int) (*awx.SurveySpec, error)
+	FindByNameFunc              func(context.Context, string, awx.Scope) (*awx.JobTemplate, error)
+	ListJobTemplatesFunc        func(url.Values) ([]*awx.JobTemplate, *awx.ListJobTemplatesResponse, error)
+	LaunchFunc                  func(int, map[string]interface{}, url.Values) (*awx.JobLaunch, error)
+	CreateJobTemplateFunc       func(map[string]interface{}, url.Values) (*awx.JobTemplate, error)
+	UpdateJobTemplateFunc       func(int, map[string]interface{}, url.Values) (*awx.JobTemplate, error)
+	DeleteJobTemplateFunc       func(int) (*awx.JobTemplate, error)
+	DisAssociateCredentialsFunc func(int, map[string]interface{}, url.Values) (*awx.JobTemplate, error)
+	AssociateCredentialsFunc    func(int, map[string]interface{}, url.Values) (*awx.JobTemplate, error)
+}
+
+// GetJobTemplateByID records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateAPI) GetJobTemplateByID(id int, params url.Values) (r0 *awx.JobTemplate, r1 error) {
+	f.record("GetJobTemplateByID", id, params)
+	if fn := f.GetJobTemplateByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetJobTemplateByIDReturns scripts the results of GetJobTemplateByID.
+func (f *JobTemplateAPI) GetJobTemplateByIDReturns(r0 *awx.JobTemplate, r1 error) {
+	f.GetJobTemplateByIDFunc = func(int, url.Values) (*awx.JobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.JobTemplate, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *JobTemplateAPI) GetByNamedURLReturns(r0 *awx.JobTemplate, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.JobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// GetSurveySpec records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateAPI) GetSurveySpec(id int) (r0 *awx.SurveySpec, r1 error) {
+	f.record("GetSurveySpec", id)
+	if fn := f.GetSurveySpecFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// GetSurveySpecReturns scripts the results of GetSurveySpec.
+func (f *JobTemplateAPI) GetSurveySpecReturns(r0 *awx.SurveySpec, r1 error) {
+	f.GetSurveySpecFunc = func(int) (*awx.SurveySpec, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.JobTemplate, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *JobTemplateAPI) FindByNameReturns(r0 *awx.JobTemplate, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.JobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// ListJobTemplates records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateAPI) ListJobTemplates(params url.Values) (r0 []*awx.JobTemplate, r1 *awx.ListJobTemplatesResponse, r2 error) {
+	f.record("ListJobTemplates", params)
+	if fn := f.ListJobTemplatesFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListJobTemplatesReturns scripts the results of ListJobTemplates.
+func (f *JobTemplateAPI) ListJobTemplatesReturns(r0 []*awx.JobTemplate, r1 *awx.ListJobTemplatesResponse, r2 error) {
+	f.ListJobTemplatesFunc = func(url.Values) ([]*awx.JobTemplate, *awx.ListJobTemplatesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// Launch records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateAPI) Launch(id int, data map[string]interface{}, params url.Values) (r0 *awx.JobLaunch, r1 error) {
+	f.record("Launch", id, data, params)
+	if fn := f.LaunchFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// LaunchReturns scripts the results of Launch.
+func (f *JobTemplateAPI) LaunchReturns(r0 *awx.JobLaunch, r1 error) {
+	f.LaunchFunc = func(int, map[string]interface{}, url.Values) (*awx.JobLaunch, error) {
+		return r0, r1
+	}
+}
+
+// CreateJobTemplate records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateAPI) CreateJobTemplate(data map[string]interface{}, params url.Values) (r0 *awx.JobTemplate, r1 error) {
+	f.record("CreateJobTemplate", data, params)
+	if fn := f.CreateJobTemplateFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateJobTemplateReturns scripts the results of CreateJobTemplate.
+func (f *JobTemplateAPI) CreateJobTemplateReturns(r0 *awx.JobTemplate, r1 error) {
+	f.CreateJobTemplateFunc = func(map[string]interface{}, url.Values) (*awx.JobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// UpdateJobTemplate records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateAPI) UpdateJobTemplate(id int, data map[string]interface{}, params url.Values) (r0 *awx.JobTemplate, r1 error) {
+	f.record("UpdateJobTemplate", id, data, params)
+	if fn := f.UpdateJobTemplateFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateJobTemplateReturns scripts the results of UpdateJobTemplate.
+func (f *JobTemplateAPI) UpdateJobTemplateReturns(r0 *awx.JobTemplate, r1 error) {
+	f.UpdateJobTemplateFunc = func(int, map[string]interface{}, url.Values) (*awx.JobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// DeleteJobTemplate records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateAPI) DeleteJobTemplate(id int) (r0 *awx.JobTemplate, r1 error) {
+	f.record("DeleteJobTemplate", id)
+	if fn := f.DeleteJobTemplateFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteJobTemplateReturns scripts the results of DeleteJobTemplate.
+func (f *JobTemplateAPI) DeleteJobTemplateReturns(r0 *awx.JobTemplate, r1 error) {
+	f.DeleteJobTemplateFunc = func(int) (*awx.JobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// DisAssociateCredentials records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateAPI) DisAssociateCredentials(id int, data map[string]interface{}, params url.Values) (r0 *awx.JobTemplate, r1 error) {
+	f.record("DisAssociateCredentials", id, data, params)
+	if fn := f.DisAssociateCredentialsFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// DisAssociateCredentialsReturns scripts the results of DisAssociateCredentials.
+func (f *JobTemplateAPI) DisAssociateCredentialsReturns(r0 *awx.JobTemplate, r1 error) {
+	f.DisAssociateCredentialsFunc = func(int, map[string]interface{}, url.Values) (*awx.JobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// AssociateCredentials records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateAPI) AssociateCredentials(id int, data map[string]interface{}, params url.Values) (r0 *awx.JobTemplate, r1 error) {
+	f.record("AssociateCredentials", id, data, params)
+	if fn := f.AssociateCredentialsFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// AssociateCredentialsReturns scripts the results of AssociateCredentials.
+func (f *JobTemplateAPI) AssociateCredentialsReturns(r0 *awx.JobTemplate, r1 error) {
+	f.AssociateCredentialsFunc = func(int, map[string]interface{}, url.Values) (*awx.JobTemplate, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.JobTemplateNotificationTemplatesAPI = (*JobTemplateNotificationTemplatesAPI)(nil)
+
+// JobTemplateNotificationTemplatesAPI is an in-memory fake of awx.JobTemplateNotificationTemplatesAPI.
+type JobTemplateNotificationTemplatesAPI struct {
+	Recorder
+
+	AssociateJobTemplateNotificationTemplatesErrorFunc      func(int, int) (*awx.NotificationTemplate, error)
+	AssociateJobTemplateNotificationTemplatesSuccessFunc    func(int, int) (*awx.NotificationTemplate, error)
+	AssociateJobTemplateNotificationTemplatesStartedFunc    func(int, int) (*awx.NotificationTemplate, error)
+	DisassociateJobTemplateNotificationTemplatesErrorFunc   func(int, int) (*awx.NotificationTemplate, error)
+	DisassociateJobTemplateNotificationTemplatesSuccessFunc func(int, int) (*awx.NotificationTemplate, error)
+	DisassociateJobTemplateNotificationTemplatesStartedFunc func(int, int) (*awx.NotificationTemplate, error)
+}
+
+// AssociateJobTemplateNotificationTemplatesError records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateNotificationTemplatesAPI) AssociateJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("AssociateJobTemplateNotificationTemplatesError", jobTemplateID, notificationTemplateID)
+	if fn := f.AssociateJobTemplateNotificationTemplatesErrorFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// AssociateJobTemplateNotificationTemplatesErrorReturns scripts the results of AssociateJobTemplateNotificationTemplatesError.
+func (f *JobTemplateNotificationTemplatesAPI) AssociateJobTemplateNotificationTemplatesErrorReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.AssociateJobTemplateNotificationTemplatesErrorFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// AssociateJobTemplateNotificationTemplatesSuccess records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateNotificationTemplatesAPI) AssociateJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("AssociateJobTemplateNotificationTemplatesSuccess", jobTemplateID, notificationTemplateID)
+	if fn := f.AssociateJobTemplateNotificationTemplatesSuccessFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// AssociateJobTemplateNotificationTemplatesSuccessReturns scripts the results of AssociateJobTemplateNotificationTemplatesSuccess.
+func (f *JobTemplateNotificationTemplatesAPI) AssociateJobTemplateNotificationTemplatesSuccessReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.AssociateJobTemplateNotificationTemplatesSuccessFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// AssociateJobTemplateNotificationTemplatesStarted records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateNotificationTemplatesAPI) AssociateJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("AssociateJobTemplateNotificationTemplatesStarted", jobTemplateID, notificationTemplateID)
+	if fn := f.AssociateJobTemplateNotificationTemplatesStartedFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// AssociateJobTemplateNotificationTemplatesStartedReturns scripts the results of AssociateJobTemplateNotificationTemplatesStarted.
+func (f *JobTemplateNotificationTemplatesAPI) AssociateJobTemplateNotificationTemplatesStartedReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.AssociateJobTemplateNotificationTemplatesStartedFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// DisassociateJobTemplateNotificationTemplatesError records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateNotificationTemplatesAPI) DisassociateJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("DisassociateJobTemplateNotificationTemplatesError", jobTemplateID, notificationTemplateID)
+	if fn := f.DisassociateJobTemplateNotificationTemplatesErrorFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// DisassociateJobTemplateNotificationTemplatesErrorReturns scripts the results of DisassociateJobTemplateNotificationTemplatesError.
+func (f *JobTemplateNotificationTemplatesAPI) DisassociateJobTemplateNotificationTemplatesErrorReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.DisassociateJobTemplateNotificationTemplatesErrorFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// DisassociateJobTemplateNotificationTemplatesSuccess records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateNotificationTemplatesAPI) DisassociateJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("DisassociateJobTemplateNotificationTemplatesSuccess", jobTemplateID, notificationTemplateID)
+	if fn := f.DisassociateJobTemplateNotificationTemplatesSuccessFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// DisassociateJobTemplateNotificationTemplatesSuccessReturns scripts the results of DisassociateJobTemplateNotificationTemplatesSuccess.
+func (f *JobTemplateNotificationTemplatesAPI) DisassociateJobTemplateNotificationTemplatesSuccessReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.DisassociateJobTemplateNotificationTemplatesSuccessFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// DisassociateJobTemplateNotificationTemplatesStarted records the call and returns the scripted results, zero values by default.
+func (f *JobTemplateNotificationTemplatesAPI) DisassociateJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("DisassociateJobTemplateNotificationTemplatesStarted", jobTemplateID, notificationTemplateID)
+	if fn := f.DisassociateJobTemplateNotificationTemplatesStartedFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// DisassociateJobTemplateNotificationTemplatesStartedReturns scripts the results of DisassociateJobTemplateNotificationTemplatesStarted.
+func (f *JobTemplateNotificationTemplatesAPI) DisassociateJobTemplateNotificationTemplatesStartedReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.DisassociateJobTemplateNotificationTemplatesStartedFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.ProjectAPI = (*ProjectAPI)(nil)
+
+// ProjectAPI is an in-memory fake of awx.ProjectAPI.
+type ProjectAPI struct {
+	Recorder
+
+	ListProjectsFunc   func(url.Values) ([]*awx.Project, *awx.ListProjectsResponse, error)
+	GetProjectByIDFunc func(int, url.Values) (*awx.Project, error)
+	GetByNamedURLFunc  func(string, url.Values) (*awx.Project, error)
+	FindByNameFunc     func(context.Context, string, awx.Scope) (*awx.Project, error)
+	CreateProjectFunc  func(map[string]interface{}, url.Values) (*awx.Project, error)
+	UpdateProjectFunc  func(int, map[string]interface{}, url.Values) (*awx.Project, error)
+	DeleteProjectFunc  func(int) (*awx.Project, error)
+}
+
+// ListProjects records the call and returns the scripted results, zero values by default.
+func (f *ProjectAPI) ListProjects(params url.Values) (r0 []*awx.Project, r1 *awx.ListProjectsResponse, r2 error) {
+	f.record("ListProjects", params)
+	if fn := f.ListProjectsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListProjectsReturns scripts the results of ListProjects.
+func (f *ProjectAPI) ListProjectsReturns(r0 []*awx.Project, r1 *awx.ListProjectsResponse, r2 error) {
+	f.ListProjectsFunc = func(url.Values) ([]*awx.Project, *awx.ListProjectsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetProjectByID records the call and returns the scripted results, zero values by default.
+func (f *ProjectAPI) GetProjectByID(id int, params url.Values) (r0 *awx.Project, r1 error) {
+	f.record("GetProjectByID", id, params)
+	if fn := f.GetProjectByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetProjectByIDReturns scripts the results of GetProjectByID.
+func (f *ProjectAPI) GetProjectByIDReturns(r0 *awx.Project, r1 error) {
+	f.GetProjectByIDFunc = func(int, url.Values) (*awx.Project, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *ProjectAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.Project, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *ProjectAPI) GetByNamedURLReturns(r0 *awx.Project, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.Project, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *ProjectAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.Project, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *ProjectAPI) FindByNameReturns(r0 *awx.Project, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.Project, error) {
+		return r0, r1
+	}
+}
+
+// CreateProject records the call and returns the scripted results, zero values by default.
+func (f *ProjectAPI) CreateProject(data map[string]interface{}, params url.Values) (r0 *awx.Project, r1 error) {
+	f.record("CreateProject", data, params)
+	if fn := f.CreateProjectFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateProjectReturns scripts the results of CreateProject.
+func (f *ProjectAPI) CreateProjectReturns(r0 *awx.Project, r1 error) {
+	f.CreateProjectFunc = func(map[string]interface{}, url.Values) (*awx.Project, error) {
+		return r0, r1
+	}
+}
+
+// UpdateProject records the call and returns the scripted results, zero values by default.
+func (f *ProjectAPI) UpdateProject(id int, data map[string]interface{}, params url.Values) (r0 *awx.Project, r1 error) {
+	f.record("UpdateProject", id, data, params)
+	if fn := f.UpdateProjectFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateProjectReturns scripts the results of UpdateProject.
+func (f *ProjectAPI) UpdateProjectReturns(r0 *awx.Project, r1 error) {
+	f.UpdateProjectFunc = func(int, map[string]interface{}, url.Values) (*awx.Project, error) {
+		return r0, r1
+	}
+}
+
+// DeleteProject records the call and returns the scripted results, zero values by default.
+func (f *ProjectAPI) DeleteProject(id int) (r0 *awx.Project, r1 error) {
+	f.record("DeleteProject", id)
+	if fn := f.DeleteProjectFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteProjectReturns scripts the results of DeleteProject.
+func (f *ProjectAPI) DeleteProjectReturns(r0 *awx.Project, r1 error) {
+	f.DeleteProjectFunc = func(int) (*awx.Project, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.ProjectUpdatesAPI = (*ProjectUpdatesAPI)(nil)
+
+// ProjectUpdatesAPI is an in-memory fake of awx.ProjectUpdatesAPI.
+type ProjectUpdatesAPI struct {
+	Recorder
+
+	ProjectUpdateCancelFunc func(int) (*awx.ProjectUpdateCancel, error)
+	ProjectUpdateGetFunc    func(int) (*awx.Job, error)
+}
+
+// ProjectUpdateCancel records the call and returns the scripted results, zero values by default.
+func (f *ProjectUpdatesAPI) ProjectUpdateCancel(id int) (r0 *awx.ProjectUpdateCancel, r1 error) {
+	f.record("ProjectUpdateCancel", id)
+	if fn := f.ProjectUpdateCancelFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// ProjectUpdateCancelReturns scripts the results of ProjectUpdateCancel.
+func (f *ProjectUpdatesAPI) ProjectUpdateCancelReturns(r0 *awx.ProjectUpdateCancel, r1 error) {
+	f.ProjectUpdateCancelFunc = func(int) (*awx.ProjectUpdateCancel, error) {
+		return r0, r1
+	}
+}
+
+// ProjectUpdateGet records the call and returns the scripted results, zero values by default.
+func (f *ProjectUpdatesAPI) ProjectUpdateGet(id int) (r0 *awx.Job, r1 error) {
+	f.record("ProjectUpdateGet", id)
+	if fn := f.ProjectUpdateGetFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// ProjectUpdateGetReturns scripts the results of ProjectUpdateGet.
+func (f *ProjectUpdatesAPI) ProjectUpdateGetReturns(r0 *awx.Job, r1 error) {
+	f.ProjectUpdateGetFunc = func(int) (*awx.Job, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.UserAPI = (*UserAPI)(nil)
+
+// UserAPI is an in-memory fake of awx.UserAPI.
+type UserAPI struct {
+	Recorder
+
+	ListUsersFunc                 func(url.Values) ([]*awx.User, *awx.ListUsersResponse, error)
+	CreateUserFunc                func(map[string]interface{}, url.Values) (*awx.User, error)
+	UpdateUserFunc                func(int, map[string]interface{}, url.Values) (*awx.User, error)
+	DeleteUserFunc                func(int) (*awx.User, error)
+	GetUserByIDFunc               func(int, url.Values) (*awx.User, error)
+	GetByNamedURLFunc             func(string, url.Values) (*awx.User, error)
+	FindByNameFunc                func(context.Context, string, awx.Scope) (*awx.User, error)
+	ListUserRoleEntitlementsFunc  func(int, url.Values) ([]*awx.ApplyRole, *awx.ListUsersEntitlementsResponse, error)
+	UpdateUserRoleEntitlementFunc func(int, map[string]interface{}, url.Values) (interface{}, error)
+}
+
+// ListUsers records the call and returns the scripted results, zero values by default.
+func (f *UserAPI) ListUsers(params url.Values) (r0 []*awx.User, r1 *awx.ListUsersResponse, r2 error) {
+	f.record("ListUsers", params)
+	if fn := f.ListUsersFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListUsersReturns scripts the results of ListUsers.
+func (f *UserAPI) ListUsersReturns(r0 []*awx.User, r1 *awx.ListUsersResponse, r2 error) {
+	f.ListUsersFunc = func(url.Values) ([]*awx.User, *awx.ListUsersResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateUser records the call and returns the scripted results, zero values by default.
+func (f *UserAPI) CreateUser(data map[string]interface{}, params url.Values) (r0 *awx.User, r1 error) {
+	f.record("CreateUser", data, params)
+	if fn := f.CreateUserFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateUserReturns scripts the results of CreateUser.
+func (f *UserAPI) CreateUserReturns(r0 *awx.User, r1 error) {
+	f.CreateUserFunc = func(map[string]interface{}, url.Values) (*awx.User, error) {
+		return r0, r1
+	}
+}
+
+// UpdateUser records the call and returns the scripted results, zero values by default.
+func (f *UserAPI) UpdateUser(id int, data map[string]interface{}, params url.Values) (r0 *awx.User, r1 error) {
+	f.record("UpdateUser", id, data, params)
+	if fn := f.UpdateUserFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateUserReturns scripts the results of UpdateUser.
+func (f *UserAPI) UpdateUserReturns(r0 *awx.User, r1 error) {
+	f.UpdateUserFunc = func(int, map[string]interface{}, url.Values) (*awx.User, error) {
+		return r0, r1
+	}
+}
+
+// DeleteUser records the call and returns the scripted results, zero values by default.
+func (f *UserAPI) DeleteUser(id int) (r0 *awx.User, r1 error) {
+	f.record("DeleteUser", id)
+	if fn := f.DeleteUserFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteUserReturns scripts the results of DeleteUser.
+func (f *UserAPI) DeleteUserReturns(r0 *awx.User, r1 error) {
+	f.DeleteUserFunc = func(int) (*awx.User, error) {
+		return r0, r1
+	}
+}
+
+// GetUserByID records the call and returns the scripted results, zero values by default.
+func (f *UserAPI) GetUserByID(id int, params url.Values) (r0 *awx.User, r1 error) {
+	f.record("GetUserByID", id, params)
+	if fn := f.GetUserByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetUserByIDReturns scripts the results of GetUserByID.
+func (f *UserAPI) GetUserByIDReturns(r0 *awx.User, r1 error) {
+	f.GetUserByIDFunc = func(int, url.Values) (*awx.User, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *UserAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.User, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *UserAPI) GetByNamedURLReturns(r0 *awx.User, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.User, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *UserAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.User, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *UserAPI) FindByNameReturns(r0 *awx.User, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.User, error) {
+		return r0, r1
+	}
+}
+
+// ListUserRoleEntitlements records the call and returns the scripted results, zero values by default.
+func (f *UserAPI) ListUserRoleEntitlements(id int, params url.Values) (r0 []*awx.ApplyRole, r1 *awx.ListUsersEntitlementsResponse, r2 error) {
+	f.record("ListUserRoleEntitlements", id, params)
+	if fn := f.ListUserRoleEntitlementsFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// ListUserRoleEntitlementsReturns scripts the results of ListUserRoleEntitlements.
+func (f *UserAPI) ListUserRoleEntitlementsReturns(r0 []*awx.ApplyRole, r1 *awx.ListUsersEntitlementsResponse, r2 error) {
+	f.ListUserRoleEntitlementsFunc = func(int, url.Values) ([]*awx.ApplyRole, *awx.ListUsersEntitlementsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// UpdateUserRoleEntitlement records the call and returns the scripted results, zero values by default.
+func (f *UserAPI) UpdateUserRoleEntitlement(id int, data map[string]interface{}, params url.Values) (r0 interface{}, r1 error) {
+	f.record("UpdateUserRoleEntitlement", id, data, params)
+	if fn := f.UpdateUserRoleEntitlementFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateUserRoleEntitlementReturns scripts the results of UpdateUserRoleEntitlement.
+func (f *UserAPI) UpdateUserRoleEntitlementReturns(r0 interface{}, r1 error) {
+	f.UpdateUserRoleEntitlementFunc = func(int, map[string]interface{}, url.Values) (interface{}, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.GroupAPI = (*GroupAPI)(nil)
+
+// GroupAPI is an in-memory fake of awx.GroupAPI.
+type GroupAPI struct {
+	Recorder
+
+	GetGroupByIDFunc  func(int, url.Values) (*awx.Group, error)
+	GetByNamedURLFunc func(string, url.Values) (*awx.Group, error)
+	FindByNameFunc    func(context.Context, string, awx.Scope) (*awx.Group, error)
+	ListGroupsFunc    func(url.Values) ([]*awx.Group, *awx.ListGroupsResponse, error)
+	CreateGroupFunc   func(map[string]interface{}, url.Values) (*awx.Group, error)
+	UpdateGroupFunc   func(int, map[string]interface{}, url.Values) (*awx.Group, error)
+	DeleteGroupFunc   func(int) (*awx.Group, error)
+}
+
+// GetGroupByID records the call and returns the scripted results, zero values by default.
+func (f *GroupAPI) GetGroupByID(id int, params url.Values) (r0 *awx.Group, r1 error) {
+	f.record("GetGroupByID", id, params)
+	if fn := f.GetGroupByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetGroupByIDReturns scripts the results of GetGroupByID.
+func (f *GroupAPI) GetGroupByIDReturns(r0 *awx.Group, r1 error) {
+	f.GetGroupByIDFunc = func(int, url.Values) (*awx.Group, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *GroupAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.Group, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *GroupAPI) GetByNamedURLReturns(r0 *awx.Group, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.Group, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *GroupAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.Group, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *GroupAPI) FindByNameReturns(r0 *awx.Group, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.Group, error) {
+		return r0, r1
+	}
+}
+
+// ListGroups records the call and returns the scripted results, zero values by default.
+func (f *GroupAPI) ListGroups(params url.Values) (r0 []*awx.Group, r1 *awx.ListGroupsResponse, r2 error) {
+	f.record("ListGroups", params)
+	if fn := f.ListGroupsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListGroupsReturns scripts the results of ListGroups.
+func (f *GroupAPI) ListGroupsReturns(r0 []*awx.Group, r1 *awx.ListGroupsResponse, r2 error) {
+	f.ListGroupsFunc = func(url.Values) ([]*awx.Group, *awx.ListGroupsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateGroup records the call and returns the scripted results, zero values by default.
+func (f *GroupAPI) CreateGroup(data map[string]interface{}, params url.Values) (r0 *awx.Group, r1 error) {
+	f.record("CreateGroup", data, params)
+	if fn := f.CreateGroupFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateGroupReturns scripts the results of CreateGroup.
+func (f *GroupAPI) CreateGroupReturns(r0 *awx.Group, r1 error) {
+	f.CreateGroupFunc = func(map[string]interface{}, url.Values) (*awx.Group, error) {
+		return r0, r1
+	}
+}
+
+// UpdateGroup records the call and returns the scripted results, zero values by default.
+func (f *GroupAPI) UpdateGroup(id int, data map[string]interface{}, params url.Values) (r0 *awx.Group, r1 error) {
+	f.record("UpdateGroup", id, data, params)
+	if fn := f.UpdateGroupFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateGroupReturns scripts the results of UpdateGroup.
+func (f *GroupAPI) UpdateGroupReturns(r0 *awx.Group, r1 error) {
+	f.UpdateGroupFunc = func(int, map[string]interface{}, url.Values) (*awx.Group, error) {
+		return r0, r1
+	}
+}
+
+// DeleteGroup records the call and returns the scripted results, zero values by default.
+func (f *GroupAPI) DeleteGroup(id int) (r0 *awx.Group, r1 error) {
+	f.record("DeleteGroup", id)
+	if fn := f.DeleteGroupFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteGroupReturns scripts the results of DeleteGroup.
+func (f *GroupAPI) DeleteGroupReturns(r0 *awx.Group, r1 error) {
+	f.DeleteGroupFunc = func(int) (*awx.Group, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.HostAPI = (*HostAPI)(nil)
+
+// HostAPI is an in-memory fake of awx.HostAPI.
+type HostAPI struct {
+	Recorder
+
+	GetHostByIDFunc       func(int, url.Values) (*awx.Host, error)
+	GetByNamedURLFunc     func(string, url.Values) (*awx.Host, error)
+	FindByNameFunc        func(context.Context, string, awx.Scope) (*awx.Host, error)
+	ListHostsFunc         func(url.Values) ([]*awx.Host, *awx.ListHostsResponse, error)
+	CreateHostFunc        func(map[string]interface{}, url.Values) (*awx.Host, error)
+	UpdateHostFunc        func(int, map[string]interface{}, url.Values) (*awx.Host, error)
+	AssociateGroupFunc    func(int, map[string]interface{}, url.Values) (*awx.Host, error)
+	DisAssociateGroupFunc func(int, map[string]interface{}, url.Values) (*awx.Host, error)
+	DeleteHostFunc        func(int) (*awx.Host, error)
+}
+
+// GetHostByID records the call and returns the scripted results, zero values by default.
+func (f *HostAPI) GetHostByID(id int, params url.Values) (r0 *awx.Host, r1 error) {
+	f.record("GetHostByID", id, params)
+	if fn := f.GetHostByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetHostByIDReturns scripts the results of GetHostByID.
+func (f *HostAPI) GetHostByIDReturns(r0 *awx.Host, r1 error) {
+	f.GetHostByIDFunc = func(int, url.Values) (*awx.Host, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *HostAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.Host, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *HostAPI) GetByNamedURLReturns(r0 *awx.Host, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.Host, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *HostAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.Host, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *HostAPI) FindByNameReturns(r0 *awx.Host, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.Host, error) {
+		return r0, r1
+	}
+}
+
+// ListHosts records the call and returns the scripted results, zero values by default.
+func (f *HostAPI) ListHosts(params url.Values) (r0 []*awx.Host, r1 *awx.ListHostsResponse, r2 error) {
+	f.record("ListHosts", params)
+	if fn := f.ListHostsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListHostsReturns scripts the results of ListHosts.
+func (f *HostAPI) ListHostsReturns(r0 []*awx.Host, r1 *awx.ListHostsResponse, r2 error) {
+	f.ListHostsFunc = func(url.Values) ([]*awx.Host, *awx.ListHostsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateHost records the call and returns the scripted results, zero values by default.
+func (f *HostAPI) CreateHost(data map[string]interface{}, params url.Values) (r0 *awx.Host, r1 error) {
+	f.record("CreateHost", data, params)
+	if fn := f.CreateHostFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateHostReturns scripts the results of CreateHost.
+func (f *HostAPI) CreateHostReturns(r0 *awx.Host, r1 error) {
+	f.CreateHostFunc = func(map[string]interface{}, url.Values) (*awx.Host, error) {
+		return r0, r1
+	}
+}
+
+// UpdateHost records the call and returns the scripted results, zero values by default.
+func (f *HostAPI) UpdateHost(id int, data map[string]interface{}, params url.Values) (r0 *awx.Host, r1 error) {
+	f.record("UpdateHost", id, data, params)
+	if fn := f.UpdateHostFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateHostReturns scripts the results of UpdateHost.
+func (f *HostAPI) UpdateHostReturns(r0 *awx.Host, r1 error) {
+	f.UpdateHostFunc = func(int, map[string]interface{}, url.Values) (*awx.Host, error) {
+		return r0, r1
+	}
+}
+
+// AssociateGroup records the call and returns the scripted results, zero values by default.
+func (f *HostAPI) AssociateGroup(id int, data map[string]interface{}, params url.Values) (r0 *awx.Host, r1 error) {
+	f.record("AssociateGroup", id, data, params)
+	if fn := f.AssociateGroupFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// AssociateGroupReturns scripts the results of AssociateGroup.
+func (f *HostAPI) AssociateGroupReturns(r0 *awx.Host, r1 error) {
+	f.AssociateGroupFunc = func(int, map[string]interface{}, url.Values) (*awx.Host, error) {
+		return r0, r1
+	}
+}
+
+// DisAssociateGroup records the call and returns the scripted results, zero values by default.
+func (f *HostAPI) DisAssociateGroup(id int, data map[string]interface{}, params url.Values) (r0 *awx.Host, r1 error) {
+	f.record("DisAssociateGroup", id, data, params)
+	if fn := f.DisAssociateGroupFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// DisAssociateGroupReturns scripts the results of DisAssociateGroup.
+func (f *HostAPI) DisAssociateGroupReturns(r0 *awx.Host, r1 error) {
+	f.DisAssociateGroupFunc = func(int, map[string]interface{}, url.Values) (*awx.Host, error) {
+		return r0, r1
+	}
+}
+
+// DeleteHost records the call and returns the scripted results, zero values by default.
+func (f *HostAPI) DeleteHost(id int) (r0 *awx.Host, r1 error) {
+	f.record("DeleteHost", id)
+	if fn := f.DeleteHostFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteHostReturns scripts the results of DeleteHost.
+func (f *HostAPI) DeleteHostReturns(r0 *awx.Host, r1 error) {
+	f.DeleteHostFunc = func(int) (*awx.Host, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.HostMetricsAPI = (*HostMetricsAPI)(nil)
+
+// HostMetricsAPI is an in-memory fake of awx.HostMetricsAPI.
+type HostMetricsAPI struct {
+	Recorder
+
+	ListHostMetricsFunc   func(url.Values) ([]*awx.HostMetric, *awx.ListHostMetricsResponse, error)
+	GetHostMetricByIDFunc func(int, url.Values) (*awx.HostMetric, error)
+	DeleteHostMetricFunc  func(int) error
+}
+
+// ListHostMetrics records the call and returns the scripted results, zero values by default.
+func (f *HostMetricsAPI) ListHostMetrics(params url.Values) (r0 []*awx.HostMetric, r1 *awx.ListHostMetricsResponse, r2 error) {
+	f.record("ListHostMetrics", params)
+	if fn := f.ListHostMetricsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListHostMetricsReturns scripts the results of ListHostMetrics.
+func (f *HostMetricsAPI) ListHostMetricsReturns(r0 []*awx.HostMetric, r1 *awx.ListHostMetricsResponse, r2 error) {
+	f.ListHostMetricsFunc = func(url.Values) ([]*awx.HostMetric, *awx.ListHostMetricsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetHostMetricByID records the call and returns the scripted results, zero values by default.
+func (f *HostMetricsAPI) GetHostMetricByID(id int, params url.Values) (r0 *awx.HostMetric, r1 error) {
+	f.record("GetHostMetricByID", id, params)
+	if fn := f.GetHostMetricByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetHostMetricByIDReturns scripts the results of GetHostMetricByID.
+func (f *HostMetricsAPI) GetHostMetricByIDReturns(r0 *awx.HostMetric, r1 error) {
+	f.GetHostMetricByIDFunc = func(int, url.Values) (*awx.HostMetric, error) {
+		return r0, r1
+	}
+}
+
+// DeleteHostMetric records the call and returns the scripted results, zero values by default.
+func (f *HostMetricsAPI) DeleteHostMetric(id int) (r0 error) {
+	f.record("DeleteHostMetric", id)
+	if fn := f.DeleteHostMetricFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteHostMetricReturns scripts the results of DeleteHostMetric.
+func (f *HostMetricsAPI) DeleteHostMetricReturns(r0 error) {
+	f.DeleteHostMetricFunc = func(int) error {
+		return r0
+	}
+}
+
+var _ awx.HostMetricSummaryMonthlyAPI = (*HostMetricSummaryMonthlyAPI)(nil)
+
+// HostMetricSummaryMonthlyAPI is an in-memory fake of awx.HostMetricSummaryMonthlyAPI.
+type HostMetricSummaryMonthlyAPI struct {
+	Recorder
+
+	ListHostMetricSummaryMonthlyFunc func(url.Values) ([]*awx.HostMetricSummaryMonthly, *awx.ListHostMetricSummaryMonthlyResponse, error)
+}
+
+// ListHostMetricSummaryMonthly records the call and returns the scripted results, zero values by default.
+func (f *HostMetricSummaryMonthlyAPI) ListHostMetricSummaryMonthly(params url.Values) (r0 []*awx.HostMetricSummaryMonthly, r1 *awx.ListHostMetricSummaryMonthlyResponse, r2 error) {
+	f.record("ListHostMetricSummaryMonthly", params)
+	if fn := f.ListHostMetricSummaryMonthlyFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListHostMetricSummaryMonthlyReturns scripts the results of ListHostMetricSummaryMonthly.
+func (f *HostMetricSummaryMonthlyAPI) ListHostMetricSummaryMonthlyReturns(r0 []*awx.HostMetricSummaryMonthly, r1 *awx.ListHostMetricSummaryMonthlyResponse, r2 error) {
+	f.ListHostMetricSummaryMonthlyFunc = func(url.Values) ([]*awx.HostMetricSummaryMonthly, *awx.ListHostMetricSummaryMonthlyResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+var _ awx.CredentialsAPI = (*CredentialsAPI)(nil)
+
+// CredentialsAPI is an in-memory fake of awx.CredentialsAPI.
+type CredentialsAPI struct {
+	Recorder
+
+	ListCredentialsFunc       func(url.Values) ([]*awx.Credential, error)
+	CreateCredentialsFunc     func(map[string]interface{}, url.Values) (*awx.Credential, error)
+	GetCredentialsByIDFunc    func(int, url.Values) (*awx.Credential, error)
+	UpdateCredentialsByIDFunc func(int, map[string]interface{}, url.Values) (*awx.Credential, error)
+	DeleteCredentialsByIDFunc func(int, url.Values) error
+	GetByNamedURLFunc         func(string, url.Values) (*awx.Credential, error)
+	FindByNameFunc            func(context.Context, string, awx.Scope) (*awx.Credential, error)
+}
+
+// ListCredentials records the call and returns the scripted results, zero values by default.
+func (f *CredentialsAPI) ListCredentials(params url.Values) (r0 []*awx.Credential, r1 error) {
+	f.record("ListCredentials", params)
+	if fn := f.ListCredentialsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListCredentialsReturns scripts the results of ListCredentials.
+func (f *CredentialsAPI) ListCredentialsReturns(r0 []*awx.Credential, r1 error) {
+	f.ListCredentialsFunc = func(url.Values) ([]*awx.Credential, error) {
+		return r0, r1
+	}
+}
+
+// CreateCredentials records the call and returns the scripted results, zero values by default.
+func (f *CredentialsAPI) CreateCredentials(data map[string]interface{}, params url.Values) (r0 *awx.Credential, r1 error) {
+	f.record("CreateCredentials", data, params)
+	if fn := f.CreateCredentialsFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateCredentialsReturns scripts the results of CreateCredentials.
+func (f *CredentialsAPI) CreateCredentialsReturns(r0 *awx.Credential, r1 error) {
+	f.CreateCredentialsFunc = func(map[string]interface{}, url.Values) (*awx.Credential, error) {
+		return r0, r1
+	}
+}
+
+// GetCredentialsByID records the call and returns the scripted results, zero values by default.
+func (f *CredentialsAPI) GetCredentialsByID(id int, params url.Values) (r0 *awx.Credential, r1 error) {
+	f.record("GetCredentialsByID", id, params)
+	if fn := f.GetCredentialsByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetCredentialsByIDReturns scripts the results of GetCredentialsByID.
+func (f *CredentialsAPI) GetCredentialsByIDReturns(r0 *awx.Credential, r1 error) {
+	f.GetCredentialsByIDFunc = func(int, url.Values) (*awx.Credential, error) {
+		return r0, r1
+	}
+}
+
+// UpdateCredentialsByID records the call and returns the scripted results, zero values by default.
+func (f *CredentialsAPI) UpdateCredentialsByID(id int, data map[string]interface{}, params url.Values) (r0 *awx.Credential, r1 error) {
+	f.record("UpdateCredentialsByID", id, data, params)
+	if fn := f.UpdateCredentialsByIDFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateCredentialsByIDReturns scripts the results of UpdateCredentialsByID.
+func (f *CredentialsAPI) UpdateCredentialsByIDReturns(r0 *awx.Credential, r1 error) {
+	f.UpdateCredentialsByIDFunc = func(int, map[string]interface{}, url.Values) (*awx.Credential, error) {
+		return r0, r1
+	}
+}
+
+// DeleteCredentialsByID records the call and returns the scripted results, zero values by default.
+func (f *CredentialsAPI) DeleteCredentialsByID(id int, params url.Values) (r0 error) {
+	f.record("DeleteCredentialsByID", id, params)
+	if fn := f.DeleteCredentialsByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// DeleteCredentialsByIDReturns scripts the results of DeleteCredentialsByID.
+func (f *CredentialsAPI) DeleteCredentialsByIDReturns(r0 error) {
+	f.DeleteCredentialsByIDFunc = func(int, url.Values) error {
+		return r0
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *CredentialsAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.Credential, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *CredentialsAPI) GetByNamedURLReturns(r0 *awx.Credential, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.Credential, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *CredentialsAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.Credential, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *CredentialsAPI) FindByNameReturns(r0 *awx.Credential, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.Credential, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.CredentialTypeAPI = (*CredentialTypeAPI)(nil)
+
+// CredentialTypeAPI is an in-memory fake of awx.CredentialTypeAPI.
+type CredentialTypeAPI struct {
+	Recorder
+
+	ListCredentialTypesFunc      func(url.Values) ([]*awx.CredentialType, *awx.ListCredentialTypeResponse, error)
+	CreateCredentialTypeFunc     func(map[string]interface{}, url.Values) (*awx.CredentialType, error)
+	GetCredentialTypeByIDFunc    func(int, url.Values) (*awx.CredentialType, error)
+	UpdateCredentialTypeByIDFunc func(int, map[string]interface{}, url.Values) (*awx.CredentialType, error)
+	DeleteCredentialTypeByIDFunc func(int, url.Values) error
+	GetByNamedURLFunc            func(string, url.Values) (*awx.CredentialType, error)
+	FindByNameFunc               func(context.Context, string, awx.Scope) (*awx.CredentialType, error)
+}
+
+// ListCredentialTypes records the call and returns the scripted results, zero values by default.
+func (f *CredentialTypeAPI) ListCredentialTypes(params url.Values) (r0 []*awx.CredentialType, r1 *awx.ListCredentialTypeResponse, r2 error) {
+	f.record("ListCredentialTypes", params)
+	if fn := f.ListCredentialTypesFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListCredentialTypesReturns scripts the results of ListCredentialTypes.
+func (f *CredentialTypeAPI) ListCredentialTypesReturns(r0 []*awx.CredentialType, r1 *awx.ListCredentialTypeResponse, r2 error) {
+	f.ListCredentialTypesFunc = func(url.Values) ([]*awx.CredentialType, *awx.ListCredentialTypeResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateCredentialType records the call and returns the scripted results, zero values by default.
+func (f *CredentialTypeAPI) CreateCredentialType(data map[string]interface{}, params url.Values) (r0 *awx.CredentialType, r1 error) {
+	f.record("CreateCredentialType", data, params)
+	if fn := f.CreateCredentialTypeFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateCredentialTypeReturns scripts the results of CreateCredentialType.
+func (f *CredentialTypeAPI) CreateCredentialTypeReturns(r0 *awx.CredentialType, r1 error) {
+	f.CreateCredentialTypeFunc = func(map[string]interface{}, url.Values) (*awx.CredentialType, error) {
+		return r0, r1
+	}
+}
+
+// GetCredentialTypeByID records the call and returns the scripted results, zero values by default.
+func (f *CredentialTypeAPI) GetCredentialTypeByID(id int, params url.Values) (r0 *awx.CredentialType, r1 error) {
+	f.record("GetCredentialTypeByID", id, params)
+	if fn := f.GetCredentialTypeByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetCredentialTypeByIDReturns scripts the results of GetCredentialTypeByID.
+func (f *CredentialTypeAPI) GetCredentialTypeByIDReturns(r0 *awx.CredentialType, r1 error) {
+	f.GetCredentialTypeByIDFunc = func(int, url.Values) (*awx.CredentialType, error) {
+		return r0, r1
+	}
+}
+
+// UpdateCredentialTypeByID records the call and returns the scripted results, zero values by default.
+func (f *CredentialTypeAPI) UpdateCredentialTypeByID(id int, data map[string]interface{}, params url.Values) (r0 *awx.CredentialType, r1 error) {
+	f.record("UpdateCredentialTypeByID", id, data, params)
+	if fn := f.UpdateCredentialTypeByIDFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateCredentialTypeByIDReturns scripts the results of UpdateCredentialTypeByID.
+func (f *CredentialTypeAPI) UpdateCredentialTypeByIDReturns(r0 *awx.CredentialType, r1 error) {
+	f.UpdateCredentialTypeByIDFunc = func(int, map[string]interface{}, url.Values) (*awx.CredentialType, error) {
+		return r0, r1
+	}
+}
+
+// DeleteCredentialTypeByID records the call and returns the scripted results, zero values by default.
+func (f *CredentialTypeAPI) DeleteCredentialTypeByID(id int, params url.Values) (r0 error) {
+	f.record("DeleteCredentialTypeByID", id, params)
+	if fn := f.DeleteCredentialTypeByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// DeleteCredentialTypeByIDReturns scripts the results of DeleteCredentialTypeByID.
+func (f *CredentialTypeAPI) DeleteCredentialTypeByIDReturns(r0 error) {
+	f.DeleteCredentialTypeByIDFunc = func(int, url.Values) error {
+		return r0
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *CredentialTypeAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.CredentialType, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *CredentialTypeAPI) GetByNamedURLReturns(r0 *awx.CredentialType, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.CredentialType, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *CredentialTypeAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.CredentialType, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *CredentialTypeAPI) FindByNameReturns(r0 *awx.CredentialType, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.CredentialType, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.CredentialInputSourceAPI = (*CredentialInputSourceAPI)(nil)
+
+// CredentialInputSourceAPI is an in-memory fake of awx.CredentialInputSourceAPI.
+type CredentialInputSourceAPI struct {
+	Recorder
+
+	ListCredentialInputSourcesFunc      func(url.Values) ([]*awx.CredentialInputSource, *awx.ListCredentialInputSourceResponse, error)
+	CreateCredentialInputSourceFunc     func(map[string]interface{}, url.Values) (*awx.CredentialInputSource, error)
+	GetCredentialInputSourceByIDFunc    func(int, url.Values) (*awx.CredentialInputSource, error)
+	UpdateCredentialInputSourceByIDFunc func(int, map[string]interface{}, url.Values) (*awx.CredentialInputSource, error)
+	DeleteCredentialInputSourceByIDFunc func(int, url.Values) error
+}
+
+// ListCredentialInputSources records the call and returns the scripted results, zero values by default.
+func (f *CredentialInputSourceAPI) ListCredentialInputSources(params url.Values) (r0 []*awx.CredentialInputSource, r1 *awx.ListCredentialInputSourceResponse, r2 error) {
+	f.record("ListCredentialInputSources", params)
+	if fn := f.ListCredentialInputSourcesFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListCredentialInputSourcesReturns scripts the results of ListCredentialInputSources.
+func (f *CredentialInputSourceAPI) ListCredentialInputSourcesReturns(r0 []*awx.CredentialInputSource, r1 *awx.ListCredentialInputSourceResponse, r2 error) {
+	f.ListCredentialInputSourcesFunc = func(url.Values) ([]*awx.CredentialInputSource, *awx.ListCredentialInputSourceResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateCredentialInputSource records the call and returns the scripted results, zero values by default.
+func (f *CredentialInputSourceAPI) CreateCredentialInputSource(data map[string]interface{}, params url.Values) (r0 *awx.CredentialInputSource, r1 error) {
+	f.record("CreateCredentialInputSource", data, params)
+	if fn := f.CreateCredentialInputSourceFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateCredentialInputSourceReturns scripts the results of CreateCredentialInputSource.
+func (f *CredentialInputSourceAPI) CreateCredentialInputSourceReturns(r0 *awx.CredentialInputSource, r1 error) {
+	f.CreateCredentialInputSourceFunc = func(map[string]interface{}, url.Values) (*awx.CredentialInputSource, error) {
+		return r0, r1
+	}
+}
+
+// GetCredentialInputSourceByID records the call and returns the scripted results, zero values by default.
+func (f *CredentialInputSourceAPI) GetCredentialInputSourceByID(id int, params url.Values) (r0 *awx.CredentialInputSource, r1 error) {
+	f.record("GetCredentialInputSourceByID", id, params)
+	if fn := f.GetCredentialInputSourceByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetCredentialInputSourceByIDReturns scripts the results of GetCredentialInputSourceByID.
+func (f *CredentialInputSourceAPI) GetCredentialInputSourceByIDReturns(r0 *awx.CredentialInputSource, r1 error) {
+	f.GetCredentialInputSourceByIDFunc = func(int, url.Values) (*awx.CredentialInputSource, error) {
+		return r0, r1
+	}
+}
+
+// UpdateCredentialInputSourceByID records the call and returns the scripted results, zero values by default.
+func (f *CredentialInputSourceAPI) UpdateCredentialInputSourceByID(id int, data map[string]interface{}, params url.Values) (r0 *awx.CredentialInputSource, r1 error) {
+	f.record("UpdateCredentialInputSourceByID", id, data, params)
+	if fn := f.UpdateCredentialInputSourceByIDFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateCredentialInputSourceByIDReturns scripts the results of UpdateCredentialInputSourceByID.
+func (f *CredentialInputSourceAPI) UpdateCredentialInputSourceByIDReturns(r0 *awx.CredentialInputSource, r1 error) {
+	f.UpdateCredentialInputSourceByIDFunc = func(int, map[string]interface{}, url.Values) (*awx.CredentialInputSource, error) {
+		return r0, r1
+	}
+}
+
+// DeleteCredentialInputSourceByID records the call and returns the scripted results, zero values by default.
+func (f *CredentialInputSourceAPI) DeleteCredentialInputSourceByID(id int, params url.Values) (r0 error) {
+	f.record("DeleteCredentialInputSourceByID", id, params)
+	if fn := f.DeleteCredentialInputSourceByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// DeleteCredentialInputSourceByIDReturns scripts the results of DeleteCredentialInputSourceByID.
+func (f *CredentialInputSourceAPI) DeleteCredentialInputSourceByIDReturns(r0 error) {
+	f.DeleteCredentialInputSourceByIDFunc = func(int, url.Values) error {
+		return r0
+	}
+}
+
+var _ awx.InventorySourcesAPI = (*InventorySourcesAPI)(nil)
+
+// InventorySourcesAPI is an in-memory fake of awx.InventorySourcesAPI.
+type InventorySourcesAPI struct {
+	Recorder
+
+	GetInventorySourceByIDFunc func(int, url.Values) (*awx.InventorySource, error)
+	GetByNamedURLFunc          func(string, url.Values) (*awx.InventorySource, error)
+	FindByNameFunc             func(context.Context, string, awx.Scope) (*awx.InventorySource, error)
+	ListInventorySourcesFunc   func(url.Values) ([]*awx.InventorySource, *awx.ListInventorySourcesResponse, error)
+	CreateInventorySourceFunc  func(map[string]interface{}, url.Values) (*awx.InventorySource, error)
+	UpdateInventorySourceFunc  func(int, map[string]interface{}, url.Values) (*awx.InventorySource, error)
+	GetInventorySourceFunc     func(int, url.Values) (*awx.InventorySource, error)
+	DeleteInventorySourceFunc  func(int) (*awx.InventorySource, error)
+}
+
+// GetInventorySourceByID records the call and returns the scripted results, zero values by default.
+func (f *InventorySourcesAPI) GetInventorySourceByID(id int, params url.Values) (r0 *awx.InventorySource, r1 error) {
+	f.record("GetInventorySourceByID", id, params)
+	if fn := f.GetInventorySourceByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetInventorySourceByIDReturns scripts the results of GetInventorySourceByID.
+func (f *InventorySourcesAPI) GetInventorySourceByIDReturns(r0 *awx.InventorySource, r1 error) {
+	f.GetInventorySourceByIDFunc = func(int, url.Values) (*awx.InventorySource, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *InventorySourcesAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.InventorySource, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *InventorySourcesAPI) GetByNamedURLReturns(r0 *awx.InventorySource, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.InventorySource, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *InventorySourcesAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.InventorySource, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *InventorySourcesAPI) FindByNameReturns(r0 *awx.InventorySource, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.InventorySource, error) {
+		return r0, r1
+	}
+}
+
+// ListInventorySources records the call and returns the scripted results, zero values by default.
+func (f *InventorySourcesAPI) ListInventorySources(params url.Values) (r0 []*awx.InventorySource, r1 *awx.ListInventorySourcesResponse, r2 error) {
+	f.record("ListInventorySources", params)
+	if fn := f.ListInventorySourcesFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListInventorySourcesReturns scripts the results of ListInventorySources.
+func (f *InventorySourcesAPI) ListInventorySourcesReturns(r0 []*awx.InventorySource, r1 *awx.ListInventorySourcesResponse, r2 error) {
+	f.ListInventorySourcesFunc = func(url.Values) ([]*awx.InventorySource, *awx.ListInventorySourcesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateInventorySource records the call and returns the scripted results, zero values by default.
+func (f *InventorySourcesAPI) CreateInventorySource(data map[string]interface{}, params url.Values) (r0 *awx.InventorySource, r1 error) {
+	f.record("CreateInventorySource", data, params)
+	if fn := f.CreateInventorySourceFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateInventorySourceReturns scripts the results of CreateInventorySource.
+func (f *InventorySourcesAPI) CreateInventorySourceReturns(r0 *awx.InventorySource, r1 error) {
+	f.CreateInventorySourceFunc = func(map[string]interface{}, url.Values) (*awx.InventorySource, error) {
+		return r0, r1
+	}
+}
+
+// UpdateInventorySource records the call and returns the scripted results, zero values by default.
+func (f *InventorySourcesAPI) UpdateInventorySource(id int, data map[string]interface{}, params url.Values) (r0 *awx.InventorySource, r1 error) {
+	f.record("UpdateInventorySource", id, data, params)
+	if fn := f.UpdateInventorySourceFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateInventorySourceReturns scripts the results of UpdateInventorySource.
+func (f *InventorySourcesAPI) UpdateInventorySourceReturns(r0 *awx.InventorySource, r1 error) {
+	f.UpdateInventorySourceFunc = func(int, map[string]interface{}, url.Values) (*awx.InventorySource, error) {
+		return r0, r1
+	}
+}
+
+// GetInventorySource records the call and returns the scripted results, zero values by default.
+func (f *InventorySourcesAPI) GetInventorySource(id int, params url.Values) (r0 *awx.InventorySource, r1 error) {
+	f.record("GetInventorySource", id, params)
+	if fn := f.GetInventorySourceFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetInventorySourceReturns scripts the results of GetInventorySource.
+func (f *InventorySourcesAPI) GetInventorySourceReturns(r0 *awx.InventorySource, r1 error) {
+	f.GetInventorySourceFunc = func(int, url.Values) (*awx.InventorySource, error) {
+		return r0, r1
+	}
+}
+
+// DeleteInventorySource records the call and returns the scripted results, zero values by default.
+func (f *InventorySourcesAPI) DeleteInventorySource(id int) (r0 *awx.InventorySource, r1 error) {
+	f.record("DeleteInventorySource", id)
+	if fn := f.DeleteInventorySourceFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteInventorySourceReturns scripts the results of DeleteInventorySource.
+func (f *InventorySourcesAPI) DeleteInventorySourceReturns(r0 *awx.InventorySource, r1 error) {
+	f.DeleteInventorySourceFunc = func(int) (*awx.InventorySource, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.InventorySourcesSchedulesAPI = (*InventorySourcesSchedulesAPI)(nil)
+
+// InventorySourcesSchedulesAPI is an in-memory fake of awx.InventorySourcesSchedulesAPI.
+type InventorySourcesSchedulesAPI struct {
+	Recorder
+
+	ListInventorySourcesSchedulesFunc  func(int, url.Values) ([]*awx.Schedule, *awx.ListSchedulesResponse, error)
+	CreateInventorySourcesScheduleFunc func(int, map[string]interface{}, url.Values) (*awx.Schedule, error)
+}
+
+// ListInventorySourcesSchedules records the call and returns the scripted results, zero values by default.
+func (f *InventorySourcesSchedulesAPI) ListInventorySourcesSchedules(id int, params url.Values) (r0 []*awx.Schedule, r1 *awx.ListSchedulesResponse, r2 error) {
+	f.record("ListInventorySourcesSchedules", id, params)
+	if fn := f.ListInventorySourcesSchedulesFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// ListInventorySourcesSchedulesReturns scripts the results of ListInventorySourcesSchedules.
+func (f *InventorySourcesSchedulesAPI) ListInventorySourcesSchedulesReturns(r0 []*awx.Schedule, r1 *awx.ListSchedulesResponse, r2 error) {
+	f.ListInventorySourcesSchedulesFunc = func(int, url.Values) ([]*awx.Schedule, *awx.ListSchedulesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateInventorySourcesSchedule records the call and returns the scripted results, zero values by default.
+func (f *InventorySourcesSchedulesAPI) CreateInventorySourcesSchedule(id int, data map[string]interface{}, params url.Values) (r0 *awx.Schedule, r1 error) {
+	f.record("CreateInventorySourcesSchedule", id, data, params)
+	if fn := f.CreateInventorySourcesScheduleFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// CreateInventorySourcesScheduleReturns scripts the results of CreateInventorySourcesSchedule.
+func (f *InventorySourcesSchedulesAPI) CreateInventorySourcesScheduleReturns(r0 *awx.Schedule, r1 error) {
+	f.CreateInventorySourcesScheduleFunc = func(int, map[string]interface{}, url.Values) (*awx.Schedule, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.InventoryGroupAPI = (*InventoryGroupAPI)(nil)
+
+// InventoryGroupAPI is an in-memory fake of awx.InventoryGroupAPI.
+type InventoryGroupAPI struct {
+	Recorder
+
+	ListInventoryGroupsFunc func(int, url.Values) ([]*awx.Group, *awx.ListGroupsResponse, error)
+}
+
+// ListInventoryGroups records the call and returns the scripted results, zero values by default.
+func (f *InventoryGroupAPI) ListInventoryGroups(id int, params url.Values) (r0 []*awx.Group, r1 *awx.ListGroupsResponse, r2 error) {
+	f.record("ListInventoryGroups", id, params)
+	if fn := f.ListInventoryGroupsFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// ListInventoryGroupsReturns scripts the results of ListInventoryGroups.
+func (f *InventoryGroupAPI) ListInventoryGroupsReturns(r0 []*awx.Group, r1 *awx.ListGroupsResponse, r2 error) {
+	f.ListInventoryGroupsFunc = func(int, url.Values) ([]*awx.Group, *awx.ListGroupsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+var _ awx.InstanceGroupsAPI = (*InstanceGroupsAPI)(nil)
+
+// InstanceGroupsAPI is an in-memory fake of awx.InstanceGroupsAPI.
+type InstanceGroupsAPI struct {
+	Recorder
+
+	ListInstanceGroupsFunc   func(url.Values) ([]*awx.InstanceGroup, *awx.ListInstanceGroupsResponse, error)
+	GetInstanceGroupByIDFunc func(int, url.Values) (*awx.InstanceGroup, error)
+	GetByNamedURLFunc        func(string, url.Values) (*awx.InstanceGroup, error)
+	FindByNameFunc           func(context.Context, string, awx.Scope) (*awx.InstanceGroup, error)
+	CreateInstanceGroupFunc  func(map[string]interface{}, url.Values) (*awx.InstanceGroup, error)
+	UpdateInstanceGroupFunc  func(int, map[string]interface{}, url.Values) (*awx.InstanceGroup, error)
+	DeleteInstanceGroupFunc  func(int) (*awx.InstanceGroup, error)
+}
+
+// ListInstanceGroups records the call and returns the scripted results, zero values by default.
+func (f *InstanceGroupsAPI) ListInstanceGroups(params url.Values) (r0 []*awx.InstanceGroup, r1 *awx.ListInstanceGroupsResponse, r2 error) {
+	f.record("ListInstanceGroups", params)
+	if fn := f.ListInstanceGroupsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListInstanceGroupsReturns scripts the results of ListInstanceGroups.
+func (f *InstanceGroupsAPI) ListInstanceGroupsReturns(r0 []*awx.InstanceGroup, r1 *awx.ListInstanceGroupsResponse, r2 error) {
+	f.ListInstanceGroupsFunc = func(url.Values) ([]*awx.InstanceGroup, *awx.ListInstanceGroupsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetInstanceGroupByID records the call and returns the scripted results, zero values by default.
+func (f *InstanceGroupsAPI) GetInstanceGroupByID(id int, params url.Values) (r0 *awx.InstanceGroup, r1 error) {
+	f.record("GetInstanceGroupByID", id, params)
+	if fn := f.GetInstanceGroupByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetInstanceGroupByIDReturns scripts the results of GetInstanceGroupByID.
+func (f *InstanceGroupsAPI) GetInstanceGroupByIDReturns(r0 *awx.InstanceGroup, r1 error) {
+	f.GetInstanceGroupByIDFunc = func(int, url.Values) (*awx.InstanceGroup, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *InstanceGroupsAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.InstanceGroup, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *InstanceGroupsAPI) GetByNamedURLReturns(r0 *awx.InstanceGroup, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.InstanceGroup, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *InstanceGroupsAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.InstanceGroup, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *InstanceGroupsAPI) FindByNameReturns(r0 *awx.InstanceGroup, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.InstanceGroup, error) {
+		return r0, r1
+	}
+}
+
+// CreateInstanceGroup records the call and returns the scripted results, zero values by default.
+func (f *InstanceGroupsAPI) CreateInstanceGroup(data map[string]interface{}, params url.Values) (r0 *awx.InstanceGroup, r1 error) {
+	f.record("CreateInstanceGroup", data, params)
+	if fn := f.CreateInstanceGroupFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateInstanceGroupReturns scripts the results of CreateInstanceGroup.
+func (f *InstanceGroupsAPI) CreateInstanceGroupReturns(r0 *awx.InstanceGroup, r1 error) {
+	f.CreateInstanceGroupFunc = func(map[string]interface{}, url.Values) (*awx.InstanceGroup, error) {
+		return r0, r1
+	}
+}
+
+// UpdateInstanceGroup records the call and returns the scripted results, zero values by default.
+func (f *InstanceGroupsAPI) UpdateInstanceGroup(id int, data map[string]interface{}, params url.Values) (r0 *awx.InstanceGroup, r1 error) {
+	f.record("UpdateInstanceGroup", id, data, params)
+	if fn := f.UpdateInstanceGroupFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateInstanceGroupReturns scripts the results of UpdateInstanceGroup.
+func (f *InstanceGroupsAPI) UpdateInstanceGroupReturns(r0 *awx.InstanceGroup, r1 error) {
+	f.UpdateInstanceGroupFunc = func(int, map[string]interface{}, url.Values) (*awx.InstanceGroup, error) {
+		return r0, r1
+	}
+}
+
+// DeleteInstanceGroup records the call and returns the scripted results, zero values by default.
+func (f *InstanceGroupsAPI) DeleteInstanceGroup(id int) (r0 *awx.InstanceGroup, r1 error) {
+	f.record("DeleteInstanceGroup", id)
+	if fn := f.DeleteInstanceGroupFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteInstanceGroupReturns scripts the results of DeleteInstanceGroup.
+func (f *InstanceGroupsAPI) DeleteInstanceGroupReturns(r0 *awx.InstanceGroup, r1 error) {
+	f.DeleteInstanceGroupFunc = func(int) (*awx.InstanceGroup, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.LabelsAPI = (*LabelsAPI)(nil)
+
+// LabelsAPI is an in-memory fake of awx.LabelsAPI.
+type LabelsAPI struct {
+	Recorder
+
+	ListLabelsFunc         func(url.Values) ([]*awx.Label, *awx.ListLabelsResponse, error)
+	GetLabelByIDFunc       func(int, url.Values) (*awx.Label, error)
+	GetByNamedURLFunc      func(string, url.Values) (*awx.Label, error)
+	FindByNameFunc         func(context.Context, string, awx.Scope) (*awx.Label, error)
+	CreateLabelFunc        func(map[string]interface{}, url.Values) (*awx.Label, error)
+	UpdateLabelFunc        func(int, map[string]interface{}, url.Values) (*awx.Label, error)
+	ListResourceLabelsFunc func(awx.LabelTarget, int, url.Values) ([]*awx.Label, *awx.ListLabelsResponse, error)
+	AssociateLabelFunc     func(awx.LabelTarget, int, int) error
+	AssociateNewLabelFunc  func(awx.LabelTarget, int, string, int) error
+	DisassociateLabelFunc  func(awx.LabelTarget, int, int) error
+}
+
+// ListLabels records the call and returns the scripted results, zero values by default.
+func (f *LabelsAPI) ListLabels(params url.Values) (r0 []*awx.Label, r1 *awx.ListLabelsResponse, r2 error) {
+	f.record("ListLabels", params)
+	if fn := f.ListLabelsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListLabelsReturns scripts the results of ListLabels.
+func (f *LabelsAPI) ListLabelsReturns(r0 []*awx.Label, r1 *awx.ListLabelsResponse, r2 error) {
+	f.ListLabelsFunc = func(url.Values) ([]*awx.Label, *awx.ListLabelsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetLabelByID records the call and returns the scripted results, zero values by default.
+func (f *LabelsAPI) GetLabelByID(id int, params url.Values) (r0 *awx.Label, r1 error) {
+	f.record("GetLabelByID", id, params)
+	if fn := f.GetLabelByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetLabelByIDReturns scripts the results of GetLabelByID.
+func (f *LabelsAPI) GetLabelByIDReturns(r0 *awx.Label, r1 error) {
+	f.GetLabelByIDFunc = func(int, url.Values) (*awx.Label, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *LabelsAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.Label, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *LabelsAPI) GetByNamedURLReturns(r0 *awx.Label, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.Label, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *LabelsAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.Label, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *LabelsAPI) FindByNameReturns(r0 *awx.Label, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.Label, error) {
+		return r0, r1
+	}
+}
+
+// CreateLabel records the call and returns the scripted results, zero values by default.
+func (f *LabelsAPI) CreateLabel(data map[string]interface{}, params url.Values) (r0 *awx.Label, r1 error) {
+	f.record("CreateLabel", data, params)
+	if fn := f.CreateLabelFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateLabelReturns scripts the results of CreateLabel.
+func (f *LabelsAPI) CreateLabelReturns(r0 *awx.Label, r1 error) {
+	f.CreateLabelFunc = func(map[string]interface{}, url.Values) (*awx.Label, error) {
+		return r0, r1
+	}
+}
+
+// UpdateLabel records the call and returns the scripted results, zero values by default.
+func (f *LabelsAPI) UpdateLabel(id int, data map[string]interface{}, params url.Values) (r0 *awx.Label, r1 error) {
+	f.record("UpdateLabel", id, data, params)
+	if fn := f.UpdateLabelFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateLabelReturns scripts the results of UpdateLabel.
+func (f *LabelsAPI) UpdateLabelReturns(r0 *awx.Label, r1 error) {
+	f.UpdateLabelFunc = func(int, map[string]interface{}, url.Values) (*awx.Label, error) {
+		return r0, r1
+	}
+}
+
+// ListResourceLabels records the call and returns the scripted results, zero values by default.
+func (f *LabelsAPI) ListResourceLabels(target awx.LabelTarget, id int, params url.Values) (r0 []*awx.Label, r1 *awx.ListLabelsResponse, r2 error) {
+	f.record("ListResourceLabels", target, id, params)
+	if fn := f.ListResourceLabelsFunc; fn != nil {
+		return fn(target, id, params)
+	}
+	return
+}
+
+// ListResourceLabelsReturns scripts the results of ListResourceLabels.
+func (f *LabelsAPI) ListResourceLabelsReturns(r0 []*awx.Label, r1 *awx.ListLabelsResponse, r2 error) {
+	f.ListResourceLabelsFunc = func(awx.LabelTarget, int, url.Values) ([]*awx.Label, *awx.ListLabelsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// AssociateLabel records the call and returns the scripted results, zero values by default.
+func (f *LabelsAPI) AssociateLabel(target awx.LabelTarget, id int, labelID int) (r0 error) {
+	f.record("AssociateLabel", target, id, labelID)
+	if fn := f.AssociateLabelFunc; fn != nil {
+		return fn(target, id, labelID)
+	}
+	return
+}
+
+// AssociateLabelReturns scripts the results of AssociateLabel.
+func (f *LabelsAPI) AssociateLabelReturns(r0 error) {
+	f.AssociateLabelFunc = func(awx.LabelTarget, int, int) error {
+		return r0
+	}
+}
+
+// AssociateNewLabel records the call and returns the scripted results, zero values by default.
+func (f *LabelsAPI) AssociateNewLabel(target awx.LabelTarget, id int, name string, organization int) (r0 error) {
+	f.record("AssociateNewLabel", target, id, name, organization)
+	if fn := f.AssociateNewLabelFunc; fn != nil {
+		return fn(target, id, name, organization)
+	}
+	return
+}
+
+// AssociateNewLabelReturns scripts the results of AssociateNewLabel.
+func (f *LabelsAPI) AssociateNewLabelReturns(r0 error) {
+	f.AssociateNewLabelFunc = func(awx.LabelTarget, int, string, int) error {
+		return r0
+	}
+}
+
+// DisassociateLabel records the call and returns the scripted results, zero values by default.
+func (f *LabelsAPI) DisassociateLabel(target awx.LabelTarget, id int, labelID int) (r0 error) {
+	f.record("DisassociateLabel", target, id, labelID)
+	if fn := f.DisassociateLabelFunc; fn != nil {
+		return fn(target, id, labelID)
+	}
+	return
+}
+
+// DisassociateLabelReturns scripts the results of DisassociateLabel.
+func (f *LabelsAPI) DisassociateLabelReturns(r0 error) {
+	f.DisassociateLabelFunc = func(awx.LabelTarget, int, int) error {
+		return r0
+	}
+}
+
+var _ awx.MeAPI = (*MeAPI)(nil)
+
+// MeAPI is an in-memory fake of awx.MeAPI.
+type MeAPI struct {
+	Recorder
+
+	GetMeFunc func(url.Values) (*awx.User, error)
+}
+
+// GetMe records the call and returns the scripted results, zero values by default.
+func (f *MeAPI) GetMe(params url.Values) (r0 *awx.User, r1 error) {
+	f.record("GetMe", params)
+	if fn := f.GetMeFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// GetMeReturns scripts the results of GetMe.
+func (f *MeAPI) GetMeReturns(r0 *awx.User, r1 error) {
+	f.GetMeFunc = func(url.Values) (*awx.User, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.NotificationTemplatesAPI = (*NotificationTemplatesAPI)(nil)
+
+// NotificationTemplatesAPI is an in-memory fake of awx.NotificationTemplatesAPI.
+type NotificationTemplatesAPI struct {
+	Recorder
+
+	ListFunc          func(url.Values) ([]*awx.NotificationTemplate, *awx.ListNotificationTemplatesResponse, error)
+	GetByIDFunc       func(int, url.Values) (*awx.NotificationTemplate, error)
+	GetByNamedURLFunc func(string, url.Values) (*awx.NotificationTemplate, error)
+	FindByNameFunc    func(context.Context, string, awx.Scope) (*awx.NotificationTemplate, error)
+	CreateFunc        func(map[string]interface{}, url.Values) (*awx.NotificationTemplate, error)
+	UpdateFunc        func(int, map[string]interface{}, url.Values) (*awx.NotificationTemplate, error)
+	DeleteFunc        func(int) (*awx.NotificationTemplate, error)
+	TestFunc          func(context.Context, int) (*awx.Notification, error)
+}
+
+// List records the call and returns the scripted results, zero values by default.
+func (f *NotificationTemplatesAPI) List(params url.Values) (r0 []*awx.NotificationTemplate, r1 *awx.ListNotificationTemplatesResponse, r2 error) {
+	f.record("List", params)
+	if fn := f.ListFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListReturns scripts the results of List.
+func (f *NotificationTemplatesAPI) ListReturns(r0 []*awx.NotificationTemplate, r1 *awx.ListNotificationTemplatesResponse, r2 error) {
+	f.ListFunc = func(url.Values) ([]*awx.NotificationTemplate, *awx.ListNotificationTemplatesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetByID records the call and returns the scripted results, zero values by default.
+func (f *NotificationTemplatesAPI) GetByID(id int, params url.Values) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("GetByID", id, params)
+	if fn := f.GetByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetByIDReturns scripts the results of GetByID.
+func (f *NotificationTemplatesAPI) GetByIDReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.GetByIDFunc = func(int, url.Values) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *NotificationTemplatesAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *NotificationTemplatesAPI) GetByNamedURLReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *NotificationTemplatesAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *NotificationTemplatesAPI) FindByNameReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// Create records the call and returns the scripted results, zero values by default.
+func (f *NotificationTemplatesAPI) Create(data map[string]interface{}, params url.Values) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("Create", data, params)
+	if fn := f.CreateFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateReturns scripts the results of Create.
+func (f *NotificationTemplatesAPI) CreateReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.CreateFunc = func(map[string]interface{}, url.Values) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// Update records the call and returns the scripted results, zero values by default.
+func (f *NotificationTemplatesAPI) Update(id int, data map[string]interface{}, params url.Values) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("Update", id, data, params)
+	if fn := f.UpdateFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateReturns scripts the results of Update.
+func (f *NotificationTemplatesAPI) UpdateReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.UpdateFunc = func(int, map[string]interface{}, url.Values) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// Delete records the call and returns the scripted results, zero values by default.
+func (f *NotificationTemplatesAPI) Delete(id int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("Delete", id)
+	if fn := f.DeleteFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteReturns scripts the results of Delete.
+func (f *NotificationTemplatesAPI) DeleteReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.DeleteFunc = func(int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// Test records the call and returns the scripted results, zero values by default.
+func (f *NotificationTemplatesAPI) Test(ctx context.Context, id int) (r0 *awx.Notification, r1 error) {
+	f.record("Test", ctx, id)
+	if fn := f.TestFunc; fn != nil {
+		return fn(ctx, id)
+	}
+	return
+}
+
+// TestReturns scripts the results of Test.
+func (f *NotificationTemplatesAPI) TestReturns(r0 *awx.Notification, r1 error) {
+	f.TestFunc = func(context.Context, int) (*awx.Notification, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.NotificationsAPI = (*NotificationsAPI)(nil)
+
+// NotificationsAPI is an in-memory fake of awx.NotificationsAPI.
+type NotificationsAPI struct {
+	Recorder
+
+	ListNotificationsFunc                     func(url.Values) ([]*awx.Notification, *awx.ListNotificationsResponse, error)
+	GetNotificationByIDFunc                   func(int, url.Values) (*awx.Notification, error)
+	ListJobNotificationsFunc                  func(int, url.Values) ([]*awx.Notification, *awx.ListNotificationsResponse, error)
+	ListNotificationTemplateNotificationsFunc func(int, url.Values) ([]*awx.Notification, *awx.ListNotificationsResponse, error)
+}
+
+// ListNotifications records the call and returns the scripted results, zero values by default.
+func (f *NotificationsAPI) ListNotifications(params url.Values) (r0 []*awx.Notification, r1 *awx.ListNotificationsResponse, r2 error) {
+	f.record("ListNotifications", params)
+	if fn := f.ListNotificationsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListNotificationsReturns scripts the results of ListNotifications.
+func (f *NotificationsAPI) ListNotificationsReturns(r0 []*awx.Notification, r1 *awx.ListNotificationsResponse, r2 error) {
+	f.ListNotificationsFunc = func(url.Values) ([]*awx.Notification, *awx.ListNotificationsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetNotificationByID records the call and returns the scripted results, zero values by default.
+func (f *NotificationsAPI) GetNotificationByID(id int, params url.Values) (r0 *awx.Notification, r1 error) {
+	f.record("GetNotificationByID", id, params)
+	if fn := f.GetNotificationByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetNotificationByIDReturns scripts the results of GetNotificationByID.
+func (f *NotificationsAPI) GetNotificationByIDReturns(r0 *awx.Notification, r1 error) {
+	f.GetNotificationByIDFunc = func(int, url.Values) (*awx.Notification, error) {
+		return r0, r1
+	}
+}
+
+// ListJobNotifications records the call and returns the scripted results, zero values by default.
+func (f *NotificationsAPI) ListJobNotifications(jobID int, params url.Values) (r0 []*awx.Notification, r1 *awx.ListNotificationsResponse, r2 error) {
+	f.record("ListJobNotifications", jobID, params)
+	if fn := f.ListJobNotificationsFunc; fn != nil {
+		return fn(jobID, params)
+	}
+	return
+}
+
+// ListJobNotificationsReturns scripts the results of ListJobNotifications.
+func (f *NotificationsAPI) ListJobNotificationsReturns(r0 []*awx.Notification, r1 *awx.ListNotificationsResponse, r2 error) {
+	f.ListJobNotificationsFunc = func(int, url.Values) ([]*awx.Notification, *awx.ListNotificationsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// ListNotificationTemplateNotifications records the call and returns the scripted results, zero values by default.
+func (f *NotificationsAPI) ListNotificationTemplateNotifications(id int, params url.Values) (r0 []*awx.Notification, r1 *awx.ListNotificationsResponse, r2 error) {
+	f.record("ListNotificationTemplateNotifications", id, params)
+	if fn := f.ListNotificationTemplateNotificationsFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// ListNotificationTemplateNotificationsReturns scripts the results of ListNotificationTemplateNotifications.
+func (f *NotificationsAPI) ListNotificationTemplateNotificationsReturns(r0 []*awx.Notification, r1 *awx.ListNotificationsResponse, r2 error) {
+	f.ListNotificationTemplateNotificationsFunc = func(int, url.Values) ([]*awx.Notification, *awx.ListNotificationsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+var _ awx.OrganizationsAPI = (*OrganizationsAPI)(nil)
+
+// OrganizationsAPI is an in-memory fake of awx.OrganizationsAPI.
+type OrganizationsAPI struct {
+	Recorder
+
+	ListOrganizationsFunc             func(url.Values) ([]*awx.Organization, error)
+	GetOrganizationsByIDFunc          func(int, url.Values) (*awx.Organization, error)
+	GetByNamedURLFunc                 func(string, url.Values) (*awx.Organization, error)
+	FindByNameFunc                    func(context.Context, string, awx.Scope) (*awx.Organization, error)
+	CreateOrganizationFunc            func(map[string]interface{}, url.Values) (*awx.Organization, error)
+	UpdateOrganizationFunc            func(int, map[string]interface{}, url.Values) (*awx.Organization, error)
+	DeleteOrganizationFunc            func(int) (*awx.Organization, error)
+	DisAssociateGalaxyCredentialsFunc func(int, map[string]interface{}, url.Values) (*awx.Organization, error)
+	AssociateGalaxyCredentialsFunc    func(int, map[string]interface{}, url.Values) (*awx.Organization, error)
+	DisAssociateInstanceGroupsFunc    func(int, map[string]interface{}, url.Values) (*awx.Organization, error)
+	AssociateInstanceGroupsFunc       func(int, map[string]interface{}, url.Values) (*awx.Organization, error)
+}
+
+// ListOrganizations records the call and returns the scripted results, zero values by default.
+func (f *OrganizationsAPI) ListOrganizations(params url.Values) (r0 []*awx.Organization, r1 error) {
+	f.record("ListOrganizations", params)
+	if fn := f.ListOrganizationsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListOrganizationsReturns scripts the results of ListOrganizations.
+func (f *OrganizationsAPI) ListOrganizationsReturns(r0 []*awx.Organization, r1 error) {
+	f.ListOrganizationsFunc = func(url.Values) ([]*awx.Organization, error) {
+		return r0, r1
+	}
+}
+
+// GetOrganizationsByID records the call and returns the scripted results, zero values by default.
+func (f *OrganizationsAPI) GetOrganizationsByID(id int, params url.Values) (r0 *awx.Organization, r1 error) {
+	f.record("GetOrganizationsByID", id, params)
+	if fn := f.GetOrganizationsByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetOrganizationsByIDReturns scripts the results of GetOrganizationsByID.
+func (f *OrganizationsAPI) GetOrganizationsByIDReturns(r0 *awx.Organization, r1 error) {
+	f.GetOrganizationsByIDFunc = func(int, url.Values) (*awx.Organization, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *OrganizationsAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.Organization, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *OrganizationsAPI) GetByNamedURLReturns(r0 *awx.Organization, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.Organization, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *OrganizationsAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.Organization, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *OrganizationsAPI) FindByNameReturns(r0 *awx.Organization, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.Organization, error) {
+		return r0, r1
+	}
+}
+
+// CreateOrganization records the call and returns the scripted results, zero values by default.
+func (f *OrganizationsAPI) CreateOrganization(data map[string]interface{}, params url.Values) (r0 *awx.Organization, r1 error) {
+	f.record("CreateOrganization", data, params)
+	if fn := f.CreateOrganizationFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateOrganizationReturns scripts the results of CreateOrganization.
+func (f *OrganizationsAPI) CreateOrganizationReturns(r0 *awx.Organization, r1 error) {
+	f.CreateOrganizationFunc = func(map[string]interface{}, url.Values) (*awx.Organization, error) {
+		return r0, r1
+	}
+}
+
+// UpdateOrganization records the call and returns the scripted results, zero values by default.
+func (f *OrganizationsAPI) UpdateOrganization(id int, data map[string]interface{}, params url.Values) (r0 *awx.Organization, r1 error) {
+	f.record("UpdateOrganization", id, data, params)
+	if fn := f.UpdateOrganizationFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateOrganizationReturns scripts the results of UpdateOrganization.
+func (f *OrganizationsAPI) UpdateOrganizationReturns(r0 *awx.Organization, r1 error) {
+	f.UpdateOrganizationFunc = func(int, map[string]interface{}, url.Values) (*awx.Organization, error) {
+		return r0, r1
+	}
+}
+
+// DeleteOrganization records the call and returns the scripted results, zero values by default.
+func (f *OrganizationsAPI) DeleteOrganization(id int) (r0 *awx.Organization, r1 error) {
+	f.record("DeleteOrganization", id)
+	if fn := f.DeleteOrganizationFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteOrganizationReturns scripts the results of DeleteOrganization.
+func (f *OrganizationsAPI) DeleteOrganizationReturns(r0 *awx.Organization, r1 error) {
+	f.DeleteOrganizationFunc = func(int) (*awx.Organization, error) {
+		return r0, r1
+	}
+}
+
+// DisAssociateGalaxyCredentials records the call and returns the scripted results, zero values by default.
+func (f *OrganizationsAPI) DisAssociateGalaxyCredentials(id int, data map[string]interface{}, params url.Values) (r0 *awx.Organization, r1 error) {
+	f.record("DisAssociateGalaxyCredentials", id, data, params)
+	if fn := f.DisAssociateGalaxyCredentialsFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// DisAssociateGalaxyCredentialsReturns scripts the results of DisAssociateGalaxyCredentials.
+func (f *OrganizationsAPI) DisAssociateGalaxyCredentialsReturns(r0 *awx.Organization, r1 error) {
+	f.DisAssociateGalaxyCredentialsFunc = func(int, map[string]interface{}, url.Values) (*awx.Organization, error) {
+		return r0, r1
+	}
+}
+
+// AssociateGalaxyCredentials records the call and returns the scripted results, zero values by default.
+func (f *OrganizationsAPI) AssociateGalaxyCredentials(id int, data map[string]interface{}, params url.Values) (r0 *awx.Organization, r1 error) {
+	f.record("AssociateGalaxyCredentials", id, data, params)
+	if fn := f.AssociateGalaxyCredentialsFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// AssociateGalaxyCredentialsReturns scripts the results of AssociateGalaxyCredentials.
+func (f *OrganizationsAPI) AssociateGalaxyCredentialsReturns(r0 *awx.Organization, r1 error) {
+	f.AssociateGalaxyCredentialsFunc = func(int, map[string]interface{}, url.Values) (*awx.Organization, error) {
+		return r0, r1
+	}
+}
+
+// DisAssociateInstanceGroups records the call and returns the scripted results, zero values by default.
+func (f *OrganizationsAPI) DisAssociateInstanceGroups(id int, data map[string]interface{}, params url.Values) (r0 *awx.Organization, r1 error) {
+	f.record("DisAssociateInstanceGroups", id, data, params)
+	if fn := f.DisAssociateInstanceGroupsFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// DisAssociateInstanceGroupsReturns scripts the results of DisAssociateInstanceGroups.
+func (f *OrganizationsAPI) DisAssociateInstanceGroupsReturns(r0 *awx.Organization, r1 error) {
+	f.DisAssociateInstanceGroupsFunc = func(int, map[string]interface{}, url.Values) (*awx.Organization, error) {
+		return r0, r1
+	}
+}
+
+// AssociateInstanceGroups records the call and returns the scripted results, zero values by default.
+func (f *OrganizationsAPI) AssociateInstanceGroups(id int, data map[string]interface{}, params url.Values) (r0 *awx.Organization, r1 error) {
+	f.record("AssociateInstanceGroups", id, data, params)
+	if fn := f.AssociateInstanceGroupsFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// AssociateInstanceGroupsReturns scripts the results of AssociateInstanceGroups.
+func (f *OrganizationsAPI) AssociateInstanceGroupsReturns(r0 *awx.Organization, r1 error) {
+	f.AssociateInstanceGroupsFunc = func(int, map[string]interface{}, url.Values) (*awx.Organization, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.SchedulesAPI = (*SchedulesAPI)(nil)
+
+// SchedulesAPI is an in-memory fake of awx.SchedulesAPI.
+type SchedulesAPI struct {
+	Recorder
+
+	ListFunc          func(url.Values) ([]*awx.Schedule, *awx.ListSchedulesResponse, error)
+	GetByIDFunc       func(int, url.Values) (*awx.Schedule, error)
+	GetByNamedURLFunc func(string, url.Values) (*awx.Schedule, error)
+	FindByNameFunc    func(context.Context, string, awx.Scope) (*awx.Schedule, error)
+	CreateFunc        func(map[string]interface{}, url.Values) (*awx.Schedule, error)
+	UpdateFunc        func(int, map[string]interface{}, url.Values) (*awx.Schedule, error)
+	DeleteFunc        func(int) (*awx.Schedule, error)
+}
+
+// List records the call and returns the scripted results, zero values by default.
+func (f *SchedulesAPI) List(params url.Values) (r0 []*awx.Schedule, r1 *awx.ListSchedulesResponse, r2 error) {
+	f.record("List", params)
+	if fn := f.ListFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListReturns scripts the results of List.
+func (f *SchedulesAPI) ListReturns(r0 []*awx.Schedule, r1 *awx.ListSchedulesResponse, r2 error) {
+	f.ListFunc = func(url.Values) ([]*awx.Schedule, *awx.ListSchedulesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetByID records the call and returns the scripted results, zero values by default.
+func (f *SchedulesAPI) GetByID(id int, params url.Values) (r0 *awx.Schedule, r1 error) {
+	f.record("GetByID", id, params)
+	if fn := f.GetByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetByIDReturns scripts the results of GetByID.
+func (f *SchedulesAPI) GetByIDReturns(r0 *awx.Schedule, r1 error) {
+	f.GetByIDFunc = func(int, url.Values) (*awx.Schedule, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *SchedulesAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.Schedule, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *SchedulesAPI) GetByNamedURLReturns(r0 *awx.Schedule, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.Schedule, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *SchedulesAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.Schedule, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *SchedulesAPI) FindByNameReturns(r0 *awx.Schedule, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.Schedule, error) {
+		return r0, r1
+	}
+}
+
+// Create records the call and returns the scripted results, zero values by default.
+func (f *SchedulesAPI) Create(data map[string]interface{}, params url.Values) (r0 *awx.Schedule, r1 error) {
+	f.record("Create", data, params)
+	if fn := f.CreateFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateReturns scripts the results of Create.
+func (f *SchedulesAPI) CreateReturns(r0 *awx.Schedule, r1 error) {
+	f.CreateFunc = func(map[string]interface{}, url.Values) (*awx.Schedule, error) {
+		return r0, r1
+	}
+}
+
+// Update records the call and returns the scripted results, zero values by default.
+func (f *SchedulesAPI) Update(id int, data map[string]interface{}, params url.Values) (r0 *awx.Schedule, r1 error) {
+	f.record("Update", id, data, params)
+	if fn := f.UpdateFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateReturns scripts the results of Update.
+func (f *SchedulesAPI) UpdateReturns(r0 *awx.Schedule, r1 error) {
+	f.UpdateFunc = func(int, map[string]interface{}, url.Values) (*awx.Schedule, error) {
+		return r0, r1
+	}
+}
+
+// Delete records the call and returns the scripted results, zero values by default.
+func (f *SchedulesAPI) Delete(id int) (r0 *awx.Schedule, r1 error) {
+	f.record("Delete", id)
+	if fn := f.DeleteFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteReturns scripts the results of Delete.
+func (f *SchedulesAPI) DeleteReturns(r0 *awx.Schedule, r1 error) {
+	f.DeleteFunc = func(int) (*awx.Schedule, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.RolesAPI = (*RolesAPI)(nil)
+
+// RolesAPI is an in-memory fake of awx.RolesAPI.
+type RolesAPI struct {
+	Recorder
+
+	ListRolesFunc     func(url.Values) ([]*awx.Role, *awx.ListRolesResponse, error)
+	GetRoleByIDFunc   func(int, url.Values) (*awx.Role, error)
+	ListRoleUsersFunc func(int, url.Values) ([]*awx.User, *awx.ListUsersResponse, error)
+	ListRoleTeamsFunc func(int, url.Values) ([]*awx.Team, *awx.ListTeamsResponse, error)
+	GrantFunc         func(context.Context, awx.Principal, interface{}, string) error
+	RevokeFunc        func(context.Context, awx.Principal, interface{}, string) error
+}
+
+// ListRoles records the call and returns the scripted results, zero values by default.
+func (f *RolesAPI) ListRoles(params url.Values) (r0 []*awx.Role, r1 *awx.ListRolesResponse, r2 error) {
+	f.record("ListRoles", params)
+	if fn := f.ListRolesFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListRolesReturns scripts the results of ListRoles.
+func (f *RolesAPI) ListRolesReturns(r0 []*awx.Role, r1 *awx.ListRolesResponse, r2 error) {
+	f.ListRolesFunc = func(url.Values) ([]*awx.Role, *awx.ListRolesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetRoleByID records the call and returns the scripted results, zero values by default.
+func (f *RolesAPI) GetRoleByID(id int, params url.Values) (r0 *awx.Role, r1 error) {
+	f.record("GetRoleByID", id, params)
+	if fn := f.GetRoleByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetRoleByIDReturns scripts the results of GetRoleByID.
+func (f *RolesAPI) GetRoleByIDReturns(r0 *awx.Role, r1 error) {
+	f.GetRoleByIDFunc = func(int, url.Values) (*awx.Role, error) {
+		return r0, r1
+	}
+}
+
+// ListRoleUsers records the call and returns the scripted results, zero values by default.
+func (f *RolesAPI) ListRoleUsers(id int, params url.Values) (r0 []*awx.User, r1 *awx.ListUsersResponse, r2 error) {
+	f.record("ListRoleUsers", id, params)
+	if fn := f.ListRoleUsersFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// ListRoleUsersReturns scripts the results of ListRoleUsers.
+func (f *RolesAPI) ListRoleUsersReturns(r0 []*awx.User, r1 *awx.ListUsersResponse, r2 error) {
+	f.ListRoleUsersFunc = func(int, url.Values) ([]*awx.User, *awx.ListUsersResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// ListRoleTeams records the call and returns the scripted results, zero values by default.
+func (f *RolesAPI) ListRoleTeams(id int, params url.Values) (r0 []*awx.Team, r1 *awx.ListTeamsResponse, r2 error) {
+	f.record("ListRoleTeams", id, params)
+	if fn := f.ListRoleTeamsFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// ListRoleTeamsReturns scripts the results of ListRoleTeams.
+func (f *RolesAPI) ListRoleTeamsReturns(r0 []*awx.Team, r1 *awx.ListTeamsResponse, r2 error) {
+	f.ListRoleTeamsFunc = func(int, url.Values) ([]*awx.Team, *awx.ListTeamsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// Grant records the call and returns the scripted results, zero values by default.
+func (f *RolesAPI) Grant(ctx context.Context, principal awx.Principal, resource interface{}, role string) (r0 error) {
+	f.record("Grant", ctx, principal, resource, role)
+	if fn := f.GrantFunc; fn != nil {
+		return fn(ctx, principal, resource, role)
+	}
+	return
+}
+
+// GrantReturns scripts the results of Grant.
+func (f *RolesAPI) GrantReturns(r0 error) {
+	f.GrantFunc = func(context.Context, awx.Principal, interface{}, string) error {
+		return r0
+	}
+}
+
+// Revoke records the call and returns the scripted results, zero values by default.
+func (f *RolesAPI) Revoke(ctx context.Context, principal awx.Principal, resource interface{}, role string) (r0 error) {
+	f.record("Revoke", ctx, principal, resource, role)
+	if fn := f.RevokeFunc; fn != nil {
+		return fn(ctx, principal, resource, role)
+	}
+	return
+}
+
+// RevokeReturns scripts the results of Revoke.
+func (f *RolesAPI) RevokeReturns(r0 error) {
+	f.RevokeFunc = func(context.Context, awx.Principal, interface{}, string) error {
+		return r0
+	}
+}
+
+var _ awx.SettingAPI = (*SettingAPI)(nil)
+
+// SettingAPI is an in-memory fake of awx.SettingAPI.
+type SettingAPI struct {
+	Recorder
+
+	ListSettingsFunc      func(url.Values) ([]*awx.SettingSummary, *awx.ListSettingsResponse, error)
+	GetSettingsBySlugFunc func(string, url.Values) (*awx.Setting, error)
+	UpdateSettingsFunc    func(string, map[string]interface{}, url.Values) (*awx.Setting, error)
+	DeleteSettingsFunc    func(string) (*awx.Setting, error)
+}
+
+// ListSettings records the call and returns the scripted results, zero values by default.
+func (f *SettingAPI) ListSettings(params url.Values) (r0 []*awx.SettingSummary, r1 *awx.ListSettingsResponse, r2 error) {
+	f.record("ListSettings", params)
+	if fn := f.ListSettingsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListSettingsReturns scripts the results of ListSettings.
+func (f *SettingAPI) ListSettingsReturns(r0 []*awx.SettingSummary, r1 *awx.ListSettingsResponse, r2 error) {
+	f.ListSettingsFunc = func(url.Values) ([]*awx.SettingSummary, *awx.ListSettingsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetSettingsBySlug records the call and returns the scripted results, zero values by default.
+func (f *SettingAPI) GetSettingsBySlug(slug string, params url.Values) (r0 *awx.Setting, r1 error) {
+	f.record("GetSettingsBySlug", slug, params)
+	if fn := f.GetSettingsBySlugFunc; fn != nil {
+		return fn(slug, params)
+	}
+	return
+}
+
+// GetSettingsBySlugReturns scripts the results of GetSettingsBySlug.
+func (f *SettingAPI) GetSettingsBySlugReturns(r0 *awx.Setting, r1 error) {
+	f.GetSettingsBySlugFunc = func(string, url.Values) (*awx.Setting, error) {
+		return r0, r1
+	}
+}
+
+// UpdateSettings records the call and returns the scripted results, zero values by default.
+func (f *SettingAPI) UpdateSettings(slug string, data map[string]interface{}, params url.Values) (r0 *awx.Setting, r1 error) {
+	f.record("UpdateSettings", slug, data, params)
+	if fn := f.UpdateSettingsFunc; fn != nil {
+		return fn(slug, data, params)
+	}
+	return
+}
+
+// UpdateSettingsReturns scripts the results of UpdateSettings.
+func (f *SettingAPI) UpdateSettingsReturns(r0 *awx.Setting, r1 error) {
+	f.UpdateSettingsFunc = func(string, map[string]interface{}, url.Values) (*awx.Setting, error) {
+		return r0, r1
+	}
+}
+
+// DeleteSettings records the call and returns the scripted results, zero values by default.
+func (f *SettingAPI) DeleteSettings(slug string) (r0 *awx.Setting, r1 error) {
+	f.record("DeleteSettings", slug)
+	if fn := f.DeleteSettingsFunc; fn != nil {
+		return fn(slug)
+	}
+	return
+}
+
+// DeleteSettingsReturns scripts the results of DeleteSettings.
+func (f *SettingAPI) DeleteSettingsReturns(r0 *awx.Setting, r1 error) {
+	f.DeleteSettingsFunc = func(string) (*awx.Setting, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.SystemJobTemplatesAPI = (*SystemJobTemplatesAPI)(nil)
+
+// SystemJobTemplatesAPI is an in-memory fake of awx.SystemJobTemplatesAPI.
+type SystemJobTemplatesAPI struct {
+	Recorder
+
+	ListSystemJobTemplatesFunc          func(url.Values) ([]*awx.SystemJobTemplate, *awx.ListSystemJobTemplatesResponse, error)
+	GetSystemJobTemplateByIDFunc        func(int, url.Values) (*awx.SystemJobTemplate, error)
+	LaunchFunc                          func(int, map[string]interface{}, url.Values) (*awx.SystemJobLaunch, error)
+	LaunchCleanupFunc                   func(string, int) (*awx.SystemJobLaunch, error)
+	ListSystemJobTemplateSchedulesFunc  func(int, url.Values) ([]*awx.Schedule, *awx.ListSchedulesResponse, error)
+	CreateSystemJobTemplateScheduleFunc func(int, map[string]interface{}, url.Values) (*awx.Schedule, error)
+}
+
+// ListSystemJobTemplates records the call and returns the scripted results, zero values by default.
+func (f *SystemJobTemplatesAPI) ListSystemJobTemplates(params url.Values) (r0 []*awx.SystemJobTemplate, r1 *awx.ListSystemJobTemplatesResponse, r2 error) {
+	f.record("ListSystemJobTemplates", params)
+	if fn := f.ListSystemJobTemplatesFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListSystemJobTemplatesReturns scripts the results of ListSystemJobTemplates.
+func (f *SystemJobTemplatesAPI) ListSystemJobTemplatesReturns(r0 []*awx.SystemJobTemplate, r1 *awx.ListSystemJobTemplatesResponse, r2 error) {
+	f.ListSystemJobTemplatesFunc = func(url.Values) ([]*awx.SystemJobTemplate, *awx.ListSystemJobTemplatesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetSystemJobTemplateByID records the call and returns the scripted results, zero values by default.
+func (f *SystemJobTemplatesAPI) GetSystemJobTemplateByID(id int, params url.Values) (r0 *awx.SystemJobTemplate, r1 error) {
+	f.record("GetSystemJobTemplateByID", id, params)
+	if fn := f.GetSystemJobTemplateByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetSystemJobTemplateByIDReturns scripts the results of GetSystemJobTemplateByID.
+func (f *SystemJobTemplatesAPI) GetSystemJobTemplateByIDReturns(r0 *awx.SystemJobTemplate, r1 error) {
+	f.GetSystemJobTemplateByIDFunc = func(int, url.Values) (*awx.SystemJobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// Launch records the call and returns the scripted results, zero values by default.
+func (f *SystemJobTemplatesAPI) Launch(id int, data map[string]interface{}, params url.Values) (r0 *awx.SystemJobLaunch, r1 error) {
+	f.record("Launch", id, data, params)
+	if fn := f.LaunchFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// LaunchReturns scripts the results of Launch.
+func (f *SystemJobTemplatesAPI) LaunchReturns(r0 *awx.SystemJobLaunch, r1 error) {
+	f.LaunchFunc = func(int, map[string]interface{}, url.Values) (*awx.SystemJobLaunch, error) {
+		return r0, r1
+	}
+}
+
+// LaunchCleanup records the call and returns the scripted results, zero values by default.
+func (f *SystemJobTemplatesAPI) LaunchCleanup(jobType string, days int) (r0 *awx.SystemJobLaunch, r1 error) {
+	f.record("LaunchCleanup", jobType, days)
+	if fn := f.LaunchCleanupFunc; fn != nil {
+		return fn(jobType, days)
+	}
+	return
+}
+
+// LaunchCleanupReturns scripts the results of LaunchCleanup.
+func (f *SystemJobTemplatesAPI) LaunchCleanupReturns(r0 *awx.SystemJobLaunch, r1 error) {
+	f.LaunchCleanupFunc = func(string, int) (*awx.SystemJobLaunch, error) {
+		return r0, r1
+	}
+}
+
+// ListSystemJobTemplateSchedules records the call and returns the scripted results, zero values by default.
+func (f *SystemJobTemplatesAPI) ListSystemJobTemplateSchedules(id int, params url.Values) (r0 []*awx.Schedule, r1 *awx.ListSchedulesResponse, r2 error) {
+	f.record("ListSystemJobTemplateSchedules", id, params)
+	if fn := f.ListSystemJobTemplateSchedulesFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// ListSystemJobTemplateSchedulesReturns scripts the results of ListSystemJobTemplateSchedules.
+func (f *SystemJobTemplatesAPI) ListSystemJobTemplateSchedulesReturns(r0 []*awx.Schedule, r1 *awx.ListSchedulesResponse, r2 error) {
+	f.ListSystemJobTemplateSchedulesFunc = func(int, url.Values) ([]*awx.Schedule, *awx.ListSchedulesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateSystemJobTemplateSchedule records the call and returns the scripted results, zero values by default.
+func (f *SystemJobTemplatesAPI) CreateSystemJobTemplateSchedule(id int, data map[string]interface{}, params url.Values) (r0 *awx.Schedule, r1 error) {
+	f.record("CreateSystemJobTemplateSchedule", id, data, params)
+	if fn := f.CreateSystemJobTemplateScheduleFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// CreateSystemJobTemplateScheduleReturns scripts the results of CreateSystemJobTemplateSchedule.
+func (f *SystemJobTemplatesAPI) CreateSystemJobTemplateScheduleReturns(r0 *awx.Schedule, r1 error) {
+	f.CreateSystemJobTemplateScheduleFunc = func(int, map[string]interface{}, url.Values) (*awx.Schedule, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.SystemJobsAPI = (*SystemJobsAPI)(nil)
+
+// SystemJobsAPI is an in-memory fake of awx.SystemJobsAPI.
+type SystemJobsAPI struct {
+	Recorder
+
+	ListSystemJobsFunc     func(url.Values) ([]*awx.SystemJob, *awx.ListSystemJobsResponse, error)
+	GetSystemJobFunc       func(int, url.Values) (*awx.SystemJob, error)
+	CancelSystemJobFunc    func(int, map[string]interface{}, url.Values) (*awx.CancelJobResponse, error)
+	GetSystemJobStdoutFunc func(int, url.Values) (string, error)
+	GetSystemJobEventsFunc func(int, url.Values) ([]awx.SystemJobEvent, *awx.SystemJobEventsResponse, error)
+}
+
+// ListSystemJobs records the call and returns the scripted results, zero values by default.
+func (f *SystemJobsAPI) ListSystemJobs(params url.Values) (r0 []*awx.SystemJob, r1 *awx.ListSystemJobsResponse, r2 error) {
+	f.record("ListSystemJobs", params)
+	if fn := f.ListSystemJobsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListSystemJobsReturns scripts the results of ListSystemJobs.
+func (f *SystemJobsAPI) ListSystemJobsReturns(r0 []*awx.SystemJob, r1 *awx.ListSystemJobsResponse, r2 error) {
+	f.ListSystemJobsFunc = func(url.Values) ([]*awx.SystemJob, *awx.ListSystemJobsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetSystemJob records the call and returns the scripted results, zero values by default.
+func (f *SystemJobsAPI) GetSystemJob(id int, params url.Values) (r0 *awx.SystemJob, r1 error) {
+	f.record("GetSystemJob", id, params)
+	if fn := f.GetSystemJobFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetSystemJobReturns scripts the results of GetSystemJob.
+func (f *SystemJobsAPI) GetSystemJobReturns(r0 *awx.SystemJob, r1 error) {
+	f.GetSystemJobFunc = func(int, url.Values) (*awx.SystemJob, error) {
+		return r0, r1
+	}
+}
+
+// CancelSystemJob records the call and returns the scripted results, zero values by default.
+func (f *SystemJobsAPI) CancelSystemJob(id int, data map[string]interface{}, params url.Values) (r0 *awx.CancelJobResponse, r1 error) {
+	f.record("CancelSystemJob", id, data, params)
+	if fn := f.CancelSystemJobFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// CancelSystemJobReturns scripts the results of CancelSystemJob.
+func (f *SystemJobsAPI) CancelSystemJobReturns(r0 *awx.CancelJobResponse, r1 error) {
+	f.CancelSystemJobFunc = func(int, map[string]interface{}, url.Values) (*awx.CancelJobResponse, error) {
+		return r0, r1
+	}
+}
+
+// GetSystemJobStdout records the call and returns the scripted results, zero values by default.
+func (f *SystemJobsAPI) GetSystemJobStdout(id int, params url.Values) (r0 string, r1 error) {
+	f.record("GetSystemJobStdout", id, params)
+	if fn := f.GetSystemJobStdoutFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetSystemJobStdoutReturns scripts the results of GetSystemJobStdout.
+func (f *SystemJobsAPI) GetSystemJobStdoutReturns(r0 string, r1 error) {
+	f.GetSystemJobStdoutFunc = func(int, url.Values) (string, error) {
+		return r0, r1
+	}
+}
+
+// GetSystemJobEvents records the call and returns the scripted results, zero values by default.
+func (f *SystemJobsAPI) GetSystemJobEvents(id int, params url.Values) (r0 []awx.SystemJobEvent, r1 *awx.SystemJobEventsResponse, r2 error) {
+	f.record("GetSystemJobEvents", id, params)
+	if fn := f.GetSystemJobEventsFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetSystemJobEventsReturns scripts the results of GetSystemJobEvents.
+func (f *SystemJobsAPI) GetSystemJobEventsReturns(r0 []awx.SystemJobEvent, r1 *awx.SystemJobEventsResponse, r2 error) {
+	f.GetSystemJobEventsFunc = func(int, url.Values) ([]awx.SystemJobEvent, *awx.SystemJobEventsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+var _ awx.TeamAPI = (*TeamAPI)(nil)
+
+// TeamAPI is an in-memory fake of awx.TeamAPI.
+type TeamAPI struct {
+	Recorder
+
+	ListTeamsFunc                 func(url.Values) ([]*awx.Team, *awx.ListTeamsResponse, error)
+	ListTeamRoleEntitlementsFunc  func(int, url.Values) ([]*awx.ApplyRole, *awx.ListTeamRolesResponse, error)
+	GetTeamObjectRolesFunc        func(int, url.Values, *awx.PaginationRequest) ([]*awx.ApplyRole, *awx.ListTeamRolesResponse, error)
+	GetTeamUsersFunc              func(int, url.Values, *awx.PaginationRequest) ([]*awx.User, *awx.ListTeamUsersResponse, error)
+	GetTeamAccessListFunc         func(int, url.Values, *awx.PaginationRequest) ([]*awx.User, *awx.ListTeamUsersResponse, error)
+	AddTeamUserFunc               func(int, map[string]interface{}) error
+	RemoveTeamUserFunc            func(int, map[string]interface{}) error
+	GetTeamByIDFunc               func(int, url.Values) (*awx.Team, error)
+	GetByNamedURLFunc             func(string, url.Values) (*awx.Team, error)
+	FindByNameFunc                func(context.Context, string, awx.Scope) (*awx.Team, error)
+	CreateTeamFunc                func(map[string]interface{}, url.Values) (*awx.Team, error)
+	UpdateTeamFunc                func(int, map[string]interface{}, url.Values) (*awx.Team, error)
+	UpdateTeamRoleEntitlementFunc func(int, map[string]interface{}, url.Values) (interface{}, error)
+	DeleteTeamFunc                func(int) (*awx.Team, error)
+}
+
+// ListTeams records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) ListTeams(params url.Values) (r0 []*awx.Team, r1 *awx.ListTeamsResponse, r2 error) {
+	f.record("ListTeams", params)
+	if fn := f.ListTeamsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListTeamsReturns scripts the results of ListTeams.
+func (f *TeamAPI) ListTeamsReturns(r0 []*awx.Team, r1 *awx.ListTeamsResponse, r2 error) {
+	f.ListTeamsFunc = func(url.Values) ([]*awx.Team, *awx.ListTeamsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// ListTeamRoleEntitlements records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) ListTeamRoleEntitlements(id int, params url.Values) (r0 []*awx.ApplyRole, r1 *awx.ListTeamRolesResponse, r2 error) {
+	f.record("ListTeamRoleEntitlements", id, params)
+	if fn := f.ListTeamRoleEntitlementsFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// ListTeamRoleEntitlementsReturns scripts the results of ListTeamRoleEntitlements.
+func (f *TeamAPI) ListTeamRoleEntitlementsReturns(r0 []*awx.ApplyRole, r1 *awx.ListTeamRolesResponse, r2 error) {
+	f.ListTeamRoleEntitlementsFunc = func(int, url.Values) ([]*awx.ApplyRole, *awx.ListTeamRolesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetTeamObjectRoles records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) GetTeamObjectRoles(id int, params url.Values, pagination *awx.PaginationRequest) (r0 []*awx.ApplyRole, r1 *awx.ListTeamRolesResponse, r2 error) {
+	f.record("GetTeamObjectRoles", id, params, pagination)
+	if fn := f.GetTeamObjectRolesFunc; fn != nil {
+		return fn(id, params, pagination)
+	}
+	return
+}
+
+// GetTeamObjectRolesReturns scripts the results of GetTeamObjectRoles.
+func (f *TeamAPI) GetTeamObjectRolesReturns(r0 []*awx.ApplyRole, r1 *awx.ListTeamRolesResponse, r2 error) {
+	f.GetTeamObjectRolesFunc = func(int, url.Values, *awx.PaginationRequest) ([]*awx.ApplyRole, *awx.ListTeamRolesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetTeamUsers records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) GetTeamUsers(id int, params url.Values, pagination *awx.PaginationRequest) (r0 []*awx.User, r1 *awx.ListTeamUsersResponse, r2 error) {
+	f.record("GetTeamUsers", id, params, pagination)
+	if fn := f.GetTeamUsersFunc; fn != nil {
+		return fn(id, params, pagination)
+	}
+	return
+}
+
+// GetTeamUsersReturns scripts the results of GetTeamUsers.
+func (f *TeamAPI) GetTeamUsersReturns(r0 []*awx.User, r1 *awx.ListTeamUsersResponse, r2 error) {
+	f.GetTeamUsersFunc = func(int, url.Values, *awx.PaginationRequest) ([]*awx.User, *awx.ListTeamUsersResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// GetTeamAccessList records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) GetTeamAccessList(id int, params url.Values, pagination *awx.PaginationRequest) (r0 []*awx.User, r1 *awx.ListTeamUsersResponse, r2 error) {
+	f.record("GetTeamAccessList", id, params, pagination)
+	if fn := f.GetTeamAccessListFunc; fn != nil {
+		return fn(id, params, pagination)
+	}
+	return
+}
+
+// GetTeamAccessListReturns scripts the results of GetTeamAccessList.
+func (f *TeamAPI) GetTeamAccessListReturns(r0 []*awx.User, r1 *awx.ListTeamUsersResponse, r2 error) {
+	f.GetTeamAccessListFunc = func(int, url.Values, *awx.PaginationRequest) ([]*awx.User, *awx.ListTeamUsersResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// AddTeamUser records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) AddTeamUser(id int, data map[string]interface{}) (r0 error) {
+	f.record("AddTeamUser", id, data)
+	if fn := f.AddTeamUserFunc; fn != nil {
+		return fn(id, data)
+	}
+	return
+}
+
+// AddTeamUserReturns scripts the results of AddTeamUser.
+func (f *TeamAPI) AddTeamUserReturns(r0 error) {
+	f.AddTeamUserFunc = func(int, map[string]interface{}) error {
+		return r0
+	}
+}
+
+// RemoveTeamUser records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) RemoveTeamUser(id int, data map[string]interface{}) (r0 error) {
+	f.record("RemoveTeamUser", id, data)
+	if fn := f.RemoveTeamUserFunc; fn != nil {
+		return fn(id, data)
+	}
+	return
+}
+
+// RemoveTeamUserReturns scripts the results of RemoveTeamUser.
+func (f *TeamAPI) RemoveTeamUserReturns(r0 error) {
+	f.RemoveTeamUserFunc = func(int, map[string]interface{}) error {
+		return r0
+	}
+}
+
+// GetTeamByID records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) GetTeamByID(id int, params url.Values) (r0 *awx.Team, r1 error) {
+	f.record("GetTeamByID", id, params)
+	if fn := f.GetTeamByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetTeamByIDReturns scripts the results of GetTeamByID.
+func (f *TeamAPI) GetTeamByIDReturns(r0 *awx.Team, r1 error) {
+	f.GetTeamByIDFunc = func(int, url.Values) (*awx.Team, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.Team, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *TeamAPI) GetByNamedURLReturns(r0 *awx.Team, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.Team, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.Team, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *TeamAPI) FindByNameReturns(r0 *awx.Team, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.Team, error) {
+		return r0, r1
+	}
+}
+
+// CreateTeam records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) CreateTeam(data map[string]interface{}, params url.Values) (r0 *awx.Team, r1 error) {
+	f.record("CreateTeam", data, params)
+	if fn := f.CreateTeamFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateTeamReturns scripts the results of CreateTeam.
+func (f *TeamAPI) CreateTeamReturns(r0 *awx.Team, r1 error) {
+	f.CreateTeamFunc = func(map[string]interface{}, url.Values) (*awx.Team, error) {
+		return r0, r1
+	}
+}
+
+// UpdateTeam records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) UpdateTeam(id int, data map[string]interface{}, params url.Values) (r0 *awx.Team, r1 error) {
+	f.record("UpdateTeam", id, data, params)
+	if fn := f.UpdateTeamFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateTeamReturns scripts the results of UpdateTeam.
+func (f *TeamAPI) UpdateTeamReturns(r0 *awx.Team, r1 error) {
+	f.UpdateTeamFunc = func(int, map[string]interface{}, url.Values) (*awx.Team, error) {
+		return r0, r1
+	}
+}
+
+// UpdateTeamRoleEntitlement records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) UpdateTeamRoleEntitlement(id int, data map[string]interface{}, params url.Values) (r0 interface{}, r1 error) {
+	f.record("UpdateTeamRoleEntitlement", id, data, params)
+	if fn := f.UpdateTeamRoleEntitlementFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateTeamRoleEntitlementReturns scripts the results of UpdateTeamRoleEntitlement.
+func (f *TeamAPI) UpdateTeamRoleEntitlementReturns(r0 interface{}, r1 error) {
+	f.UpdateTeamRoleEntitlementFunc = func(int, map[string]interface{}, url.Values) (interface{}, error) {
+		return r0, r1
+	}
+}
+
+// DeleteTeam records the call and returns the scripted results, zero values by default.
+func (f *TeamAPI) DeleteTeam(id int) (r0 *awx.Team, r1 error) {
+	f.record("DeleteTeam", id)
+	if fn := f.DeleteTeamFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteTeamReturns scripts the results of DeleteTeam.
+func (f *TeamAPI) DeleteTeamReturns(r0 *awx.Team, r1 error) {
+	f.DeleteTeamFunc = func(int) (*awx.Team, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.UnifiedJobTemplatesAPI = (*UnifiedJobTemplatesAPI)(nil)
+
+// UnifiedJobTemplatesAPI is an in-memory fake of awx.UnifiedJobTemplatesAPI.
+type UnifiedJobTemplatesAPI struct {
+	Recorder
+
+	ListUnifiedJobTemplatesFunc func(url.Values) ([]awx.AnyJobTemplate, *awx.ListUnifiedJobTemplatesResponse, error)
+}
+
+// ListUnifiedJobTemplates records the call and returns the scripted results, zero values by default.
+func (f *UnifiedJobTemplatesAPI) ListUnifiedJobTemplates(params url.Values) (r0 []awx.AnyJobTemplate, r1 *awx.ListUnifiedJobTemplatesResponse, r2 error) {
+	f.record("ListUnifiedJobTemplates", params)
+	if fn := f.ListUnifiedJobTemplatesFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListUnifiedJobTemplatesReturns scripts the results of ListUnifiedJobTemplates.
+func (f *UnifiedJobTemplatesAPI) ListUnifiedJobTemplatesReturns(r0 []awx.AnyJobTemplate, r1 *awx.ListUnifiedJobTemplatesResponse, r2 error) {
+	f.ListUnifiedJobTemplatesFunc = func(url.Values) ([]awx.AnyJobTemplate, *awx.ListUnifiedJobTemplatesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+var _ awx.UnifiedJobsAPI = (*UnifiedJobsAPI)(nil)
+
+// UnifiedJobsAPI is an in-memory fake of awx.UnifiedJobsAPI.
+type UnifiedJobsAPI struct {
+	Recorder
+
+	ListUnifiedJobsFunc func(url.Values) ([]awx.AnyJob, *awx.ListUnifiedJobsResponse, error)
+}
+
+// ListUnifiedJobs records the call and returns the scripted results, zero values by default.
+func (f *UnifiedJobsAPI) ListUnifiedJobs(params url.Values) (r0 []awx.AnyJob, r1 *awx.ListUnifiedJobsResponse, r2 error) {
+	f.record("ListUnifiedJobs", params)
+	if fn := f.ListUnifiedJobsFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListUnifiedJobsReturns scripts the results of ListUnifiedJobs.
+func (f *UnifiedJobsAPI) ListUnifiedJobsReturns(r0 []awx.AnyJob, r1 *awx.ListUnifiedJobsResponse, r2 error) {
+	f.ListUnifiedJobsFunc = func(url.Values) ([]awx.AnyJob, *awx.ListUnifiedJobsResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+var _ awx.WorkflowJobTemplateScheduleAPI = (*WorkflowJobTemplateScheduleAPI)(nil)
+
+// WorkflowJobTemplateScheduleAPI is an in-memory fake of awx.WorkflowJobTemplateScheduleAPI.
+type WorkflowJobTemplateScheduleAPI struct {
+	Recorder
+
+	ListWorkflowJobTemplateSchedulesFunc  func(int, url.Values) ([]*awx.Schedule, *awx.ListSchedulesResponse, error)
+	CreateWorkflowJobTemplateScheduleFunc func(int, map[string]interface{}, url.Values) (*awx.Schedule, error)
+}
+
+// ListWorkflowJobTemplateSchedules records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateScheduleAPI) ListWorkflowJobTemplateSchedules(id int, params url.Values) (r0 []*awx.Schedule, r1 *awx.ListSchedulesResponse, r2 error) {
+	f.record("ListWorkflowJobTemplateSchedules", id, params)
+	if fn := f.ListWorkflowJobTemplateSchedulesFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// ListWorkflowJobTemplateSchedulesReturns scripts the results of ListWorkflowJobTemplateSchedules.
+func (f *WorkflowJobTemplateScheduleAPI) ListWorkflowJobTemplateSchedulesReturns(r0 []*awx.Schedule, r1 *awx.ListSchedulesResponse, r2 error) {
+	f.ListWorkflowJobTemplateSchedulesFunc = func(int, url.Values) ([]*awx.Schedule, *awx.ListSchedulesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateWorkflowJobTemplateSchedule records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateScheduleAPI) CreateWorkflowJobTemplateSchedule(id int, data map[string]interface{}, params url.Values) (r0 *awx.Schedule, r1 error) {
+	f.record("CreateWorkflowJobTemplateSchedule", id, data, params)
+	if fn := f.CreateWorkflowJobTemplateScheduleFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// CreateWorkflowJobTemplateScheduleReturns scripts the results of CreateWorkflowJobTemplateSchedule.
+func (f *WorkflowJobTemplateScheduleAPI) CreateWorkflowJobTemplateScheduleReturns(r0 *awx.Schedule, r1 error) {
+	f.CreateWorkflowJobTemplateScheduleFunc = func(int, map[string]interface{}, url.Values) (*awx.Schedule, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.WorkflowJobTemplateAPI = (*WorkflowJobTemplateAPI)(nil)
+
+// WorkflowJobTemplateAPI is an in-memory fake of awx.WorkflowJobTemplateAPI.
+type WorkflowJobTemplateAPI struct {
+	Recorder
+
+	GetWorkflowJobTemplateByIDFunc func(int, url.Values) (*awx.WorkflowJobTemplate, error)
+	GetByNamedURLFunc              func(string, url.Values) (*awx.WorkflowJobTemplate, error)
+	GetSurveySpecFunc              func(int) (*awx.SurveySpec, error)
+	FindByNameFunc                 func(context.Context, string, awx.Scope) (*awx.WorkflowJobTemplate, error)
+	ListWorkflowJobTemplatesFunc   func(url.Values) ([]*awx.WorkflowJobTemplate, *awx.ListWorkflowJobTemplatesResponse, error)
+	CreateWorkflowJobTemplateFunc  func(map[string]interface{}, url.Values) (*awx.WorkflowJobTemplate, error)
+	UpdateWorkflowJobTemplateFunc  func(int, map[string]interface{}, url.Values) (*awx.WorkflowJobTemplate, error)
+	DeleteWorkflowJobTemplateFunc  func(int) (*awx.WorkflowJobTemplate, error)
+	LaunchFunc                     func(int, map[string]interface{}, url.Values) (*awx.JobLaunch, error)
+}
+
+// GetWorkflowJobTemplateByID records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateAPI) GetWorkflowJobTemplateByID(id int, params url.Values) (r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.record("GetWorkflowJobTemplateByID", id, params)
+	if fn := f.GetWorkflowJobTemplateByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetWorkflowJobTemplateByIDReturns scripts the results of GetWorkflowJobTemplateByID.
+func (f *WorkflowJobTemplateAPI) GetWorkflowJobTemplateByIDReturns(r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.GetWorkflowJobTemplateByIDFunc = func(int, url.Values) (*awx.WorkflowJobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *WorkflowJobTemplateAPI) GetByNamedURLReturns(r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.WorkflowJobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// GetSurveySpec records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateAPI) GetSurveySpec(id int) (r0 *awx.SurveySpec, r1 error) {
+	f.record("GetSurveySpec", id)
+	if fn := f.GetSurveySpecFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// GetSurveySpecReturns scripts the results of GetSurveySpec.
+func (f *WorkflowJobTemplateAPI) GetSurveySpecReturns(r0 *awx.SurveySpec, r1 error) {
+	f.GetSurveySpecFunc = func(int) (*awx.SurveySpec, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateAPI) FindByName(ctx context.Context, name string, scope awx.Scope) (r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.record("FindByName", ctx, name, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, name, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *WorkflowJobTemplateAPI) FindByNameReturns(r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.WorkflowJobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// ListWorkflowJobTemplates records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateAPI) ListWorkflowJobTemplates(params url.Values) (r0 []*awx.WorkflowJobTemplate, r1 *awx.ListWorkflowJobTemplatesResponse, r2 error) {
+	f.record("ListWorkflowJobTemplates", params)
+	if fn := f.ListWorkflowJobTemplatesFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListWorkflowJobTemplatesReturns scripts the results of ListWorkflowJobTemplates.
+func (f *WorkflowJobTemplateAPI) ListWorkflowJobTemplatesReturns(r0 []*awx.WorkflowJobTemplate, r1 *awx.ListWorkflowJobTemplatesResponse, r2 error) {
+	f.ListWorkflowJobTemplatesFunc = func(url.Values) ([]*awx.WorkflowJobTemplate, *awx.ListWorkflowJobTemplatesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateWorkflowJobTemplate records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateAPI) CreateWorkflowJobTemplate(data map[string]interface{}, params url.Values) (r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.record("CreateWorkflowJobTemplate", data, params)
+	if fn := f.CreateWorkflowJobTemplateFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateWorkflowJobTemplateReturns scripts the results of CreateWorkflowJobTemplate.
+func (f *WorkflowJobTemplateAPI) CreateWorkflowJobTemplateReturns(r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.CreateWorkflowJobTemplateFunc = func(map[string]interface{}, url.Values) (*awx.WorkflowJobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// UpdateWorkflowJobTemplate records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateAPI) UpdateWorkflowJobTemplate(id int, data map[string]interface{}, params url.Values) (r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.record("UpdateWorkflowJobTemplate", id, data, params)
+	if fn := f.UpdateWorkflowJobTemplateFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateWorkflowJobTemplateReturns scripts the results of UpdateWorkflowJobTemplate.
+func (f *WorkflowJobTemplateAPI) UpdateWorkflowJobTemplateReturns(r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.UpdateWorkflowJobTemplateFunc = func(int, map[string]interface{}, url.Values) (*awx.WorkflowJobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// DeleteWorkflowJobTemplate records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateAPI) DeleteWorkflowJobTemplate(id int) (r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.record("DeleteWorkflowJobTemplate", id)
+	if fn := f.DeleteWorkflowJobTemplateFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteWorkflowJobTemplateReturns scripts the results of DeleteWorkflowJobTemplate.
+func (f *WorkflowJobTemplateAPI) DeleteWorkflowJobTemplateReturns(r0 *awx.WorkflowJobTemplate, r1 error) {
+	f.DeleteWorkflowJobTemplateFunc = func(int) (*awx.WorkflowJobTemplate, error) {
+		return r0, r1
+	}
+}
+
+// Launch records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateAPI) Launch(id int, data map[string]interface{}, params url.Values) (r0 *awx.JobLaunch, r1 error) {
+	f.record("Launch", id, data, params)
+	if fn := f.LaunchFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// LaunchReturns scripts the results of Launch.
+func (f *WorkflowJobTemplateAPI) LaunchReturns(r0 *awx.JobLaunch, r1 error) {
+	f.LaunchFunc = func(int, map[string]interface{}, url.Values) (*awx.JobLaunch, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.WorkflowJobTemplateNodeAPI = (*WorkflowJobTemplateNodeAPI)(nil)
+
+// WorkflowJobTemplateNodeAPI is an in-memory fake of awx.WorkflowJobTemplateNodeAPI.
+type WorkflowJobTemplateNodeAPI struct {
+	Recorder
+
+	GetWorkflowJobTemplateNodeByIDFunc func(int, url.Values) (*awx.WorkflowJobTemplateNode, error)
+	GetByNamedURLFunc                  func(string, url.Values) (*awx.WorkflowJobTemplateNode, error)
+	FindByNameFunc                     func(context.Context, string, awx.Scope) (*awx.WorkflowJobTemplateNode, error)
+	ListWorkflowJobTemplateNodesFunc   func(url.Values) ([]*awx.WorkflowJobTemplateNode, *awx.ListWorkflowJobTemplateNodesResponse, error)
+	CreateWorkflowJobTemplateNodeFunc  func(map[string]interface{}, url.Values) (*awx.WorkflowJobTemplateNode, error)
+	UpdateWorkflowJobTemplateNodeFunc  func(int, map[string]interface{}, url.Values) (*awx.WorkflowJobTemplateNode, error)
+	DeleteWorkflowJobTemplateNodeFunc  func(int) (*awx.WorkflowJobTemplateNode, error)
+}
+
+// GetWorkflowJobTemplateNodeByID records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNodeAPI) GetWorkflowJobTemplateNodeByID(id int, params url.Values) (r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.record("GetWorkflowJobTemplateNodeByID", id, params)
+	if fn := f.GetWorkflowJobTemplateNodeByIDFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// GetWorkflowJobTemplateNodeByIDReturns scripts the results of GetWorkflowJobTemplateNodeByID.
+func (f *WorkflowJobTemplateNodeAPI) GetWorkflowJobTemplateNodeByIDReturns(r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.GetWorkflowJobTemplateNodeByIDFunc = func(int, url.Values) (*awx.WorkflowJobTemplateNode, error) {
+		return r0, r1
+	}
+}
+
+// GetByNamedURL records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNodeAPI) GetByNamedURL(identifier string, params url.Values) (r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.record("GetByNamedURL", identifier, params)
+	if fn := f.GetByNamedURLFunc; fn != nil {
+		return fn(identifier, params)
+	}
+	return
+}
+
+// GetByNamedURLReturns scripts the results of GetByNamedURL.
+func (f *WorkflowJobTemplateNodeAPI) GetByNamedURLReturns(r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.GetByNamedURLFunc = func(string, url.Values) (*awx.WorkflowJobTemplateNode, error) {
+		return r0, r1
+	}
+}
+
+// FindByName records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNodeAPI) FindByName(ctx context.Context, identifier string, scope awx.Scope) (r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.record("FindByName", ctx, identifier, scope)
+	if fn := f.FindByNameFunc; fn != nil {
+		return fn(ctx, identifier, scope)
+	}
+	return
+}
+
+// FindByNameReturns scripts the results of FindByName.
+func (f *WorkflowJobTemplateNodeAPI) FindByNameReturns(r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.FindByNameFunc = func(context.Context, string, awx.Scope) (*awx.WorkflowJobTemplateNode, error) {
+		return r0, r1
+	}
+}
+
+// ListWorkflowJobTemplateNodes records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNodeAPI) ListWorkflowJobTemplateNodes(params url.Values) (r0 []*awx.WorkflowJobTemplateNode, r1 *awx.ListWorkflowJobTemplateNodesResponse, r2 error) {
+	f.record("ListWorkflowJobTemplateNodes", params)
+	if fn := f.ListWorkflowJobTemplateNodesFunc; fn != nil {
+		return fn(params)
+	}
+	return
+}
+
+// ListWorkflowJobTemplateNodesReturns scripts the results of ListWorkflowJobTemplateNodes.
+func (f *WorkflowJobTemplateNodeAPI) ListWorkflowJobTemplateNodesReturns(r0 []*awx.WorkflowJobTemplateNode, r1 *awx.ListWorkflowJobTemplateNodesResponse, r2 error) {
+	f.ListWorkflowJobTemplateNodesFunc = func(url.Values) ([]*awx.WorkflowJobTemplateNode, *awx.ListWorkflowJobTemplateNodesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateWorkflowJobTemplateNode records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNodeAPI) CreateWorkflowJobTemplateNode(data map[string]interface{}, params url.Values) (r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.record("CreateWorkflowJobTemplateNode", data, params)
+	if fn := f.CreateWorkflowJobTemplateNodeFunc; fn != nil {
+		return fn(data, params)
+	}
+	return
+}
+
+// CreateWorkflowJobTemplateNodeReturns scripts the results of CreateWorkflowJobTemplateNode.
+func (f *WorkflowJobTemplateNodeAPI) CreateWorkflowJobTemplateNodeReturns(r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.CreateWorkflowJobTemplateNodeFunc = func(map[string]interface{}, url.Values) (*awx.WorkflowJobTemplateNode, error) {
+		return r0, r1
+	}
+}
+
+// UpdateWorkflowJobTemplateNode records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNodeAPI) UpdateWorkflowJobTemplateNode(id int, data map[string]interface{}, params url.Values) (r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.record("UpdateWorkflowJobTemplateNode", id, data, params)
+	if fn := f.UpdateWorkflowJobTemplateNodeFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// UpdateWorkflowJobTemplateNodeReturns scripts the results of UpdateWorkflowJobTemplateNode.
+func (f *WorkflowJobTemplateNodeAPI) UpdateWorkflowJobTemplateNodeReturns(r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.UpdateWorkflowJobTemplateNodeFunc = func(int, map[string]interface{}, url.Values) (*awx.WorkflowJobTemplateNode, error) {
+		return r0, r1
+	}
+}
+
+// DeleteWorkflowJobTemplateNode records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNodeAPI) DeleteWorkflowJobTemplateNode(id int) (r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.record("DeleteWorkflowJobTemplateNode", id)
+	if fn := f.DeleteWorkflowJobTemplateNodeFunc; fn != nil {
+		return fn(id)
+	}
+	return
+}
+
+// DeleteWorkflowJobTemplateNodeReturns scripts the results of DeleteWorkflowJobTemplateNode.
+func (f *WorkflowJobTemplateNodeAPI) DeleteWorkflowJobTemplateNodeReturns(r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.DeleteWorkflowJobTemplateNodeFunc = func(int) (*awx.WorkflowJobTemplateNode, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.WorkflowJobTemplateNodeStepAPI = (*WorkflowJobTemplateNodeStepAPI)(nil)
+
+// WorkflowJobTemplateNodeStepAPI is an in-memory fake of awx.WorkflowJobTemplateNodeStepAPI.
+type WorkflowJobTemplateNodeStepAPI struct {
+	Recorder
+
+	ListWorkflowJobTemplateNodesFunc      func(int, url.Values) ([]*awx.WorkflowJobTemplateNode, *awx.ListWorkflowJobTemplateNodesResponse, error)
+	CreateWorkflowJobTemplateNodeStepFunc func(int, map[string]interface{}, url.Values) (*awx.WorkflowJobTemplateNode, error)
+}
+
+// ListWorkflowJobTemplateNodes records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNodeStepAPI) ListWorkflowJobTemplateNodes(id int, params url.Values) (r0 []*awx.WorkflowJobTemplateNode, r1 *awx.ListWorkflowJobTemplateNodesResponse, r2 error) {
+	f.record("ListWorkflowJobTemplateNodes", id, params)
+	if fn := f.ListWorkflowJobTemplateNodesFunc; fn != nil {
+		return fn(id, params)
+	}
+	return
+}
+
+// ListWorkflowJobTemplateNodesReturns scripts the results of ListWorkflowJobTemplateNodes.
+func (f *WorkflowJobTemplateNodeStepAPI) ListWorkflowJobTemplateNodesReturns(r0 []*awx.WorkflowJobTemplateNode, r1 *awx.ListWorkflowJobTemplateNodesResponse, r2 error) {
+	f.ListWorkflowJobTemplateNodesFunc = func(int, url.Values) ([]*awx.WorkflowJobTemplateNode, *awx.ListWorkflowJobTemplateNodesResponse, error) {
+		return r0, r1, r2
+	}
+}
+
+// CreateWorkflowJobTemplateNodeStep records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNodeStepAPI) CreateWorkflowJobTemplateNodeStep(id int, data map[string]interface{}, params url.Values) (r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.record("CreateWorkflowJobTemplateNodeStep", id, data, params)
+	if fn := f.CreateWorkflowJobTemplateNodeStepFunc; fn != nil {
+		return fn(id, data, params)
+	}
+	return
+}
+
+// CreateWorkflowJobTemplateNodeStepReturns scripts the results of CreateWorkflowJobTemplateNodeStep.
+func (f *WorkflowJobTemplateNodeStepAPI) CreateWorkflowJobTemplateNodeStepReturns(r0 *awx.WorkflowJobTemplateNode, r1 error) {
+	f.CreateWorkflowJobTemplateNodeStepFunc = func(int, map[string]interface{}, url.Values) (*awx.WorkflowJobTemplateNode, error) {
+		return r0, r1
+	}
+}
+
+var _ awx.WorkflowJobTemplateNotificationTemplatesAPI = (*WorkflowJobTemplateNotificationTemplatesAPI)(nil)
+
+// WorkflowJobTemplateNotificationTemplatesAPI is an in-memory fake of awx.WorkflowJobTemplateNotificationTemplatesAPI.
+type WorkflowJobTemplateNotificationTemplatesAPI struct {
+	Recorder
+
+	AssociateWorkflowJobTemplateNotificationTemplatesErrorFunc        func(int, int) (*awx.NotificationTemplate, error)
+	AssociateWorkflowJobTemplateNotificationTemplatesSuccessFunc      func(int, int) (*awx.NotificationTemplate, error)
+	AssociateWorkflowJobTemplateNotificationTemplatesStartedFunc      func(int, int) (*awx.NotificationTemplate, error)
+	AssociateWorkflowJobTemplateNotificationTemplatesApprovalsFunc    func(int, int) (*awx.NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesErrorFunc     func(int, int) (*awx.NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesSuccessFunc   func(int, int) (*awx.NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesStartedFunc   func(int, int) (*awx.NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesApprovalsFunc func(int, int) (*awx.NotificationTemplate, error)
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesError records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) AssociateWorkflowJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("AssociateWorkflowJobTemplateNotificationTemplatesError", jobTemplateID, notificationTemplateID)
+	if fn := f.AssociateWorkflowJobTemplateNotificationTemplatesErrorFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesErrorReturns scripts the results of AssociateWorkflowJobTemplateNotificationTemplatesError.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) AssociateWorkflowJobTemplateNotificationTemplatesErrorReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.AssociateWorkflowJobTemplateNotificationTemplatesErrorFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesSuccess records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) AssociateWorkflowJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("AssociateWorkflowJobTemplateNotificationTemplatesSuccess", jobTemplateID, notificationTemplateID)
+	if fn := f.AssociateWorkflowJobTemplateNotificationTemplatesSuccessFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesSuccessReturns scripts the results of AssociateWorkflowJobTemplateNotificationTemplatesSuccess.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) AssociateWorkflowJobTemplateNotificationTemplatesSuccessReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.AssociateWorkflowJobTemplateNotificationTemplatesSuccessFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesStarted records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) AssociateWorkflowJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("AssociateWorkflowJobTemplateNotificationTemplatesStarted", jobTemplateID, notificationTemplateID)
+	if fn := f.AssociateWorkflowJobTemplateNotificationTemplatesStartedFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesStartedReturns scripts the results of AssociateWorkflowJobTemplateNotificationTemplatesStarted.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) AssociateWorkflowJobTemplateNotificationTemplatesStartedReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.AssociateWorkflowJobTemplateNotificationTemplatesStartedFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesApprovals records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) AssociateWorkflowJobTemplateNotificationTemplatesApprovals(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("AssociateWorkflowJobTemplateNotificationTemplatesApprovals", jobTemplateID, notificationTemplateID)
+	if fn := f.AssociateWorkflowJobTemplateNotificationTemplatesApprovalsFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// AssociateWorkflowJobTemplateNotificationTemplatesApprovalsReturns scripts the results of AssociateWorkflowJobTemplateNotificationTemplatesApprovals.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) AssociateWorkflowJobTemplateNotificationTemplatesApprovalsReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.AssociateWorkflowJobTemplateNotificationTemplatesApprovalsFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesError records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) DisassociateWorkflowJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("DisassociateWorkflowJobTemplateNotificationTemplatesError", jobTemplateID, notificationTemplateID)
+	if fn := f.DisassociateWorkflowJobTemplateNotificationTemplatesErrorFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesErrorReturns scripts the results of DisassociateWorkflowJobTemplateNotificationTemplatesError.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) DisassociateWorkflowJobTemplateNotificationTemplatesErrorReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.DisassociateWorkflowJobTemplateNotificationTemplatesErrorFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesSuccess records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) DisassociateWorkflowJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("DisassociateWorkflowJobTemplateNotificationTemplatesSuccess", jobTemplateID, notificationTemplateID)
+	if fn := f.DisassociateWorkflowJobTemplateNotificationTemplatesSuccessFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesSuccessReturns scripts the results of DisassociateWorkflowJobTemplateNotificationTemplatesSuccess.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) DisassociateWorkflowJobTemplateNotificationTemplatesSuccessReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.DisassociateWorkflowJobTemplateNotificationTemplatesSuccessFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesStarted records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) DisassociateWorkflowJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("DisassociateWorkflowJobTemplateNotificationTemplatesStarted", jobTemplateID, notificationTemplateID)
+	if fn := f.DisassociateWorkflowJobTemplateNotificationTemplatesStartedFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesStartedReturns scripts the results of DisassociateWorkflowJobTemplateNotificationTemplatesStarted.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) DisassociateWorkflowJobTemplateNotificationTemplatesStartedReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.DisassociateWorkflowJobTemplateNotificationTemplatesStartedFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesApprovals records the call and returns the scripted results, zero values by default.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) DisassociateWorkflowJobTemplateNotificationTemplatesApprovals(jobTemplateID int, notificationTemplateID int) (r0 *awx.NotificationTemplate, r1 error) {
+	f.record("DisassociateWorkflowJobTemplateNotificationTemplatesApprovals", jobTemplateID, notificationTemplateID)
+	if fn := f.DisassociateWorkflowJobTemplateNotificationTemplatesApprovalsFunc; fn != nil {
+		return fn(jobTemplateID, notificationTemplateID)
+	}
+	return
+}
+
+// DisassociateWorkflowJobTemplateNotificationTemplatesApprovalsReturns scripts the results of DisassociateWorkflowJobTemplateNotificationTemplatesApprovals.
+func (f *WorkflowJobTemplateNotificationTemplatesAPI) DisassociateWorkflowJobTemplateNotificationTemplatesApprovalsReturns(r0 *awx.NotificationTemplate, r1 error) {
+	f.DisassociateWorkflowJobTemplateNotificationTemplatesApprovalsFunc = func(int, int) (*awx.NotificationTemplate, error) {
+		return r0, r1
+	}
+}
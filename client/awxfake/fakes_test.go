@@ -0,0 +1,55 @@
+package awxfake
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	awx "github.com/denouche/goawx/client"
+)
+
+func TestNewAWXClientMethods(t *testing.T) {
+	client, fakes := NewAWX()
+	if client.HostService != fakes.HostService {
+		t.Errorf("the host service is not the fake one")
+	}
+
+	// the settings of the client are ignored, without panicking
+	client.SetStrictDecoding(true)
+	client.SetNameCache(true)
+	client.SetSchemaValidation(true)
+	client.SetDryRun(true)
+	if plan := client.Plan(); plan != nil {
+		t.Errorf("plan: %v", plan)
+	}
+	client.ResetPlan()
+	if version := client.ServerVersion(); !version.IsZero() {
+		t.Errorf("server version: %+v", version)
+	}
+	if !client.SupportsFeature(awx.FeatureBulkAPI) {
+		t.Errorf("a fake supports every feature")
+	}
+
+	ctx := context.Background()
+	if _, err := awx.Get[awx.Host](ctx, client, "hosts/1/", url.Values{}); err == nil {
+		t.Errorf("get: expected an error")
+	}
+	if _, err := awx.List[awx.Host](ctx, client, "hosts/", url.Values{}); err == nil {
+		t.Errorf("list: expected an error")
+	}
+	if _, err := awx.ListPage[awx.Host](ctx, client, "hosts/", url.Values{}); err == nil {
+		t.Errorf("list page: expected an error")
+	}
+	if _, err := awx.Post[awx.Host](ctx, client, "hosts/", map[string]string{}, nil); err == nil {
+		t.Errorf("post: expected an error")
+	}
+	if _, err := awx.Put[awx.Host](ctx, client, "hosts/1/", map[string]string{}, nil); err == nil {
+		t.Errorf("put: expected an error")
+	}
+	if _, err := awx.Patch[awx.Host](ctx, client, "hosts/1/", map[string]string{}, nil); err == nil {
+		t.Errorf("patch: expected an error")
+	}
+	if err := awx.Delete(ctx, client, "hosts/1/", nil); err == nil {
+		t.Errorf("delete: expected an error")
+	}
+}
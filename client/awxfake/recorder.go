@@ -0,0 +1,58 @@
+// Package awxfake provides in-memory fakes of the awx services interfaces,
+// to unit test code depending on goawx without an AWX server.
+//
+// Each fake records its calls and returns the results scripted with its
+// `<Method>Returns` helpers or `<Method>Func` hooks, zero values otherwise.
+package awxfake
+
+import "sync"
+
+// Call is a recorded call of a fake method.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Recorder records the calls of a fake, it is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the recorded calls in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls of a method in order.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// CallCount returns the number of recorded calls of a method.
+func (r *Recorder) CallCount(method string) int {
+	return len(r.CallsTo(method))
+}
+
+// Reset forgets the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
@@ -0,0 +1,335 @@
+// Package cassette provides a record/replay `http.RoundTripper` for
+// deterministic tests of code talking to AWX.
+//
+// In record mode, requests go to the real server and the interactions are
+// saved into a cassette file, with the authentication headers and secret
+// fields scrubbed. In replay mode, requests are answered from the cassette,
+// matching on method, path, query and body, without any network access.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode of a Transport.
+type Mode int
+
+// Enum of the transport modes.
+const (
+	// ModeReplay answers requests from the cassette, without network access.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the server and records the interactions.
+	ModeRecord
+)
+
+// Scrubbed replaces the scrubbed header values and secret fields.
+const Scrubbed = "**SCRUBBED**"
+
+// ErrNoInteraction is returned in replay mode for requests not matching any unused interaction.
+var ErrNoInteraction = errors.New("no matching interaction in cassette")
+
+// DefaultScrubHeaders are the headers scrubbed from the recorded interactions.
+var DefaultScrubHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Csrftoken", "X-Auth-Token"}
+
+// DefaultDropHeaders are the headers changing on every response, dropped from
+// the recorded interactions so recording again only changes the bodies.
+var DefaultDropHeaders = []string{"Date", "X-Api-Node", "X-Api-Request-Id", "X-Api-Time", "X-Api-Total-Time"}
+
+// DefaultScrubFields are the json fields, at any depth, scrubbed from the
+// recorded bodies. Keys are matched case insensitively.
+var DefaultScrubFields = []string{
+	"password", "secret", "token", "access_token", "refresh_token", "client_secret",
+	"ssh_key_data", "ssh_key_unlock", "become_password", "vault_password",
+	"authorize_password", "security_token", "webhook_key",
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the content of a cassette file.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Transport is a record/replay `http.RoundTripper`, use it as the transport
+// of the `http.Client` given to `NewAWX`.
+type Transport struct {
+	Mode Mode
+	Path string
+	// Transport sends the requests in record mode, `http.DefaultTransport` if nil.
+	Transport    http.RoundTripper
+	ScrubHeaders []string
+	DropHeaders  []string
+	ScrubFields  []string
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// New creates a transport for the cassette file at path. In replay mode the
+// cassette is loaded, in record mode it is written by `Save`.
+func New(path string, mode Mode) (*Transport, error) {
+	t := &Transport{
+		Mode:         mode,
+		Path:         path,
+		ScrubHeaders: append([]string(nil), DefaultScrubHeaders...),
+		DropHeaders:  append([]string(nil), DefaultDropHeaders...),
+		ScrubFields:  append([]string(nil), DefaultScrubFields...),
+		cassette:     &Cassette{},
+	}
+
+	if mode == ModeReplay {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(content, t.cassette); err != nil {
+			return nil, fmt.Errorf("decode cassette %s: %w", path, err)
+		}
+		t.used = make([]bool, len(t.cassette.Interactions))
+	}
+
+	return t, nil
+}
+
+// Client returns an http client using the transport.
+func (t *Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// RoundTrip implements `http.RoundTripper`.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if t.Mode == ModeRecord {
+		return t.record(req, body)
+	}
+	return t.replay(req, body)
+}
+
+func (t *Transport) record(req *http.Request, body []byte) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: Request{
+			Method:  req.Method,
+			Path:    req.URL.Path,
+			Query:   req.URL.Query().Encode(),
+			Headers: t.scrubHeaders(req.Header),
+			Body:    t.scrubBody(body),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    t.scrubHeaders(resp.Header),
+			Body:       t.scrubBody(respBody),
+		},
+	}
+
+	t.mu.Lock()
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction)
+	t.mu.Unlock()
+
+	return resp, nil
+}
+
+func (t *Transport) replay(req *http.Request, body []byte) (*http.Response, error) {
+	query := req.URL.Query().Encode()
+	scrubbed := t.scrubBody(body)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, interaction := range t.cassette.Interactions {
+		if t.used[i] || !matchRequest(interaction.Request, req.Method, req.URL.Path, query, scrubbed) {
+			continue
+		}
+		t.used[i] = true
+
+		recorded := interaction.Response
+		headers := recorded.Headers.Clone()
+		if headers == nil {
+			headers = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        headers,
+			Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s?%s", ErrNoInteraction, req.Method, req.URL.Path, query)
+}
+
+// Save writes the recorded interactions into the cassette file, it does nothing in replay mode.
+func (t *Transport) Save() error {
+	if t.Mode != ModeRecord {
+		return nil
+	}
+
+	t.mu.Lock()
+	content, err := json.MarshalIndent(t.cassette, "", "  ")
+	t.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(t.Path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(t.Path, append(content, '\n'), 0o644)
+}
+
+// Unused returns the interactions of the cassette not replayed yet, nil in record mode.
+func (t *Transport) Unused() []*Interaction {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var unused []*Interaction
+	for i, interaction := range t.cassette.Interactions {
+		if i < len(t.used) && !t.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+func matchRequest(recorded Request, method, path, query, body string) bool {
+	if recorded.Method != method || recorded.Path != path || recorded.Query != query {
+		return false
+	}
+	return sameBody(recorded.Body, body)
+}
+
+// sameBody compares json bodies by value and other bodies as text.
+func sameBody(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+func (t *Transport) scrubHeaders(headers http.Header) http.Header {
+	if len(headers) == 0 {
+		return nil
+	}
+	scrubbed := headers.Clone()
+	// bodies are re-encoded when scrubbed, their length is given by the replayed body
+	scrubbed.Del("Content-Length")
+	for _, name := range t.DropHeaders {
+		scrubbed.Del(name)
+	}
+	for _, name := range t.ScrubHeaders {
+		if scrubbed.Get(name) != "" {
+			scrubbed.Set(name, Scrubbed)
+		}
+	}
+	return scrubbed
+}
+
+// scrubBody replaces the secret fields of a json body, other bodies are kept as is.
+func (t *Transport) scrubBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	content, err := json.Marshal(t.scrubValue(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(content)
+}
+
+func (t *Transport) scrubValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if t.isSecretField(key) {
+				if s, ok := item.(string); ok && s != "" {
+					v[key] = Scrubbed
+				}
+				continue
+			}
+			v[key] = t.scrubValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = t.scrubValue(item)
+		}
+	}
+	return value
+}
+
+func (t *Transport) isSecretField(key string) bool {
+	for _, field := range t.ScrubFields {
+		if strings.EqualFold(field, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,186 @@
+package cassette
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestTransport() *Transport {
+	return &Transport{
+		ScrubHeaders: DefaultScrubHeaders,
+		DropHeaders:  DefaultDropHeaders,
+		ScrubFields:  DefaultScrubFields,
+		cassette:     &Cassette{},
+	}
+}
+
+func TestScrubHeaders(t *testing.T) {
+	transport := newTestTransport()
+	headers := http.Header{
+		"Authorization":  {"Bearer secret"},
+		"Cookie":         {"sessionid=1"},
+		"Content-Type":   {"application/json"},
+		"Content-Length": {"42"},
+		"Date":           {"Mon, 19 Oct 2026 17:37:51 GMT"},
+		"X-Api-Time":     {"0.012s"},
+	}
+	scrubbed := transport.scrubHeaders(headers)
+
+	want := http.Header{
+		"Authorization": {Scrubbed},
+		"Cookie":        {Scrubbed},
+		"Content-Type":  {"application/json"},
+	}
+	if len(scrubbed) != len(want) {
+		t.Errorf("headers: %v, want %v", scrubbed, want)
+	}
+	for name, values := range want {
+		if got := scrubbed.Get(name); got != values[0] {
+			t.Errorf("%s: %q, want %q", name, got, values[0])
+		}
+	}
+	if headers.Get("Authorization") != "Bearer secret" {
+		t.Errorf("the request headers were modified")
+	}
+	if transport.scrubHeaders(nil) != nil {
+		t.Errorf("empty headers are not recorded")
+	}
+}
+
+func TestScrubBody(t *testing.T) {
+	transport := newTestTransport()
+	tests := []struct {
+		name, body, want string
+	}{
+		{"empty", "", ""},
+		{"not json", "password=secret", "password=secret"},
+		{
+			name: "nested fields",
+			body: `{"name":"c","inputs":{"Password":"p","ssh_key_data":"k","username":"u"},"items":[{"token":"t"}]}`,
+			want: `{"inputs":{"Password":"**SCRUBBED**","ssh_key_data":"**SCRUBBED**","username":"u"},"items":[{"token":"**SCRUBBED**"}],"name":"c"}`,
+		},
+		{
+			name: "empty and non string secrets",
+			body: `{"password":"","secret":false,"token":null,"webhook_key":{"value":"x"}}`,
+			want: `{"password":"","secret":false,"token":null,"webhook_key":{"value":"x"}}`,
+		},
+	}
+	for _, tt := range tests {
+		if got := transport.scrubBody([]byte(tt.body)); got != tt.want {
+			t.Errorf("%s: %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMatchRequest(t *testing.T) {
+	recorded := Request{Method: "POST", Path: "/api/v2/hosts/", Query: "a=1&b=2", Body: `{"name":"web","inventory":1}`}
+	tests := []struct {
+		name                      string
+		method, path, query, body string
+		want                      bool
+	}{
+		{"same", "POST", "/api/v2/hosts/", "a=1&b=2", `{"name":"web","inventory":1}`, true},
+		{"json key order and spaces", "POST", "/api/v2/hosts/", "a=1&b=2", `{ "inventory": 1, "name": "web" }`, true},
+		{"method", "PUT", "/api/v2/hosts/", "a=1&b=2", `{"name":"web","inventory":1}`, false},
+		{"path", "POST", "/api/v2/groups/", "a=1&b=2", `{"name":"web","inventory":1}`, false},
+		{"query", "POST", "/api/v2/hosts/", "a=1", `{"name":"web","inventory":1}`, false},
+		{"body", "POST", "/api/v2/hosts/", "a=1&b=2", `{"name":"db","inventory":1}`, false},
+		{"not json body", "POST", "/api/v2/hosts/", "a=1&b=2", `name=web`, false},
+	}
+	for _, tt := range tests {
+		if got := matchRequest(recorded, tt.method, tt.path, tt.query, tt.body); got != tt.want {
+			t.Errorf("%s: got %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Api-Time", "0.01s")
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, strings.Replace(string(body), "}", `,"id":`+string(rune('0'+calls))+`}`, 1))
+			return
+		}
+		io.WriteString(w, `{"count":`+string(rune('0'+calls))+`}`)
+	}))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	send := func(client *http.Client, method, query, body string) (int, string) {
+		t.Helper()
+		req, err := http.NewRequest(method, server.URL+"/api/v2/credentials/"+query, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Basic c2VjcmV0")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %s", method, query, err)
+		}
+		defer resp.Body.Close()
+		content, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(content)
+	}
+
+	recorder, err := New(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	send(recorder.Client(), "GET", "?page=1", "")
+	send(recorder.Client(), "GET", "?page=1", "")
+	send(recorder.Client(), "POST", "", `{"name":"c","inputs":{"password":"p"}}`)
+	if recorder.Unused() != nil {
+		t.Errorf("unused interactions in record mode")
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("save: %s", err)
+	}
+
+	player, err := New(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interaction := player.cassette.Interactions[2]
+	if interaction.Request.Headers.Get("Authorization") != Scrubbed || interaction.Response.Headers.Get("X-Api-Time") != "" {
+		t.Errorf("headers: %v, %v", interaction.Request.Headers, interaction.Response.Headers)
+	}
+	if strings.Contains(interaction.Request.Body, `"p"`) || strings.Contains(interaction.Response.Body, `"p"`) {
+		t.Errorf("the password was recorded: %s, %s", interaction.Request.Body, interaction.Response.Body)
+	}
+
+	// identical requests are answered in the recorded order, a scrubbed secret still matches
+	if _, body := send(player.Client(), "GET", "?page=1", ""); body != `{"count":1}` {
+		t.Errorf("first replay: %s", body)
+	}
+	if len(player.Unused()) != 2 {
+		t.Errorf("unused: %d, want 2", len(player.Unused()))
+	}
+	if _, body := send(player.Client(), "GET", "?page=1", ""); body != `{"count":2}` {
+		t.Errorf("second replay: %s", body)
+	}
+	status, body := send(player.Client(), "POST", "", `{"inputs":{"password":"other"},"name":"c"}`)
+	if status != http.StatusCreated || !strings.Contains(body, `"id":3`) {
+		t.Errorf("post replay: %d %s", status, body)
+	}
+	if unused := player.Unused(); len(unused) != 0 {
+		t.Errorf("unused: %v", unused)
+	}
+	if calls != 3 {
+		t.Errorf("the replay reached the server: %d calls", calls)
+	}
+
+	req, _ := http.NewRequest("GET", server.URL+"/api/v2/credentials/?page=1", nil)
+	if _, err := player.RoundTrip(req); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("expected ErrNoInteraction once replayed, got %v", err)
+	}
+}
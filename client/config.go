@@ -0,0 +1,102 @@
+package awx
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+)
+
+// ConfigService implements awx config apis.
+type ConfigService struct {
+	client *Client
+}
+
+const configAPIEndpoint = "/api/v2/config/"
+
+// GetConfig shows the config of the AWX installation, its license included.
+func (c *ConfigService) GetConfig(params url.Values) (*Config, error) {
+	result := new(Config)
+	resp, err := c.client.Requester.GetJSON(configAPIEndpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// AttachManifest installs the subscription of a manifest file, the zip file
+// exported from the Red Hat subscription allocations, accepting the EULA.
+func (c *ConfigService) AttachManifest(path string) (*LicenseInfo, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"eula_accepted": true,
+		"manifest":      base64.StdEncoding.EncodeToString(content),
+	}
+	return c.post(configAPIEndpoint, data)
+}
+
+// AttachSubscription installs a subscription, by its pool ID, of the
+// subscriptions available to the credentials saved in the settings.
+func (c *ConfigService) AttachSubscription(poolID string) (*LicenseInfo, error) {
+	return c.post(configAPIEndpoint+"attach/", map[string]interface{}{"pool_id": poolID})
+}
+
+func (c *ConfigService) post(endpoint string, data map[string]interface{}) (*LicenseInfo, error) {
+	result := new(LicenseInfo)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ExpiresAt returns the expiry of the license, zero for licenses which don't expire.
+func (l *LicenseInfo) ExpiresAt() time.Time {
+	if l.LicenseDate <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(l.LicenseDate, 0)
+}
+
+// Check returns an error when the license, unless an open one, is invalid,
+// expired at `now` or automates more hosts than it allows.
+func (l *LicenseInfo) Check(now time.Time) error {
+	if l.LicenseType == "open" {
+		return nil
+	}
+	if !l.ValidKey {
+		return fmt.Errorf("invalid %s license", l.LicenseType)
+	}
+	if expiresAt := l.ExpiresAt(); l.DateExpired || (!expiresAt.IsZero() && !now.Before(expiresAt)) {
+		if expiresAt.IsZero() {
+			return fmt.Errorf("%s license expired", l.SubscriptionName)
+		}
+		return fmt.Errorf("%s license expired on %s", l.SubscriptionName, expiresAt.Format(time.RFC3339))
+	}
+	if !l.Compliant {
+		return fmt.Errorf("%s license not compliant: %d hosts automated for %d allowed", l.SubscriptionName, l.AutomatedInstances, l.InstanceCount)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package awx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLicenseInfoCheck(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		name    string
+		license LicenseInfo
+		err     string
+	}{
+		{name: "open", license: LicenseInfo{LicenseType: "open"}},
+		{name: "valid", license: LicenseInfo{LicenseType: "enterprise", ValidKey: true, Compliant: true, LicenseDate: now.Unix() + 1}},
+		{name: "without expiry", license: LicenseInfo{LicenseType: "enterprise", ValidKey: true, Compliant: true}},
+		{name: "invalid key", license: LicenseInfo{LicenseType: "enterprise", Compliant: true}, err: "invalid enterprise license"},
+		{
+			name:    "expired at now",
+			license: LicenseInfo{LicenseType: "enterprise", SubscriptionName: "Ansible", ValidKey: true, Compliant: true, LicenseDate: now.Unix()},
+			err:     "Ansible license expired on " + now.Format(time.RFC3339),
+		},
+		{
+			name:    "reported expired",
+			license: LicenseInfo{LicenseType: "enterprise", SubscriptionName: "Ansible", ValidKey: true, Compliant: true, LicenseDate: now.Unix() + 1, DateExpired: true},
+			err:     "Ansible license expired on " + now.Add(time.Second).Format(time.RFC3339),
+		},
+		{
+			name:    "reported expired without date",
+			license: LicenseInfo{LicenseType: "enterprise", SubscriptionName: "Ansible", ValidKey: true, Compliant: true, DateExpired: true},
+			err:     "Ansible license expired",
+		},
+		{
+			name:    "not compliant",
+			license: LicenseInfo{LicenseType: "enterprise", SubscriptionName: "Ansible", ValidKey: true, AutomatedInstances: 12, InstanceCount: 10},
+			err:     "Ansible license not compliant: 12 hosts automated for 10 allowed",
+		},
+	}
+	for _, tt := range tests {
+		err := tt.license.Check(now)
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("%s: %s", tt.name, err)
+		case tt.err != "" && (err == nil || err.Error() != tt.err):
+			t.Errorf("%s: got %v, want %q", tt.name, err, tt.err)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 type CredentialInputSourceService struct {
@@ -17,7 +18,7 @@ type ListCredentialInputSourceResponse struct {
 
 const credentialInputSourceAPIEndpoint = "/api/v2/credential_input_sources/"
 
-func (cs *CredentialInputSourceService) ListCredentialInputSources(params map[string]string) ([]*CredentialInputSource,
+func (cs *CredentialInputSourceService) ListCredentialInputSources(params url.Values) ([]*CredentialInputSource,
 	*ListCredentialInputSourceResponse,
 	error) {
 	result := new(ListCredentialInputSourceResponse)
@@ -34,7 +35,7 @@ func (cs *CredentialInputSourceService) ListCredentialInputSources(params map[st
 	return result.Results, result, nil
 }
 
-func (cs *CredentialInputSourceService) CreateCredentialInputSource(data map[string]interface{}, params map[string]string) (*CredentialInputSource, error) {
+func (cs *CredentialInputSourceService) CreateCredentialInputSource(data map[string]interface{}, params url.Values) (*CredentialInputSource, error) {
 	result := new(CredentialInputSource)
 	payload, err := json.Marshal(data)
 	if err != nil {
@@ -54,7 +55,7 @@ func (cs *CredentialInputSourceService) CreateCredentialInputSource(data map[str
 	return result, nil
 }
 
-func (cs *CredentialInputSourceService) GetCredentialInputSourceByID(id int, params map[string]string) (*CredentialInputSource, error) {
+func (cs *CredentialInputSourceService) GetCredentialInputSourceByID(id int, params url.Values) (*CredentialInputSource, error) {
 	result := new(CredentialInputSource)
 	endpoint := fmt.Sprintf("%s%d", credentialInputSourceAPIEndpoint, id)
 	resp, err := cs.client.Requester.GetJSON(endpoint, result, params)
@@ -71,7 +72,7 @@ func (cs *CredentialInputSourceService) GetCredentialInputSourceByID(id int, par
 }
 
 func (cs *CredentialInputSourceService) UpdateCredentialInputSourceByID(id int, data map[string]interface{},
-	params map[string]string) (*CredentialInputSource, error) {
+	params url.Values) (*CredentialInputSource, error) {
 	result := new(CredentialInputSource)
 	endpoint := fmt.Sprintf("%s%d", credentialInputSourceAPIEndpoint, id)
 
@@ -93,7 +94,7 @@ func (cs *CredentialInputSourceService) UpdateCredentialInputSourceByID(id int,
 	return result, nil
 }
 
-func (cs *CredentialInputSourceService) DeleteCredentialInputSourceByID(id int, params map[string]string) error {
+func (cs *CredentialInputSourceService) DeleteCredentialInputSourceByID(id int, params url.Values) error {
 	endpoint := fmt.Sprintf("%s%d", credentialInputSourceAPIEndpoint, id)
 	resp, err := cs.client.Requester.Delete(endpoint, nil, params)
 	if err != nil {
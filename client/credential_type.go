@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 type CredentialTypeService struct {
@@ -17,7 +19,7 @@ type ListCredentialTypeResponse struct {
 
 const credentialTypesAPIEndpoint = "/api/v2/credential_types/"
 
-func (cs *CredentialTypeService) ListCredentialTypes(params map[string]string) ([]*CredentialType,
+func (cs *CredentialTypeService) ListCredentialTypes(params url.Values) ([]*CredentialType,
 	*ListCredentialTypeResponse, error) {
 	result := new(ListCredentialTypeResponse)
 	resp, err := cs.client.Requester.GetJSON(credentialTypesAPIEndpoint, result, params)
@@ -33,7 +35,7 @@ func (cs *CredentialTypeService) ListCredentialTypes(params map[string]string) (
 	return result.Results, result, nil
 }
 
-func (cs *CredentialTypeService) CreateCredentialType(data map[string]interface{}, params map[string]string) (*CredentialType, error) {
+func (cs *CredentialTypeService) CreateCredentialType(data map[string]interface{}, params url.Values) (*CredentialType, error) {
 	result := new(CredentialType)
 	payload, err := json.Marshal(data)
 	if err != nil {
@@ -53,7 +55,7 @@ func (cs *CredentialTypeService) CreateCredentialType(data map[string]interface{
 	return result, nil
 }
 
-func (cs *CredentialTypeService) GetCredentialTypeByID(id int, params map[string]string) (*CredentialType, error) {
+func (cs *CredentialTypeService) GetCredentialTypeByID(id int, params url.Values) (*CredentialType, error) {
 	result := new(CredentialType)
 	endpoint := fmt.Sprintf("%s%d", credentialTypesAPIEndpoint, id)
 	resp, err := cs.client.Requester.GetJSON(endpoint, result, params)
@@ -69,7 +71,7 @@ func (cs *CredentialTypeService) GetCredentialTypeByID(id int, params map[string
 	return result, nil
 }
 
-func (cs *CredentialTypeService) UpdateCredentialTypeByID(id int, data map[string]interface{}, params map[string]string) (*CredentialType, error) {
+func (cs *CredentialTypeService) UpdateCredentialTypeByID(id int, data map[string]interface{}, params url.Values) (*CredentialType, error) {
 	result := new(CredentialType)
 	endpoint := fmt.Sprintf("%s%d", credentialTypesAPIEndpoint, id)
 
@@ -91,7 +93,7 @@ func (cs *CredentialTypeService) UpdateCredentialTypeByID(id int, data map[strin
 	return result, nil
 }
 
-func (cs *CredentialTypeService) DeleteCredentialTypeByID(id int, params map[string]string) error {
+func (cs *CredentialTypeService) DeleteCredentialTypeByID(id int, params url.Values) error {
 	endpoint := fmt.Sprintf("%s%d", credentialTypesAPIEndpoint, id)
 	resp, err := cs.client.Requester.Delete(endpoint, nil, params)
 	if err != nil {
@@ -105,3 +107,14 @@ func (cs *CredentialTypeService) DeleteCredentialTypeByID(id int, params map[str
 
 	return nil
 }
+
+// GetByNamedURL shows the details of a credential type by its named url identifier, see `NamedURLCredentialType`.
+func (cs *CredentialTypeService) GetByNamedURL(identifier string, params url.Values) (*CredentialType, error) {
+	return getByNamedURL[CredentialType](cs.client, credentialTypesAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only credential type named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (cs *CredentialTypeService) FindByName(ctx context.Context, name string, scope Scope) (*CredentialType, error) {
+	return findByName[CredentialType](ctx, cs.client, "credential type", credentialTypesAPIEndpoint, "name", name, scope, noScope)
+}
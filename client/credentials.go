@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -18,55 +19,15 @@ type ListCredentialsResponse struct {
 
 const credentialsAPIEndpoint = "/api/v2/credentials/"
 
-func (cs *CredentialsService) ListCredentials(params map[string]string) ([]*Credential, error) {
-	results, err := cs.getAllPages(credentialsAPIEndpoint, params)
+func (cs *CredentialsService) ListCredentials(params url.Values) ([]*Credential, error) {
+	results, err := listAll[Credential](context.Background(), cs.client, credentialsAPIEndpoint, params)
 	if err != nil {
 		return nil, err
 	}
 	return results, nil
 }
 
-func (cs *CredentialsService) getAllPages(firstURL string, params map[string]string) ([]*Credential, error) {
-	results := make([]*Credential, 0)
-	nextURL := firstURL
-	for {
-		nextURLParsed, err := url.Parse(nextURL)
-		if err != nil {
-			return nil, err
-		}
-
-		nextURLQueryParams := make(map[string]string)
-		for paramName, paramValues := range nextURLParsed.Query() {
-			if len(paramValues) > 0 {
-				nextURLQueryParams[paramName] = paramValues[0]
-			}
-		}
-
-		for paramName, paramValue := range params {
-			nextURLQueryParams[paramName] = paramValue
-		}
-
-		result := new(ListCredentialsResponse)
-		resp, err := cs.client.Requester.GetJSON(nextURLParsed.Path, result, nextURLQueryParams)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := CheckResponse(resp); err != nil {
-			return nil, err
-		}
-
-		results = append(results, result.Results...)
-
-		if result.Next == nil || result.Next.(string) == "" {
-			break
-		}
-		nextURL = result.Next.(string)
-	}
-	return results, nil
-}
-
-func (cs *CredentialsService) CreateCredentials(data map[string]interface{}, params map[string]string) (*Credential, error) {
+func (cs *CredentialsService) CreateCredentials(data map[string]interface{}, params url.Values) (*Credential, error) {
 	result := new(Credential)
 	payload, err := json.Marshal(data)
 	if err != nil {
@@ -86,7 +47,7 @@ func (cs *CredentialsService) CreateCredentials(data map[string]interface{}, par
 	return result, nil
 }
 
-func (cs *CredentialsService) GetCredentialsByID(id int, params map[string]string) (*Credential, error) {
+func (cs *CredentialsService) GetCredentialsByID(id int, params url.Values) (*Credential, error) {
 	result := new(Credential)
 	endpoint := fmt.Sprintf("%s%d", credentialsAPIEndpoint, id)
 	resp, err := cs.client.Requester.GetJSON(endpoint, result, params)
@@ -103,7 +64,7 @@ func (cs *CredentialsService) GetCredentialsByID(id int, params map[string]strin
 }
 
 func (cs *CredentialsService) UpdateCredentialsByID(id int, data map[string]interface{},
-	params map[string]string) (*Credential, error) {
+	params url.Values) (*Credential, error) {
 	result := new(Credential)
 	endpoint := fmt.Sprintf("%s%d", credentialsAPIEndpoint, id)
 
@@ -125,7 +86,7 @@ func (cs *CredentialsService) UpdateCredentialsByID(id int, data map[string]inte
 	return result, nil
 }
 
-func (cs *CredentialsService) DeleteCredentialsByID(id int, params map[string]string) error {
+func (cs *CredentialsService) DeleteCredentialsByID(id int, params url.Values) error {
 	endpoint := fmt.Sprintf("%s%d", credentialsAPIEndpoint, id)
 	resp, err := cs.client.Requester.Delete(endpoint, nil, params)
 	if err != nil {
@@ -139,3 +100,14 @@ func (cs *CredentialsService) DeleteCredentialsByID(id int, params map[string]st
 
 	return nil
 }
+
+// GetByNamedURL shows the details of a credential by its named url identifier, see `NamedURLCredential`.
+func (cs *CredentialsService) GetByNamedURL(identifier string, params url.Values) (*Credential, error) {
+	return getByNamedURL[Credential](cs.client, credentialsAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only credential named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (cs *CredentialsService) FindByName(ctx context.Context, name string, scope Scope) (*Credential, error) {
+	return findByName[Credential](ctx, cs.client, "credential", credentialsAPIEndpoint, "name", name, scope, organizationScope)
+}
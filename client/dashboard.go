@@ -0,0 +1,89 @@
+package awx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Enum of the dashboard jobs graph periods.
+const (
+	DashboardPeriodMonth    = "month"
+	DashboardPeriodTwoWeeks = "two_weeks"
+	DashboardPeriodWeek     = "week"
+	DashboardPeriodDay      = "day"
+)
+
+// Enum of the dashboard jobs graph job types.
+const (
+	DashboardJobTypeAll           = "all"
+	DashboardJobTypeInventorySync = "inv_sync"
+	DashboardJobTypePlaybookRun   = "playbook_run"
+	DashboardJobTypeSCMUpdate     = "scm_update"
+)
+
+// DashboardService implements awx dashboard apis.
+type DashboardService struct {
+	client *Client
+}
+
+const dashboardAPIEndpoint = "/api/v2/dashboard/"
+
+// GetDashboard shows the counts of the resources.
+func (d *DashboardService) GetDashboard(params url.Values) (*Dashboard, error) {
+	result := new(Dashboard)
+	resp, err := d.client.Requester.GetJSON(dashboardAPIEndpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetJobsGraph shows the number of successful and failed jobs per day, the
+// params `period` and `job_type` take the `DashboardPeriod*` and
+// `DashboardJobType*` values, a month of every job type by default.
+func (d *DashboardService) GetJobsGraph(params url.Values) (*DashboardJobsGraph, error) {
+	result := new(struct {
+		Jobs *DashboardJobsGraph `json:"jobs"`
+	})
+	endpoint := dashboardAPIEndpoint + "graphs/jobs/"
+	resp, err := d.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if result.Jobs == nil {
+		return &DashboardJobsGraph{}, nil
+	}
+	return result.Jobs, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, a point is a `[timestamp, count]` pair.
+func (p *DashboardGraphPoint) UnmarshalJSON(data []byte) error {
+	var pair []float64
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("invalid dashboard graph point %s", data)
+	}
+
+	p.Time = time.Unix(int64(pair[0]), 0).UTC()
+	p.Count = int(pair[1])
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p DashboardGraphPoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]int64{p.Time.Unix(), int64(p.Count)})
+}
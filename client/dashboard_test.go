@@ -0,0 +1,45 @@
+package awx
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestDashboardGraphPointUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data  string
+		want  DashboardGraphPoint
+		fails bool
+	}{
+		{data: `[1700000000, 4]`, want: DashboardGraphPoint{Time: time.Unix(1700000000, 0).UTC(), Count: 4}},
+		{data: `[1700000000.0, 0]`, want: DashboardGraphPoint{Time: time.Unix(1700000000, 0).UTC()}},
+		{data: `[1700000000]`, fails: true},
+		{data: `[1700000000, 4, 2]`, fails: true},
+		{data: `{"time": 1700000000}`, fails: true},
+		{data: `["2023-11-14", 4]`, fails: true},
+	}
+	for _, tt := range tests {
+		var point DashboardGraphPoint
+		err := json.Unmarshal([]byte(tt.data), &point)
+		if tt.fails {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %+v", tt.data, point)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", tt.data, err)
+			continue
+		}
+		if !point.Time.Equal(tt.want.Time) || point.Time.Location() != time.UTC || point.Count != tt.want.Count {
+			t.Errorf("%s: got %+v, want %+v", tt.data, point, tt.want)
+		}
+
+		content, err := json.Marshal(point)
+		if err != nil || string(content) != fmt.Sprintf("[%d,%d]", tt.want.Time.Unix(), tt.want.Count) {
+			t.Errorf("%s: marshaled %s, %v", tt.data, content, err)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package awx
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// UnknownFieldsError is returned in strict decoding mode when a response
+// holds fields which are not part of the decoded types.
+type UnknownFieldsError struct {
+	Endpoint string
+	// Fields lists the unknown json fields by Go type name.
+	Fields map[string][]string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	types := make([]string, 0, len(e.Fields))
+	for typ := range e.Fields {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	details := make([]string, 0, len(types))
+	for _, typ := range types {
+		details = append(details, fmt.Sprintf("%s: %s", typ, strings.Join(e.Fields[typ], ", ")))
+	}
+	return fmt.Sprintf("unknown fields in %s response: %s", e.Endpoint, strings.Join(details, "; "))
+}
+
+// SetStrictDecoding enables or disables the strict decoding mode, in which
+// responses holding fields unknown to the Go types fail with an `*UnknownFieldsError`.
+func (a *AWX) SetStrictDecoding(strict bool) {
+	if a.client == nil {
+		return
+	}
+	a.client.Requester.StrictDecoding = strict
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// unknownFields decodes content generically and compares it with the type of v,
+// it returns the unknown json fields by Go type name.
+func unknownFields(content []byte, v interface{}) (map[string][]string, error) {
+	var generic interface{}
+	if err := json.Unmarshal(content, &generic); err != nil {
+		return nil, err
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.IsValid() && (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface) && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || rv.Kind() == reflect.Interface {
+		return nil, nil
+	}
+
+	found := map[string]map[string]bool{}
+	collectUnknownFields(rv.Type(), generic, found)
+	if len(found) == 0 {
+		return nil, nil
+	}
+
+	fields := make(map[string][]string, len(found))
+	for typ, names := range found {
+		for name := range names {
+			fields[typ] = append(fields[typ], name)
+		}
+		sort.Strings(fields[typ])
+	}
+	return fields, nil
+}
+
+// kindedList is implemented by the lists whose items decode into the Go type
+// of their `type`, e.g. AnyJobs.
+type kindedList interface {
+	kindType(kind string) reflect.Type
+}
+
+func collectUnknownFields(t reflect.Type, value interface{}, found map[string]map[string]bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if list, ok := reflect.Zero(t).Interface().(kindedList); ok {
+		items, _ := value.([]interface{})
+		for _, item := range items {
+			object, _ := item.(map[string]interface{})
+			kind, _ := object["type"].(string)
+			collectUnknownFields(list.kindType(kind), item, found)
+		}
+		return
+	}
+	if value == nil || t.Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(jsonUnmarshalerType) ||
+		t.Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+		fields := jsonFields(t)
+		for name, fieldValue := range object {
+			fieldType, ok := lookupJSONField(fields, name)
+			if !ok {
+				typ := t.String()
+				if found[typ] == nil {
+					found[typ] = map[string]bool{}
+				}
+				found[typ][name] = true
+				continue
+			}
+			collectUnknownFields(fieldType, fieldValue, found)
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]interface{})
+		if !ok {
+			return
+		}
+		for _, item := range items {
+			collectUnknownFields(t.Elem(), item, found)
+		}
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for _, item := range object {
+			collectUnknownFields(t.Elem(), item, found)
+		}
+	}
+}
+
+// jsonFields returns the json field names of a struct type, embedded structs included.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for embeddedName, embeddedType := range jsonFields(embedded) {
+					if _, ok := fields[embeddedName]; !ok {
+						fields[embeddedName] = embeddedType
+					}
+				}
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// lookupJSONField matches a json key the way encoding/json does, case insensitively as fallback.
+func lookupJSONField(fields map[string]reflect.Type, name string) (reflect.Type, bool) {
+	if t, ok := fields[name]; ok {
+		return t, true
+	}
+	for fieldName, t := range fields {
+		if strings.EqualFold(fieldName, name) {
+			return t, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,112 @@
+package awx
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type decodeItem struct {
+	Name string `json:"name"`
+}
+
+type decodeEmbedded struct {
+	ID int `json:"id"`
+}
+
+type decodeObject struct {
+	decodeEmbedded
+	Items    []*decodeItem         `json:"items"`
+	ByName   map[string]decodeItem `json:"by_name"`
+	Custom   Nullable[int]         `json:"custom"`
+	Ignored  string                `json:"-"`
+	Untagged string
+}
+
+func TestUnknownFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    map[string][]string
+	}{{
+		name:    "known fields",
+		content: `{"id": 1, "items": [{"name": "a"}], "by_name": {"a": {"name": "a"}}, "custom": null, "untagged": "x"}`,
+	}, {
+		name:    "top level",
+		content: `{"id": 1, "extra": true, "other": 2}`,
+		want:    map[string][]string{"awx.decodeObject": {"extra", "other"}},
+	}, {
+		name:    "nested in slices and maps",
+		content: `{"items": [{"name": "a", "color": "red"}], "by_name": {"b": {"size": 1}}}`,
+		want:    map[string][]string{"awx.decodeItem": {"color", "size"}},
+	}, {
+		name:    "json dash field",
+		content: `{"Ignored": "x"}`,
+		want:    map[string][]string{"awx.decodeObject": {"Ignored"}},
+	}, {
+		name:    "custom unmarshaler and null values",
+		content: `{"custom": {"anything": 1}, "items": null}`,
+	}}
+
+	for _, tt := range tests {
+		got, err := unknownFields([]byte(tt.content), new(decodeObject))
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := unknownFields([]byte(`{`), new(decodeObject)); err == nil {
+		t.Errorf("expected an error for invalid json")
+	}
+	var generic interface{}
+	if got, err := unknownFields([]byte(`{"a": 1}`), &generic); err != nil || got != nil {
+		t.Errorf("generic value: %v, %v", got, err)
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/users/1/":
+			io.WriteString(w, `{"id": 1, "username": "admin", "new_field": true}`)
+		case "/api/v2/users/2/":
+			io.WriteString(w, `{"id": "two"}`)
+		}
+	}))
+	defer server.Close()
+	client := newTestClient(server)
+	users := &UserService{client: client}
+
+	user, err := users.GetUserByID(1, url.Values{})
+	if err != nil || user.Username != "admin" {
+		t.Fatalf("lenient decoding: %+v, %v", user, err)
+	}
+
+	client.Requester.StrictDecoding = true
+	_, err = users.GetUserByID(1, url.Values{})
+	var unknown *UnknownFieldsError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("expected an UnknownFieldsError, got %v", err)
+	}
+	if unknown.Endpoint != "/api/v2/users/1/" || !reflect.DeepEqual(unknown.Fields, map[string][]string{"awx.User": {"new_field"}}) {
+		t.Errorf("unknown fields: %+v", unknown)
+	}
+	if want := "unknown fields in /api/v2/users/1/ response: awx.User: new_field"; err.Error() != want {
+		t.Errorf("error: %q, want %q", err, want)
+	}
+
+	// type mismatches are returned in both modes
+	client.Requester.StrictDecoding = false
+	if _, err := users.GetUserByID(2, url.Values{}); err == nil || !strings.Contains(err.Error(), "decode /api/v2/users/2/ response") {
+		t.Errorf("expected a decode error, got %v", err)
+	}
+}
@@ -0,0 +1,291 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// FieldChange is a change of a field value in a plan step. `Present` reports
+// whether the current resource has the field, `Old` is its current value, nil
+// for a null value, a new resource or when the resource could not be fetched.
+type FieldChange struct {
+	Field   string
+	Old     interface{}
+	New     interface{}
+	Present bool
+}
+
+// PlanStep is a mutating request captured in dry-run mode.
+type PlanStep struct {
+	Method   string
+	Endpoint string
+	Query    string
+	// Payload is the decoded request body, nil without body.
+	Payload interface{}
+	// Changes lists the payload fields differing from the current resource
+	// for PATCH and PUT, and every payload field for POST.
+	Changes []FieldChange
+}
+
+func (s PlanStep) String() string {
+	var b strings.Builder
+	b.WriteString(s.Method + " " + s.Endpoint)
+	if s.Query != "" {
+		b.WriteString("?" + s.Query)
+	}
+	for _, change := range s.Changes {
+		if !change.Present {
+			fmt.Fprintf(&b, "\n  + %s: %s", change.Field, planValue(change.New))
+		} else {
+			fmt.Fprintf(&b, "\n  ~ %s: %s -> %s", change.Field, planValue(change.Old), planValue(change.New))
+		}
+	}
+	return b.String()
+}
+
+// Plan is the ordered list of the mutating requests captured in dry-run mode.
+type Plan []PlanStep
+
+func (p Plan) String() string {
+	steps := make([]string, 0, len(p))
+	for i, step := range p {
+		steps = append(steps, fmt.Sprintf("%d. %s", i+1, step))
+	}
+	return strings.Join(steps, "\n")
+}
+
+// DryRunID is the ID of the jobs and notifications started by the launches,
+// relaunches and tests captured in dry-run mode, no resource has it.
+const DryRunID = -1
+
+// dryRunStarted are the endpoints starting a job or a notification, by path
+// prefix and action, with the fields of the started resource ID AWX answers.
+var dryRunStarted = []struct {
+	prefix string
+	action string
+	fields []string
+}{
+	{jobTemplateAPIEndpoint, "launch/", []string{"id", "job"}},
+	{workflowJobTemplateAPIEndpoint, "launch/", []string{"id", "workflow_job"}},
+	{systemJobTemplatesAPIEndpoint, "launch/", []string{"id", "system_job"}},
+	{jobAPIEndpoint, "relaunch/", []string{"id"}},
+	{WorkflowJobAPIEndpoint, "relaunch/", []string{"id"}},
+	{notificationTemplatesAPIEndpoint, "test/", []string{"notification"}},
+}
+
+// SetDryRun enables or disables the dry-run mode. In dry-run mode GET requests
+// are sent, POST, PATCH, PUT and DELETE requests are captured into the plan
+// and answered with a synthetic response: an empty object for POST, the current
+// resource updated with the payload for PATCH and PUT, no content for DELETE.
+// A launch, a relaunch or a notification test is answered with the `DryRunID`
+// of the job or notification it would start.
+// It is safe to call while requests are in flight.
+func (a *AWX) SetDryRun(enabled bool) {
+	if a.client == nil {
+		return
+	}
+	if enabled {
+		a.client.Requester.dryRun.CompareAndSwap(nil, &dryRunPlan{})
+	} else {
+		a.client.Requester.dryRun.Store(nil)
+	}
+}
+
+// Plan returns the requests captured since dry-run mode was enabled.
+func (a *AWX) Plan() Plan {
+	if a.client == nil {
+		return nil
+	}
+	return a.client.Requester.dryRun.Load().get()
+}
+
+// ResetPlan forgets the captured requests.
+func (a *AWX) ResetPlan() {
+	if a.client == nil {
+		return
+	}
+	a.client.Requester.dryRun.Load().reset()
+}
+
+// dryRunPlan holds the captured requests.
+type dryRunPlan struct {
+	mu    sync.Mutex
+	steps Plan
+}
+
+func (p *dryRunPlan) get() Plan {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append(Plan(nil), p.steps...)
+}
+
+func (p *dryRunPlan) reset() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.steps = nil
+}
+
+func (p *dryRunPlan) add(step PlanStep) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.steps = append(p.steps, step)
+}
+
+// isMutating reports whether the method changes server state.
+func isMutating(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// capture adds a mutating request to the dry-run plan and returns its synthetic response.
+// The created resource is answered as an empty object: the payload doesn't always
+// decode into the resource type, an ID-less empty resource always does.
+func (r *Requester) capture(plan *dryRunPlan, ar *APIRequest, URL *url.URL) (*http.Response, error) {
+	var payload interface{}
+	if ar.Payload != nil {
+		body, err := ioutil.ReadAll(ar.Payload)
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(body)) > 0 {
+			if err := json.Unmarshal(body, &payload); err != nil {
+				payload = string(body)
+			}
+		}
+	}
+
+	method := strings.ToUpper(ar.Method)
+	step := PlanStep{Method: method, Endpoint: URL.Path, Query: URL.RawQuery, Payload: payload}
+
+	status := http.StatusOK
+	var result interface{} = map[string]interface{}{}
+	fields, _ := payload.(map[string]interface{})
+	switch method {
+	case http.MethodPost:
+		status = http.StatusCreated
+		step.Changes = diffFields(nil, fields)
+		if started := startedFields(URL.Path); started != nil {
+			result = started
+		}
+	case http.MethodPatch, http.MethodPut:
+		current := r.currentResource(ar, URL)
+		step.Changes = diffFields(current, fields)
+		if current != nil {
+			for key, value := range fields {
+				current[key] = value
+			}
+			result = current
+		}
+	case http.MethodDelete:
+		status = http.StatusNoContent
+		result = nil
+	}
+	plan.add(step)
+
+	var content []byte
+	if result != nil {
+		var err error
+		if content, err = json.Marshal(result); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := ar.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, URL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(content)),
+		ContentLength: int64(len(content)),
+		Request:       req,
+	}, nil
+}
+
+// startedFields returns the synthetic response of an endpoint starting a job or
+// a notification, nil for the other endpoints.
+func startedFields(path string) map[string]interface{} {
+	for _, started := range dryRunStarted {
+		if !strings.HasPrefix(path, started.prefix) || !strings.HasSuffix(path, "/"+started.action) {
+			continue
+		}
+		result := map[string]interface{}{}
+		for _, field := range started.fields {
+			result[field] = DryRunID
+		}
+		return result
+	}
+	return nil
+}
+
+// currentResource fetches the resource a PATCH or PUT applies to, nil when it can't be read.
+func (r *Requester) currentResource(ar *APIRequest, URL *url.URL) map[string]interface{} {
+	resourceURL := *URL
+	resourceURL.RawQuery = ""
+	get := &APIRequest{Method: http.MethodGet, Endpoint: ar.Endpoint, Headers: http.Header{}, Context: ar.Context}
+
+	response, err := r.send(get, &resourceURL, nil)
+	if err != nil {
+		return nil
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil
+	}
+
+	var current map[string]interface{}
+	if err := json.NewDecoder(response.Body).Decode(&current); err != nil {
+		return nil
+	}
+	return current
+}
+
+// diffFields lists the payload fields differing from the current values, sorted by name.
+func diffFields(current, payload map[string]interface{}) []FieldChange {
+	changes := make([]FieldChange, 0, len(payload))
+	for field, value := range payload {
+		old, ok := current[field]
+		if ok && reflect.DeepEqual(old, value) {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: field, Old: old, New: value, Present: ok})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
+
+func planValue(value interface{}) string {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(content)
+}
@@ -0,0 +1,231 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// jobTemplateServer serves the job template 5, it fails the mutating requests unless they are allowed.
+func jobTemplateServer(t *testing.T, allowWrites bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && !allowWrites {
+			t.Errorf("%s %s reached the server", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.URL.Path != jobTemplateAPIEndpoint+"5/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		io.WriteString(w, `{"id": 5, "name": "deploy", "verbosity": 0, "forks": 5}`)
+	}))
+}
+
+func TestDryRun(t *testing.T) {
+	server := jobTemplateServer(t, false)
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+	a.SetDryRun(true)
+
+	// the payload doesn't decode into a JobTemplate, the synthetic response still does
+	created, err := a.JobTemplateService.CreateJobTemplate(map[string]interface{}{
+		"name": "deploy", "job_type": "run", "inventory": 1, "project": 2, "extra_vars": map[string]interface{}{"env": "prod"},
+	}, url.Values{})
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	if created.ID != 0 || created.Name != "" {
+		t.Errorf("created: %+v", created)
+	}
+
+	updated, err := a.JobTemplateService.UpdateJobTemplate(5, map[string]interface{}{"verbosity": 2, "forks": 5}, url.Values{})
+	if err != nil {
+		t.Fatalf("update: %s", err)
+	}
+	if updated.ID != 5 || updated.Name != "deploy" || updated.Verbosity != 2 {
+		t.Errorf("updated: %+v", updated)
+	}
+	if _, err := a.JobTemplateService.UpdateJobTemplate(9, map[string]interface{}{"verbosity": 2}, url.Values{}); err != nil {
+		t.Fatalf("update of an unreadable resource: %s", err)
+	}
+	if _, err := a.JobTemplateService.DeleteJobTemplate(5); err != nil {
+		t.Fatalf("delete: %s", err)
+	}
+
+	plan := a.Plan()
+	if len(plan) != 4 {
+		t.Fatalf("plan: %s", plan)
+	}
+	want := []FieldChange{{Field: "verbosity", Old: float64(0), New: float64(2), Present: true}}
+	if plan[1].Method != http.MethodPatch || plan[1].Endpoint != jobTemplateAPIEndpoint+"5/" || !reflect.DeepEqual(plan[1].Changes, want) {
+		t.Errorf("update step: %+v", plan[1])
+	}
+	if len(plan[0].Changes) != 5 || plan[0].Changes[0].Old != nil || plan[0].Changes[0].Present {
+		t.Errorf("create step: %+v", plan[0])
+	}
+	if plan[3].Method != http.MethodDelete || plan[3].Payload != nil {
+		t.Errorf("delete step: %+v", plan[3])
+	}
+	if want := "1. PATCH /api/v2/job_templates/5/\n  ~ verbosity: 0 -> 2"; plan[1:2].String() != want {
+		t.Errorf("plan string: %q", plan[1:2].String())
+	}
+
+	a.ResetPlan()
+	if len(a.Plan()) != 0 {
+		t.Errorf("plan after reset: %s", a.Plan())
+	}
+	a.SetDryRun(false)
+	if a.Plan() != nil {
+		t.Errorf("plan after dry run: %s", a.Plan())
+	}
+}
+
+func TestPlanStepChanges(t *testing.T) {
+	current := map[string]interface{}{"name": "deploy", "verbosity": float64(0), "inventory": nil, "limit": "web"}
+	tests := []struct {
+		name    string
+		current map[string]interface{}
+		payload map[string]interface{}
+		want    string
+	}{
+		{name: "changed", current: current, payload: map[string]interface{}{"verbosity": float64(2)}, want: "\n  ~ verbosity: 0 -> 2"},
+		{name: "null to value", current: current, payload: map[string]interface{}{"inventory": float64(3)}, want: "\n  ~ inventory: null -> 3"},
+		{name: "value to null", current: current, payload: map[string]interface{}{"limit": nil}, want: "\n  ~ limit: \"web\" -> null"},
+		{name: "absent field", current: current, payload: map[string]interface{}{"forks": float64(5)}, want: "\n  + forks: 5"},
+		{name: "unchanged", current: current, payload: map[string]interface{}{"name": "deploy", "inventory": nil}},
+		{name: "new resource", payload: map[string]interface{}{"inventory": nil, "name": "deploy"}, want: "\n  + inventory: null\n  + name: \"deploy\""},
+	}
+	for _, tt := range tests {
+		step := PlanStep{Method: http.MethodPatch, Endpoint: jobTemplateAPIEndpoint + "5/", Changes: diffFields(tt.current, tt.payload)}
+		if got, want := step.String(), "PATCH /api/v2/job_templates/5/"+tt.want; got != want {
+			t.Errorf("%s: %q, want %q", tt.name, got, want)
+		}
+	}
+}
+
+func TestDryRunToggle(t *testing.T) {
+	server := jobTemplateServer(t, true)
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+	a.SetDryRun(true)
+
+	// enabling the dry-run mode again keeps the plan
+	if _, err := a.JobTemplateService.UpdateJobTemplate(5, map[string]interface{}{"forks": 10}, url.Values{}); err != nil {
+		t.Fatal(err)
+	}
+	a.SetDryRun(true)
+	if len(a.Plan()) != 1 {
+		t.Errorf("plan: %s", a.Plan())
+	}
+
+	// toggled while requests are in flight, run with -race
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				a.JobTemplateService.UpdateJobTemplate(5, map[string]interface{}{"forks": j}, url.Values{})
+				a.Plan()
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		a.SetDryRun(i%2 == 0)
+		a.SetDryRun(true)
+	}
+	wg.Wait()
+}
+
+func TestDryRunLaunch(t *testing.T) {
+	server := jobTemplateServer(t, false)
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+	a.SetDryRun(true)
+
+	tests := []struct {
+		name     string
+		launch   func() (int, error)
+		endpoint string
+	}{{
+		name: "job template",
+		launch: func() (int, error) {
+			launch, err := a.JobTemplateService.Launch(5, map[string]interface{}{"limit": "web"}, url.Values{})
+			if err != nil {
+				return 0, err
+			}
+			return launch.Job, nil
+		},
+		endpoint: jobTemplateAPIEndpoint + "5/launch/",
+	}, {
+		name: "workflow job template",
+		launch: func() (int, error) {
+			launch, err := a.WorkflowJobTemplateService.Launch(6, map[string]interface{}{}, url.Values{})
+			if err != nil {
+				return 0, err
+			}
+			return launch.ID, nil
+		},
+		endpoint: workflowJobTemplateAPIEndpoint + "6/launch/",
+	}, {
+		name: "system job template",
+		launch: func() (int, error) {
+			launch, err := a.SystemJobTemplatesService.Launch(1, map[string]interface{}{}, url.Values{})
+			if err != nil {
+				return 0, err
+			}
+			return launch.SystemJobID, nil
+		},
+		endpoint: systemJobTemplatesAPIEndpoint + "1/launch/",
+	}, {
+		name: "job relaunch",
+		launch: func() (int, error) {
+			launch, err := a.JobService.RelaunchJob(12, map[string]interface{}{}, url.Values{})
+			if err != nil {
+				return 0, err
+			}
+			return launch.ID, nil
+		},
+		endpoint: jobAPIEndpoint + "12/relaunch/",
+	}, {
+		name: "notification test",
+		launch: func() (int, error) {
+			notification, err := a.NotificationTemplatesService.Test(context.Background(), 3)
+			if err != nil {
+				return 0, err
+			}
+			if notification.Status != NotificationStatusPending || notification.NotificationTemplate != 3 {
+				return 0, fmt.Errorf("notification: %+v", notification)
+			}
+			return notification.ID, nil
+		},
+		endpoint: notificationTemplatesAPIEndpoint + "3/test/",
+	}}
+	for _, tt := range tests {
+		a.ResetPlan()
+		id, err := tt.launch()
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+		if id != DryRunID {
+			t.Errorf("%s: id %d, want %d", tt.name, id, DryRunID)
+		}
+		if plan := a.Plan(); len(plan) != 1 || plan[0].Method != http.MethodPost || plan[0].Endpoint != tt.endpoint {
+			t.Errorf("%s: plan %s", tt.name, plan)
+		}
+	}
+
+	// a creation isn't a launch
+	created, err := a.JobTemplateService.CreateJobTemplate(map[string]interface{}{"name": "deploy", "job_type": "run", "inventory": 1, "project": 2}, url.Values{})
+	if err != nil || created.ID != 0 {
+		t.Errorf("create: %+v, %v", created, err)
+	}
+}
@@ -0,0 +1,216 @@
+package awx
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// towerCLIConfigFiles lists the tower-cli config files, from the lowest to the highest precedence.
+var towerCLIConfigFiles = []string{
+	"/etc/tower/tower_cli.cfg",
+	"~/.tower_cli.cfg",
+	".tower_cli.cfg",
+}
+
+// EnvironmentConfig represents the connection settings shared with awxkit and tower-cli.
+type EnvironmentConfig struct {
+	Host      string
+	Username  string
+	Password  string
+	Token     string
+	VerifySSL bool
+	CABundle  string
+}
+
+// LoadEnvironmentConfig resolves the connection settings the same way awxkit and tower-cli do.
+//
+// Settings are read, from the lowest to the highest precedence, from:
+//   - `/etc/tower/tower_cli.cfg`, `~/.tower_cli.cfg` and `./.tower_cli.cfg` (`host`, `username`,
+//     `password`, `oauth_token` and `verify_ssl` keys of the `[general]` section);
+//   - the `TOWER_HOST`, `TOWER_USERNAME`, `TOWER_PASSWORD`, `TOWER_OAUTH_TOKEN` and `TOWER_VERIFY_SSL`
+//     environment variables;
+//   - the `CONTROLLER_HOST`, `CONTROLLER_USERNAME`, `CONTROLLER_PASSWORD`, `CONTROLLER_OAUTH_TOKEN`
+//     and `CONTROLLER_VERIFY_SSL` environment variables.
+//
+// The credential type follows the same precedence: a source setting a username
+// or a password discards the token of the lower sources, and a source setting a
+// token discards their username and password.
+//
+// The CA bundle is read from `REQUESTS_CA_BUNDLE` or `CURL_CA_BUNDLE`.
+// A host without scheme defaults to https.
+func LoadEnvironmentConfig() (*EnvironmentConfig, error) {
+	settings := map[string]string{}
+
+	for _, path := range towerCLIConfigFiles {
+		if strings.HasPrefix(path, "~/") {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				continue
+			}
+			path = filepath.Join(home, path[2:])
+		}
+		source := map[string]string{}
+		if err := readTowerCLIConfig(path, source); err != nil {
+			return nil, err
+		}
+		mergeSettings(settings, source)
+	}
+
+	for _, prefix := range []string{"TOWER_", "CONTROLLER_"} {
+		source := map[string]string{}
+		for _, key := range []string{"host", "username", "password", "oauth_token", "verify_ssl"} {
+			if value, ok := os.LookupEnv(prefix + strings.ToUpper(key)); ok {
+				source[key] = value
+			}
+		}
+		mergeSettings(settings, source)
+	}
+
+	config := &EnvironmentConfig{
+		Host:      settings["host"],
+		Username:  settings["username"],
+		Password:  settings["password"],
+		Token:     settings["oauth_token"],
+		VerifySSL: true,
+	}
+
+	if config.Host == "" {
+		return nil, errors.New("no AWX host found in environment or tower-cli config files")
+	}
+	if !strings.Contains(config.Host, "://") {
+		config.Host = "https://" + config.Host
+	}
+	config.Host = strings.TrimRight(config.Host, "/")
+
+	if value, ok := settings["verify_ssl"]; ok {
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "false", "no", "off", "0":
+			config.VerifySSL = false
+		}
+	}
+
+	for _, name := range []string{"REQUESTS_CA_BUNDLE", "CURL_CA_BUNDLE"} {
+		if value := os.Getenv(name); value != "" {
+			config.CABundle = value
+			break
+		}
+	}
+
+	return config, nil
+}
+
+// mergeSettings overrides the settings with the ones of a higher precedence
+// source, the credentials of another type than the source ones are discarded.
+func mergeSettings(settings, source map[string]string) {
+	_, hasUsername := source["username"]
+	_, hasPassword := source["password"]
+	_, hasToken := source["oauth_token"]
+	if hasUsername || hasPassword {
+		delete(settings, "oauth_token")
+	}
+	if hasToken {
+		delete(settings, "username")
+		delete(settings, "password")
+	}
+	for key, value := range source {
+		settings[key] = value
+	}
+}
+
+// readTowerCLIConfig reads an ini formatted tower-cli config file into settings, a missing file is ignored.
+func readTowerCLIConfig(path string, settings map[string]string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	defer file.Close()
+
+	section := "general"
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		if section != "general" {
+			continue
+		}
+
+		sep := strings.IndexAny(line, "=:")
+		if sep < 0 {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(line[:sep]))
+		value := strings.Trim(strings.TrimSpace(line[sep+1:]), `"'`)
+		settings[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read %s: %w", path, err)
+	}
+	return nil
+}
+
+// HTTPClient builds an http client honoring the TLS settings.
+func (c *EnvironmentConfig) HTTPClient() (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: !c.VerifySSL, //nolint:gosec
+	}
+
+	if c.CABundle != "" {
+		pem, err := os.ReadFile(c.CABundle)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in CA bundle %s", c.CABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}, nil
+}
+
+// Authenticator returns a token authenticator when a token is configured, a basic one otherwise.
+func (c *EnvironmentConfig) Authenticator() Authenticator {
+	if c.Token != "" {
+		return &TokenAuth{Token: c.Token}
+	}
+	return &BasicAuth{Username: c.Username, Password: c.Password}
+}
+
+// NewAWXFromEnvironment creates an AWX handler from the awxkit and tower-cli
+// settings, see `LoadEnvironmentConfig` for the precedence rules.
+func NewAWXFromEnvironment() (*AWX, error) {
+	config, err := LoadEnvironmentConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := config.HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return newAWXWithAuthenticator(config.Host, config.Authenticator(), client)
+}
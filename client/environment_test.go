@@ -0,0 +1,127 @@
+package awx
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadTowerCLIConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tower_cli.cfg")
+	content := `# tower-cli settings
+host = awx.example.com
+username: "admin"
+; a comment
+password = 'secret'
+Verify_SSL = false
+
+[other]
+oauth_token = ignored
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	settings := map[string]string{}
+	if err := readTowerCLIConfig(path, settings); err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	want := map[string]string{"host": "awx.example.com", "username": "admin", "password": "secret", "verify_ssl": "false"}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("settings: %v, want %v", settings, want)
+	}
+
+	if err := readTowerCLIConfig(filepath.Join(t.TempDir(), "missing.cfg"), settings); err != nil {
+		t.Errorf("missing file: %s", err)
+	}
+}
+
+func TestLoadEnvironmentConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string
+		env   map[string]string
+		want  EnvironmentConfig
+		token bool
+	}{{
+		name:  "file",
+		files: []string{"host = awx.example.com/\nusername = admin\npassword = secret\n"},
+		want:  EnvironmentConfig{Host: "https://awx.example.com", Username: "admin", Password: "secret", VerifySSL: true},
+	}, {
+		name:  "higher file overrides",
+		files: []string{"host = http://old\nusername = admin\n", "host = http://new\npassword = secret\nverify_ssl = no\n"},
+		want:  EnvironmentConfig{Host: "http://new", Username: "admin", Password: "secret"},
+	}, {
+		name: "controller over tower",
+		env:  map[string]string{"TOWER_HOST": "tower", "CONTROLLER_HOST": "controller", "TOWER_USERNAME": "admin"},
+		want: EnvironmentConfig{Host: "https://controller", Username: "admin", VerifySSL: true},
+	}, {
+		name:  "environment username discards file token",
+		files: []string{"host = awx\noauth_token = stale\n"},
+		env:   map[string]string{"CONTROLLER_USERNAME": "admin", "CONTROLLER_PASSWORD": "secret"},
+		want:  EnvironmentConfig{Host: "https://awx", Username: "admin", Password: "secret", VerifySSL: true},
+	}, {
+		name:  "environment token discards file credentials",
+		files: []string{"host = awx\nusername = admin\npassword = secret\n"},
+		env:   map[string]string{"TOWER_OAUTH_TOKEN": "token"},
+		want:  EnvironmentConfig{Host: "https://awx", Token: "token", VerifySSL: true},
+		token: true,
+	}, {
+		name:  "token and password of the same source",
+		env:   map[string]string{"CONTROLLER_HOST": "awx", "CONTROLLER_USERNAME": "admin", "CONTROLLER_OAUTH_TOKEN": "token"},
+		want:  EnvironmentConfig{Host: "https://awx", Username: "admin", Token: "token", VerifySSL: true},
+		token: true,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, prefix := range []string{"TOWER_", "CONTROLLER_"} {
+				for _, key := range []string{"HOST", "USERNAME", "PASSWORD", "OAUTH_TOKEN", "VERIFY_SSL"} {
+					t.Setenv(prefix+key, "")
+					os.Unsetenv(prefix + key)
+				}
+			}
+			t.Setenv("REQUESTS_CA_BUNDLE", "")
+			t.Setenv("CURL_CA_BUNDLE", "")
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			dir := t.TempDir()
+			files := make([]string, 0, len(tt.files))
+			for i, content := range tt.files {
+				path := filepath.Join(dir, string(rune('a'+i))+".cfg")
+				if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+				files = append(files, path)
+			}
+			defer func(previous []string) { towerCLIConfigFiles = previous }(towerCLIConfigFiles)
+			towerCLIConfigFiles = files
+
+			config, err := LoadEnvironmentConfig()
+			if err != nil {
+				t.Fatalf("load: %s", err)
+			}
+			if *config != tt.want {
+				t.Errorf("config: %+v, want %+v", *config, tt.want)
+			}
+			if _, ok := config.Authenticator().(*TokenAuth); ok != tt.token {
+				t.Errorf("authenticator: %T", config.Authenticator())
+			}
+		})
+	}
+
+	t.Run("no host", func(t *testing.T) {
+		for _, name := range []string{"TOWER_HOST", "CONTROLLER_HOST"} {
+			t.Setenv(name, "")
+			os.Unsetenv(name)
+		}
+		defer func(previous []string) { towerCLIConfigFiles = previous }(towerCLIConfigFiles)
+		towerCLIConfigFiles = nil
+		if _, err := LoadEnvironmentConfig(); err == nil {
+			t.Errorf("expected an error without host")
+		}
+	})
+}
@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // ExecutionEnvironmentsService implements awx execution environments apis.
@@ -20,7 +22,7 @@ type ListExecutionEnvironmentsResponse struct {
 const executionEnvironmentsAPIEndpoint = "/api/v2/execution_environments/"
 
 // ListExecutionEnvironments shows list of awx execution environments.
-func (p *ExecutionEnvironmentsService) ListExecutionEnvironments(params map[string]string) ([]*ExecutionEnvironment, *ListExecutionEnvironmentsResponse, error) {
+func (p *ExecutionEnvironmentsService) ListExecutionEnvironments(params url.Values) ([]*ExecutionEnvironment, *ListExecutionEnvironmentsResponse, error) {
 	result := new(ListExecutionEnvironmentsResponse)
 	resp, err := p.client.Requester.GetJSON(executionEnvironmentsAPIEndpoint, result, params)
 	if err != nil {
@@ -35,7 +37,7 @@ func (p *ExecutionEnvironmentsService) ListExecutionEnvironments(params map[stri
 }
 
 // GetExecutionEnvironmentByID shows the details of a ExecutionEnvironment.
-func (p *ExecutionEnvironmentsService) GetExecutionEnvironmentByID(id int, params map[string]string) (*ExecutionEnvironment, error) {
+func (p *ExecutionEnvironmentsService) GetExecutionEnvironmentByID(id int, params url.Values) (*ExecutionEnvironment, error) {
 	result := new(ExecutionEnvironment)
 	endpoint := fmt.Sprintf("%s%d/", executionEnvironmentsAPIEndpoint, id)
 	resp, err := p.client.Requester.GetJSON(endpoint, result, params)
@@ -50,8 +52,19 @@ func (p *ExecutionEnvironmentsService) GetExecutionEnvironmentByID(id int, param
 	return result, nil
 }
 
+// GetByNamedURL shows the details of an execution environment by its named url identifier, see `NamedURLExecutionEnvironment`.
+func (p *ExecutionEnvironmentsService) GetByNamedURL(identifier string, params url.Values) (*ExecutionEnvironment, error) {
+	return getByNamedURL[ExecutionEnvironment](p.client, executionEnvironmentsAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only execution environment named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (p *ExecutionEnvironmentsService) FindByName(ctx context.Context, name string, scope Scope) (*ExecutionEnvironment, error) {
+	return findByName[ExecutionEnvironment](ctx, p.client, "execution environment", executionEnvironmentsAPIEndpoint, "name", name, scope, organizationScope)
+}
+
 // CreateExecutionEnvironment creates an awx ExecutionEnvironment.
-func (p *ExecutionEnvironmentsService) CreateExecutionEnvironment(data map[string]interface{}, params map[string]string) (*ExecutionEnvironment, error) {
+func (p *ExecutionEnvironmentsService) CreateExecutionEnvironment(data map[string]interface{}, params url.Values) (*ExecutionEnvironment, error) {
 	mandatoryFields = []string{"name", "image"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -78,7 +91,7 @@ func (p *ExecutionEnvironmentsService) CreateExecutionEnvironment(data map[strin
 }
 
 // UpdateExecutionEnvironment update an awx ExecutionEnvironment.
-func (p *ExecutionEnvironmentsService) UpdateExecutionEnvironment(id int, data map[string]interface{}, params map[string]string) (*ExecutionEnvironment, error) {
+func (p *ExecutionEnvironmentsService) UpdateExecutionEnvironment(id int, data map[string]interface{}, params url.Values) (*ExecutionEnvironment, error) {
 	result := new(ExecutionEnvironment)
 	endpoint := fmt.Sprintf("%s%d", executionEnvironmentsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
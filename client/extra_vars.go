@@ -0,0 +1,344 @@
+package awx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ExtraVars holds the extra variables of a template, a job, a workflow node
+// or a launch, AWX exchanges them as YAML or JSON strings.
+//
+// Variables parsed from YAML keep their comments and order when encoded back,
+// variables parsed from JSON are encoded back as JSON. ExtraVars marshals to
+// the string AWX expects, put it as is in a data map:
+//
+//	data["extra_vars"] = vars
+type ExtraVars struct {
+	// doc is the YAML document node, its content is a mapping node.
+	doc    *yaml.Node
+	isJSON bool
+}
+
+// NewExtraVars returns the variables of a map, encoded as YAML.
+func NewExtraVars(vars map[string]interface{}) (*ExtraVars, error) {
+	v := &ExtraVars{doc: newVarsDocument()}
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := v.Set(key, vars[key]); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// ParseExtraVars parses YAML or JSON variables, an empty string or an empty
+// YAML document gives no variables.
+func ParseExtraVars(text string) (*ExtraVars, error) {
+	trimmed := strings.TrimSpace(text)
+	isJSON := strings.HasPrefix(trimmed, "{")
+	if trimmed == "" || trimmed == "---" {
+		return &ExtraVars{doc: newVarsDocument()}, nil
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(text), &doc); err != nil {
+		if !isJSON {
+			return nil, fmt.Errorf("parse extra vars: %w", err)
+		}
+		// JSON indented with tabs is not valid YAML
+		var vars map[string]interface{}
+		if jsonErr := json.Unmarshal([]byte(text), &vars); jsonErr != nil {
+			return nil, fmt.Errorf("parse extra vars: %w", jsonErr)
+		}
+		v, err := NewExtraVars(vars)
+		if err != nil {
+			return nil, err
+		}
+		v.isJSON = true
+		return v, nil
+	}
+
+	if len(doc.Content) == 0 {
+		return &ExtraVars{doc: &doc}, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("parse extra vars: expecting a mapping but got %s", root.Tag)
+	}
+	return &ExtraVars{doc: &doc, isJSON: isJSON}, nil
+}
+
+// MustParseExtraVars is like ParseExtraVars but panics on invalid variables.
+func MustParseExtraVars(text string) *ExtraVars {
+	v, err := ParseExtraVars(text)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func newVarsDocument() *yaml.Node {
+	return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+}
+
+// mapping returns the mapping node of the variables, created on first use.
+func (v *ExtraVars) mapping() *yaml.Node {
+	if v.doc == nil {
+		v.doc = newVarsDocument()
+	}
+	if len(v.doc.Content) == 0 {
+		v.doc.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
+	}
+	return v.doc.Content[0]
+}
+
+// Keys returns the variable names in document order.
+func (v *ExtraVars) Keys() []string {
+	if v == nil {
+		return nil
+	}
+	content := v.mapping().Content
+	keys := make([]string, 0, len(content)/2)
+	for i := 0; i+1 < len(content); i += 2 {
+		keys = append(keys, content[i].Value)
+	}
+	return keys
+}
+
+// Len returns the number of variables.
+func (v *ExtraVars) Len() int {
+	if v == nil {
+		return 0
+	}
+	return len(v.mapping().Content) / 2
+}
+
+// Get returns the value of a variable and whether it is defined.
+func (v *ExtraVars) Get(key string) (interface{}, bool) {
+	if v == nil {
+		return nil, false
+	}
+	content := v.mapping().Content
+	for i := 0; i+1 < len(content); i += 2 {
+		if content[i].Value == key {
+			var value interface{}
+			if err := content[i+1].Decode(&value); err != nil {
+				return nil, false
+			}
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// Set defines a variable, an existing variable keeps its position and comments.
+func (v *ExtraVars) Set(key string, value interface{}) error {
+	var node yaml.Node
+	if err := node.Encode(value); err != nil {
+		return fmt.Errorf("encode extra var %s: %w", key, err)
+	}
+
+	mapping := v.mapping()
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			previous := mapping.Content[i+1]
+			node.HeadComment, node.LineComment, node.FootComment = previous.HeadComment, previous.LineComment, previous.FootComment
+			mapping.Content[i+1] = &node
+			return nil
+		}
+	}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, &node)
+	return nil
+}
+
+// Delete removes a variable.
+func (v *ExtraVars) Delete(key string) {
+	if v == nil {
+		return
+	}
+	mapping := v.mapping()
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+// Map returns the variables as a map.
+func (v *ExtraVars) Map() map[string]interface{} {
+	vars := map[string]interface{}{}
+	if v != nil {
+		_ = v.mapping().Decode(&vars)
+	}
+	return vars
+}
+
+// YAML encodes the variables as YAML, keeping the comments.
+func (v *ExtraVars) YAML() (string, error) {
+	if v.Len() == 0 {
+		return "", nil
+	}
+	var b bytes.Buffer
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v.doc); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return "---\n" + b.String(), nil
+}
+
+// JSON encodes the variables as JSON.
+func (v *ExtraVars) JSON() (string, error) {
+	if v.Len() == 0 {
+		return "", nil
+	}
+	content, err := json.Marshal(v.Map())
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// encode encodes the variables in the format they were parsed from, YAML by default.
+func (v *ExtraVars) encode() (string, error) {
+	if v != nil && v.isJSON {
+		return v.JSON()
+	}
+	return v.YAML()
+}
+
+func (v *ExtraVars) String() string {
+	text, err := v.encode()
+	if err != nil {
+		return ""
+	}
+	return text
+}
+
+// MarshalJSON implements json.Marshaler, the variables are encoded as a string.
+// It has a value receiver so an ExtraVars value, not only a pointer, marshals
+// as variables in a data map.
+func (v ExtraVars) MarshalJSON() ([]byte, error) {
+	text, err := v.encode()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(text)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it accepts a YAML or JSON string and an object.
+func (v *ExtraVars) UnmarshalJSON(data []byte) error {
+	var parsed *ExtraVars
+	var err error
+	switch trimmed := bytes.TrimSpace(data); {
+	case bytes.Equal(trimmed, []byte("null")):
+		parsed = &ExtraVars{doc: newVarsDocument()}
+	case len(trimmed) > 0 && trimmed[0] == '"':
+		var text string
+		if err := json.Unmarshal(trimmed, &text); err != nil {
+			return err
+		}
+		parsed, err = ParseExtraVars(text)
+	default:
+		var vars map[string]interface{}
+		if err := json.Unmarshal(trimmed, &vars); err != nil {
+			return err
+		}
+		parsed, err = NewExtraVars(vars)
+		if parsed != nil {
+			parsed.isJSON = true
+		}
+	}
+	if err != nil {
+		return err
+	}
+	*v = *parsed
+	return nil
+}
+
+// Clone returns a deep copy of the variables.
+func (v *ExtraVars) Clone() *ExtraVars {
+	if v == nil {
+		return &ExtraVars{doc: newVarsDocument()}
+	}
+	v.mapping()
+	return &ExtraVars{doc: cloneNode(v.doc), isJSON: v.isJSON}
+}
+
+func cloneNode(node *yaml.Node) *yaml.Node {
+	clone := *node
+	clone.Content = make([]*yaml.Node, len(node.Content))
+	for i, child := range node.Content {
+		clone.Content[i] = cloneNode(child)
+	}
+	return &clone
+}
+
+// MergeExtraVars merges layers of variables, from the lowest precedence to the
+// highest, as AWX does: a variable of a layer replaces the whole variable of
+// the lower layers, nested mappings are not merged. The AWX order is the job
+// template variables, the workflow node extra data, the workflow job template
+// variables, then the launch variables. The result keeps the comments and
+// the format of the first layer.
+func MergeExtraVars(layers ...*ExtraVars) (*ExtraVars, error) {
+	var merged *ExtraVars
+	for _, layer := range layers {
+		if layer == nil {
+			continue
+		}
+		if merged == nil {
+			merged = layer.Clone()
+			continue
+		}
+		content := layer.mapping().Content
+		for i := 0; i+1 < len(content); i += 2 {
+			var value interface{}
+			if err := content[i+1].Decode(&value); err != nil {
+				return nil, fmt.Errorf("decode extra var %s: %w", content[i].Value, err)
+			}
+			if err := merged.Set(content[i].Value, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if merged == nil {
+		merged = &ExtraVars{doc: newVarsDocument()}
+	}
+	return merged, nil
+}
+
+// PromptOnly returns, sorted, the variables the questions of the template
+// survey don't define. AWX only accepts them on launch when the template
+// prompts for variables, and drops them otherwise into the `ignored_fields`
+// of the launch response, the variables the template itself defines included.
+// Pass a nil survey when the template survey is disabled.
+func (v *ExtraVars) PromptOnly(survey *SurveySpec) []string {
+	answers := map[string]bool{}
+	if survey != nil {
+		for _, question := range survey.Spec {
+			answers[question.Variable] = true
+		}
+	}
+
+	var promptOnly []string
+	for _, key := range v.Keys() {
+		if !answers[key] {
+			promptOnly = append(promptOnly, key)
+		}
+	}
+	sort.Strings(promptOnly)
+	return promptOnly
+}
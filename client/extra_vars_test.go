@@ -0,0 +1,180 @@
+package awx
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestExtraVars(t *testing.T) {
+	template, err := ParseExtraVars("---\n# the target release\nversion: 1.2 # pinned\nregion: eu\n")
+	if err != nil {
+		t.Fatalf("parse yaml: %s", err)
+	}
+	node, err := ParseExtraVars(`{"region": "us", "replicas": 2}`)
+	if err != nil {
+		t.Fatalf("parse json: %s", err)
+	}
+	launch, err := NewExtraVars(map[string]interface{}{"version": "1.3", "debug": true})
+	if err != nil {
+		t.Fatalf("new: %s", err)
+	}
+
+	merged, err := MergeExtraVars(template, node, nil, launch)
+	if err != nil {
+		t.Fatalf("merge: %s", err)
+	}
+	want := "---\n# the target release\nversion: \"1.3\" # pinned\nregion: us\nreplicas: 2\ndebug: true\n"
+	if got := merged.String(); got != want {
+		t.Errorf("merged yaml:\n%s\nwant:\n%s", got, want)
+	}
+	if got, _ := template.Get("version"); got != 1.2 {
+		t.Errorf("the merge changed a layer: version %v", got)
+	}
+
+	if got := node.String(); got != `{"region":"us","replicas":2}` {
+		t.Errorf("json round trip: %s", got)
+	}
+	if got, want := launch.PromptOnly(nil), []string{"debug", "version"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("prompt only: %v, want %v", got, want)
+	}
+
+	var decoded struct {
+		ExtraVars *ExtraVars `json:"extra_vars"`
+	}
+	if err := json.Unmarshal([]byte(`{"extra_vars": {"nested": {"a": [1, 2]}}}`), &decoded); err != nil {
+		t.Fatalf("unmarshal object: %s", err)
+	}
+	content, err := json.Marshal(map[string]interface{}{"extra_vars": decoded.ExtraVars})
+	if err != nil {
+		t.Fatalf("marshal: %s", err)
+	}
+	if got := string(content); got != `{"extra_vars":"{\"nested\":{\"a\":[1,2]}}"}` {
+		t.Errorf("marshal: %s", got)
+	}
+}
+
+func TestExtraVarsPromptOnly(t *testing.T) {
+	launch := MustParseExtraVars(`{"version": "1.3", "debug": true, "replicas": 3, "zone": "a"}`)
+	survey := &SurveySpec{Spec: []*SurveyQuestion{{Variable: "replicas"}, {Variable: "region"}}}
+
+	tests := []struct {
+		name   string
+		survey *SurveySpec
+		want   []string
+	}{
+		{"no survey", nil, []string{"debug", "replicas", "version", "zone"}},
+		{"survey answers", survey, []string{"debug", "version", "zone"}},
+		{"empty survey", &SurveySpec{}, []string{"debug", "replicas", "version", "zone"}},
+	}
+	for _, tt := range tests {
+		if got := launch.PromptOnly(tt.survey); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestExtraVarsPromptOnlyIgnored launches a template which doesn't prompt for
+// variables, AWX ignores every variable but the survey answers, the ones the
+// template defines included.
+func TestExtraVarsPromptOnlyIgnored(t *testing.T) {
+	template := MustParseExtraVars("version: 1.2\n")
+	survey := &SurveySpec{Spec: []*SurveyQuestion{{Variable: "replicas"}}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			ExtraVars *ExtraVars `json:"extra_vars"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		ignored := map[string]interface{}{}
+		for _, key := range payload.ExtraVars.Keys() {
+			if key != "replicas" {
+				ignored[key], _ = payload.ExtraVars.Get(key)
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"job": 12, "id": 12, "ignored_fields": map[string]interface{}{"extra_vars": ignored}})
+	}))
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+
+	launch := MustParseExtraVars(`{"version": "1.3", "replicas": 3}`)
+	if _, ok := template.Get("version"); !ok {
+		t.Fatalf("the template doesn't define version")
+	}
+	result, err := a.JobTemplateService.Launch(5, map[string]interface{}{"extra_vars": launch}, nil)
+	if err != nil {
+		t.Fatalf("launch: %s", err)
+	}
+	ignored, _ := result.IgnoredFields["extra_vars"].(map[string]interface{})
+	var keys []string
+	for key := range ignored {
+		keys = append(keys, key)
+	}
+	if got := launch.PromptOnly(survey); !reflect.DeepEqual(got, []string{"version"}) || !reflect.DeepEqual(keys, got) {
+		t.Errorf("prompt only %v, ignored %v", got, keys)
+	}
+}
+
+func TestExtraVarsFields(t *testing.T) {
+	var template JobTemplate
+	if err := json.Unmarshal([]byte(`{"id": 5, "extra_vars": "---\n# pinned\nversion: 1.2\n"}`), &template); err != nil {
+		t.Fatalf("unmarshal template: %s", err)
+	}
+	if got, _ := template.ExtraVars.Get("version"); got != 1.2 {
+		t.Errorf("template version: %v", got)
+	}
+
+	var node WorkflowJobTemplateNode
+	if err := json.Unmarshal([]byte(`{"id": 3, "extra_data": {"region": "eu"}}`), &node); err != nil {
+		t.Fatalf("unmarshal node: %s", err)
+	}
+	if got, _ := node.ExtraData.Get("region"); got != "eu" {
+		t.Errorf("node region: %v", got)
+	}
+
+	// a value marshals as variables, not as a struct
+	content, err := json.Marshal(map[string]interface{}{"extra_vars": template.ExtraVars, "extra_data": node.ExtraData})
+	if err != nil {
+		t.Fatalf("marshal: %s", err)
+	}
+	if want := `{"extra_data":"{\"region\":\"eu\"}","extra_vars":"---\n# pinned\nversion: 1.2\n"}`; string(content) != want {
+		t.Errorf("marshal: %s, want %s", content, want)
+	}
+
+	var job Job
+	if err := json.Unmarshal([]byte(`{"id": 9, "extra_vars": ""}`), &job); err != nil {
+		t.Fatalf("unmarshal job: %s", err)
+	}
+	if content, err := json.Marshal(job.ExtraVars); err != nil || string(content) != `""` {
+		t.Errorf("empty variables: %s, %v", content, err)
+	}
+}
+
+func TestGetSurveySpec(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		io.WriteString(w, `{"name": "", "description": "", "spec": [{"question_name": "Replicas", "variable": "replicas", "type": "integer", "required": true, "default": 2, "min": 1, "max": null}]}`)
+	}))
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+
+	survey, err := a.JobTemplateService.GetSurveySpec(5)
+	if err != nil {
+		t.Fatalf("job template survey: %s", err)
+	}
+	if len(survey.Spec) != 1 || survey.Spec[0].Variable != "replicas" || *survey.Spec[0].Min != 1 || survey.Spec[0].Max != nil {
+		t.Errorf("survey: %+v", survey.Spec)
+	}
+	if _, err := a.WorkflowJobTemplateService.GetSurveySpec(7); err != nil {
+		t.Fatalf("workflow job template survey: %s", err)
+	}
+	if want := []string{"/api/v2/job_templates/5/survey_spec/", "/api/v2/workflow_job_templates/7/survey_spec/"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("paths: %v, want %v", paths, want)
+	}
+}
@@ -0,0 +1,207 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Lookup errors, use `errors.Is` to test them and `errors.As` with `*LookupError` for details.
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrAmbiguous        = errors.New("ambiguous name")
+	ErrUnsupportedScope = errors.New("unsupported scope")
+)
+
+// Scope narrows a lookup by name to an organization or an inventory, zero
+// values are ignored. A lookup of a resource which cannot be narrowed this
+// way fails with `ErrUnsupportedScope`.
+type Scope struct {
+	Organization     int
+	OrganizationName string
+	Inventory        int
+	InventoryName    string
+}
+
+// scopeFields are the filter fields a Scope applies to for a resource, empty when unsupported.
+type scopeFields struct {
+	organization string
+	inventory    string
+}
+
+var (
+	noScope           = scopeFields{}
+	organizationScope = scopeFields{organization: "organization"}
+	inventoryScope    = scopeFields{organization: "inventory__organization", inventory: "inventory"}
+	templateScope     = scopeFields{organization: "organization", inventory: "inventory"}
+	scheduleScope     = scopeFields{organization: "unified_job_template__organization", inventory: "inventory"}
+	workflowNodeScope = scopeFields{organization: "workflow_job_template__organization", inventory: "inventory"}
+)
+
+func (s Scope) params(fields scopeFields) (url.Values, bool) {
+	params := url.Values{}
+	if s.Organization != 0 || s.OrganizationName != "" {
+		if fields.organization == "" {
+			return nil, false
+		}
+		if s.Organization != 0 {
+			params.Set(fields.organization, strconv.Itoa(s.Organization))
+		}
+		if s.OrganizationName != "" {
+			params.Set(fields.organization+"__name", s.OrganizationName)
+		}
+	}
+	if s.Inventory != 0 || s.InventoryName != "" {
+		if fields.inventory == "" {
+			return nil, false
+		}
+		if s.Inventory != 0 {
+			params.Set(fields.inventory, strconv.Itoa(s.Inventory))
+		}
+		if s.InventoryName != "" {
+			params.Set(fields.inventory+"__name", s.InventoryName)
+		}
+	}
+	return params, true
+}
+
+// LookupError is returned when a lookup by name does not match exactly one resource.
+type LookupError struct {
+	Resource string
+	Name     string
+	Scope    Scope
+	// IDs holds the candidates IDs of an ambiguous lookup.
+	IDs []int
+	Err error
+}
+
+func (e *LookupError) Error() string {
+	if errors.Is(e.Err, ErrAmbiguous) {
+		return fmt.Sprintf("%s %q: %s, candidates %v", e.Resource, e.Name, e.Err, e.IDs)
+	}
+	return fmt.Sprintf("%s %q: %s", e.Resource, e.Name, e.Err)
+}
+
+func (e *LookupError) Unwrap() error {
+	return e.Err
+}
+
+// SetNameCache enables or disables the cache of name to ID resolutions of
+// the `FindByName` methods, kept for the life of the client.
+func (a *AWX) SetNameCache(enabled bool) {
+	if a.client == nil {
+		return
+	}
+	if enabled {
+		a.client.names = &nameCache{ids: map[string]int{}}
+	} else {
+		a.client.names = nil
+	}
+}
+
+// nameCache caches name to ID resolutions.
+type nameCache struct {
+	mu  sync.RWMutex
+	ids map[string]int
+}
+
+func (c *nameCache) get(key string) (int, bool) {
+	if c == nil {
+		return 0, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	id, ok := c.ids[key]
+	return id, ok
+}
+
+func (c *nameCache) set(key string, id int) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ids[key] = id
+}
+
+func (c *nameCache) delete(key string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.ids, key)
+}
+
+// findByName returns the only resource of the endpoint with the given name in the scope.
+func findByName[T any](ctx context.Context, client *Client, resource, apiEndpoint, nameField, name string, scope Scope, fields scopeFields) (*T, error) {
+	params, ok := scope.params(fields)
+	if !ok {
+		return nil, &LookupError{Resource: resource, Name: name, Scope: scope, Err: ErrUnsupportedScope}
+	}
+	params.Set(nameField, name)
+
+	names := client.names
+	key := fmt.Sprintf("%s|%s|%+v", apiEndpoint, name, scope)
+	if id, ok := names.get(key); ok {
+		result := new(T)
+		err := rawDo(ctx, client, "GET", fmt.Sprintf("%s%d/", apiEndpoint, id), nil, result, nil)
+		if err == nil && resourceField(result, nameField) == name {
+			return result, nil
+		}
+		// the resource may have been deleted or renamed, resolve it again
+		names.delete(key)
+	}
+
+	results, err := listAll[T](ctx, client, apiEndpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(results) {
+	case 0:
+		return nil, &LookupError{Resource: resource, Name: name, Scope: scope, Err: ErrNotFound}
+	case 1:
+		names.set(key, resourceID(results[0]))
+		return results[0], nil
+	default:
+		ids := make([]int, 0, len(results))
+		for _, result := range results {
+			ids = append(ids, resourceID(result))
+		}
+		return nil, &LookupError{Resource: resource, Name: name, Scope: scope, IDs: ids, Err: ErrAmbiguous}
+	}
+}
+
+// resourceField returns the string field of a resource with the given json name.
+func resourceField(v interface{}, jsonName string) string {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return ""
+	}
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		if strings.Split(field.Tag.Get("json"), ",")[0] == jsonName && field.Type.Kind() == reflect.String {
+			return rv.Field(i).String()
+		}
+	}
+	return ""
+}
+
+// resourceID returns the `ID` field of a resource.
+func resourceID(v interface{}) int {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return 0
+	}
+	field := rv.FieldByName("ID")
+	if !field.IsValid() || field.Kind() != reflect.Int {
+		return 0
+	}
+	return int(field.Int())
+}
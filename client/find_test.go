@@ -0,0 +1,191 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// hostsServer serves the hosts list, filtered by name, and the hosts by ID.
+type hostsServer struct {
+	hosts    map[int]string
+	requests []string
+	queries  []url.Values
+}
+
+func (s *hostsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests = append(s.requests, r.URL.Path)
+	if r.URL.Path == hostsAPIEndpoint {
+		s.queries = append(s.queries, r.URL.Query())
+		results := []map[string]interface{}{}
+		for id := 1; id <= len(s.hosts)+10; id++ {
+			if name, ok := s.hosts[id]; ok && name == r.URL.Query().Get("name") {
+				results = append(results, map[string]interface{}{"id": id, "name": name})
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"count": len(results), "results": results})
+		return
+	}
+
+	id, _ := strconv.Atoi(strings.Trim(strings.TrimPrefix(r.URL.Path, hostsAPIEndpoint), "/"))
+	name, ok := s.hosts[id]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"id": id, "name": name})
+}
+
+func TestFindByName(t *testing.T) {
+	handler := &hostsServer{hosts: map[int]string{1: "web01", 2: "db01", 3: "db01"}}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+	ctx := context.Background()
+
+	host, err := a.HostService.FindByName(ctx, "web01", Scope{})
+	if err != nil || host.ID != 1 {
+		t.Fatalf("find: %+v, %v", host, err)
+	}
+
+	_, err = a.HostService.FindByName(ctx, "app01", Scope{})
+	var lookupErr *LookupError
+	if !errors.Is(err, ErrNotFound) || !errors.As(err, &lookupErr) || lookupErr.Resource != "host" || lookupErr.Name != "app01" {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	_, err = a.HostService.FindByName(ctx, "db01", Scope{})
+	if !errors.Is(err, ErrAmbiguous) || !errors.As(err, &lookupErr) || !reflect.DeepEqual(lookupErr.IDs, []int{2, 3}) {
+		t.Errorf("expected an ambiguous error with the candidates, got %v", err)
+	}
+	if want := `host "db01": ambiguous name, candidates [2 3]`; err == nil || err.Error() != want {
+		t.Errorf("error: %v, want %s", err, want)
+	}
+}
+
+func TestFindByNameScope(t *testing.T) {
+	handler := &hostsServer{hosts: map[int]string{1: "web01", 4: "admin"}}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+	ctx := context.Background()
+
+	if _, err := a.HostService.FindByName(ctx, "web01", Scope{OrganizationName: "Engineering", Inventory: 3}); err != nil {
+		t.Fatalf("find: %s", err)
+	}
+	want := url.Values{"name": {"web01"}, "inventory": {"3"}, "inventory__organization__name": {"Engineering"}}
+	if len(handler.queries) != 1 || !reflect.DeepEqual(handler.queries[0], want) {
+		t.Errorf("host query: %v, want %v", handler.queries, want)
+	}
+
+	lookups := []func() error{
+		func() error { _, err := a.UserService.FindByName(ctx, "admin", Scope{Organization: 1}); return err },
+		func() error {
+			_, err := a.InventoriesService.FindByName(ctx, "prod", Scope{InventoryName: "prod"})
+			return err
+		},
+		func() error {
+			_, err := a.InstanceGroupsService.FindByName(ctx, "default", Scope{OrganizationName: "Default"})
+			return err
+		},
+	}
+	requests := len(handler.requests)
+	for i, lookup := range lookups {
+		if err := lookup(); !errors.Is(err, ErrUnsupportedScope) {
+			t.Errorf("lookup %d: expected an unsupported scope error, got %v", i, err)
+		}
+	}
+	if len(handler.requests) != requests {
+		t.Errorf("unsupported scopes were sent: %v", handler.requests[requests:])
+	}
+}
+
+func TestFindWorkflowJobTemplateNode(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		if r.URL.Query().Get("identifier") == "approve" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"count": 1, "results": []map[string]interface{}{{"id": 9, "identifier": "approve"}}})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"count": 0, "results": []interface{}{}})
+	}))
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+	ctx := context.Background()
+
+	node, err := a.WorkflowJobTemplateNodeService.FindByName(ctx, "approve", Scope{OrganizationName: "Default"})
+	if err != nil || node.ID != 9 {
+		t.Fatalf("find: %+v, %v", node, err)
+	}
+	want := url.Values{"identifier": {"approve"}, "workflow_job_template__organization__name": {"Default"}}
+	if len(queries) != 1 || !reflect.DeepEqual(queries[0], want) {
+		t.Errorf("node query: %v, want %v", queries, want)
+	}
+
+	_, err = a.WorkflowJobTemplateNodeService.FindByName(ctx, "deploy", Scope{})
+	var lookupErr *LookupError
+	if !errors.Is(err, ErrNotFound) || !errors.As(err, &lookupErr) || lookupErr.Resource != "workflow job template node" {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestFindByNameCache(t *testing.T) {
+	handler := &hostsServer{hosts: map[int]string{1: "web01"}}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+	a.SetNameCache(true)
+	ctx := context.Background()
+
+	find := func(want int) {
+		t.Helper()
+		handler.requests = nil
+		host, err := a.HostService.FindByName(ctx, "web01", Scope{})
+		if err != nil || host.ID != want || host.Name != "web01" {
+			t.Fatalf("find: %+v, %v", host, err)
+		}
+	}
+
+	find(1)
+	find(1)
+	if !reflect.DeepEqual(handler.requests, []string{"/api/v2/hosts/1/"}) {
+		t.Errorf("cache hit requests: %v", handler.requests)
+	}
+
+	// renamed, the cached ID now has another name
+	handler.hosts = map[int]string{1: "web01-old", 2: "web01"}
+	find(2)
+	if !reflect.DeepEqual(handler.requests, []string{"/api/v2/hosts/1/", hostsAPIEndpoint}) {
+		t.Errorf("renamed requests: %v", handler.requests)
+	}
+
+	// deleted
+	handler.hosts = map[int]string{3: "web01"}
+	find(3)
+	if !reflect.DeepEqual(handler.requests, []string{"/api/v2/hosts/2/", hostsAPIEndpoint}) {
+		t.Errorf("deleted requests: %v", handler.requests)
+	}
+
+	// the scope is part of the key
+	handler.requests = nil
+	if _, err := a.HostService.FindByName(ctx, "web01", Scope{Inventory: 1}); err != nil {
+		t.Fatalf("find: %s", err)
+	}
+	if !reflect.DeepEqual(handler.requests, []string{hostsAPIEndpoint}) {
+		t.Errorf("scoped requests: %v", handler.requests)
+	}
+
+	a.SetNameCache(false)
+	find(3)
+	if !reflect.DeepEqual(handler.requests, []string{hostsAPIEndpoint}) {
+		t.Errorf("disabled cache requests: %v", handler.requests)
+	}
+}
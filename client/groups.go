@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // GroupService implements awx Groups apis.
@@ -20,7 +22,7 @@ type ListGroupsResponse struct {
 const groupsAPIEndpoint = "/api/v2/groups/"
 
 // GetGroupByID shows the details of a awx group.
-func (g *GroupService) GetGroupByID(id int, params map[string]string) (*Group, error) {
+func (g *GroupService) GetGroupByID(id int, params url.Values) (*Group, error) {
 	result := new(Group)
 	endpoint := fmt.Sprintf("%s%d/", groupsAPIEndpoint, id)
 	resp, err := g.client.Requester.GetJSON(endpoint, result, params)
@@ -35,8 +37,19 @@ func (g *GroupService) GetGroupByID(id int, params map[string]string) (*Group, e
 	return result, nil
 }
 
+// GetByNamedURL shows the details of a group by its named url identifier, see `NamedURLGroup`.
+func (g *GroupService) GetByNamedURL(identifier string, params url.Values) (*Group, error) {
+	return getByNamedURL[Group](g.client, groupsAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only group named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (g *GroupService) FindByName(ctx context.Context, name string, scope Scope) (*Group, error) {
+	return findByName[Group](ctx, g.client, "group", groupsAPIEndpoint, "name", name, scope, inventoryScope)
+}
+
 // ListGroups shows list of awx Groups.
-func (g *GroupService) ListGroups(params map[string]string) ([]*Group, *ListGroupsResponse, error) {
+func (g *GroupService) ListGroups(params url.Values) ([]*Group, *ListGroupsResponse, error) {
 	result := new(ListGroupsResponse)
 	resp, err := g.client.Requester.GetJSON(groupsAPIEndpoint, result, params)
 	if err != nil {
@@ -51,7 +64,7 @@ func (g *GroupService) ListGroups(params map[string]string) ([]*Group, *ListGrou
 }
 
 // CreateGroup creates an awx Group.
-func (g *GroupService) CreateGroup(data map[string]interface{}, params map[string]string) (*Group, error) {
+func (g *GroupService) CreateGroup(data map[string]interface{}, params url.Values) (*Group, error) {
 	mandatoryFields = []string{"name", "inventory"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -81,7 +94,7 @@ func (g *GroupService) CreateGroup(data map[string]interface{}, params map[strin
 }
 
 // UpdateGroup update an awx group
-func (g *GroupService) UpdateGroup(id int, data map[string]interface{}, params map[string]string) (*Group, error) {
+func (g *GroupService) UpdateGroup(id int, data map[string]interface{}, params url.Values) (*Group, error) {
 	result := new(Group)
 	endpoint := fmt.Sprintf("%s%d", groupsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
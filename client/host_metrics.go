@@ -0,0 +1,91 @@
+package awx
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// HostMetricsService implements awx host metrics apis.
+type HostMetricsService struct {
+	client *Client
+}
+
+// ListHostMetricsResponse represents `ListHostMetrics` endpoint response.
+type ListHostMetricsResponse struct {
+	Pagination
+	Results []*HostMetric `json:"results"`
+}
+
+const hostMetricsAPIEndpoint = "/api/v2/host_metrics/"
+
+// ListHostMetrics shows list of awx host metrics, the soft deleted ones
+// included unless filtered with `deleted=false`.
+func (h *HostMetricsService) ListHostMetrics(params url.Values) ([]*HostMetric, *ListHostMetricsResponse, error) {
+	result := new(ListHostMetricsResponse)
+	resp, err := h.client.Requester.GetJSON(hostMetricsAPIEndpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// GetHostMetricByID shows the details of a host metric.
+func (h *HostMetricsService) GetHostMetricByID(id int, params url.Values) (*HostMetric, error) {
+	result := new(HostMetric)
+	endpoint := fmt.Sprintf("%s%d/", hostMetricsAPIEndpoint, id)
+	resp, err := h.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeleteHostMetric soft deletes a host metric, the host stops counting in the
+// subscription usage until it is automated again.
+func (h *HostMetricsService) DeleteHostMetric(id int) error {
+	endpoint := fmt.Sprintf("%s%d/", hostMetricsAPIEndpoint, id)
+	resp, err := h.client.Requester.Delete(endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return CheckResponse(resp)
+}
+
+// HostMetricSummaryMonthlyService implements awx monthly host metric summary apis.
+type HostMetricSummaryMonthlyService struct {
+	client *Client
+}
+
+// ListHostMetricSummaryMonthlyResponse represents `ListHostMetricSummaryMonthly` endpoint response.
+type ListHostMetricSummaryMonthlyResponse struct {
+	Pagination
+	Results []*HostMetricSummaryMonthly `json:"results"`
+}
+
+const hostMetricSummaryAPIEndpoint = "/api/v2/host_metric_summary_monthly/"
+
+// ListHostMetricSummaryMonthly shows list of the monthly host metric summaries.
+func (h *HostMetricSummaryMonthlyService) ListHostMetricSummaryMonthly(params url.Values) ([]*HostMetricSummaryMonthly, *ListHostMetricSummaryMonthlyResponse, error) {
+	result := new(ListHostMetricSummaryMonthlyResponse)
+	resp, err := h.client.Requester.GetJSON(hostMetricSummaryAPIEndpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
@@ -0,0 +1,112 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestHostMetricsServices(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		switch {
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == hostMetricsAPIEndpoint:
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 3, "hostname": "web1", "deleted": false, "last_deleted": null}]}`)
+		case r.URL.Path == hostMetricSummaryAPIEndpoint:
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 1, "date": "2024-01-01", "license_consumed": 12, "license_capacity": 100}]}`)
+		default:
+			fmt.Fprint(w, `{"id": 3, "hostname": "web1", "deleted": true, "last_deleted": "2024-01-20T12:00:00Z"}`)
+		}
+	}))
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+
+	calls := []struct {
+		name    string
+		call    func() error
+		request string
+	}{{
+		name: "list host metrics",
+		call: func() error {
+			metrics, _, err := a.HostMetricsService.ListHostMetrics(url.Values{"deleted": {"false"}})
+			if err == nil && (len(metrics) != 1 || metrics[0].Hostname != "web1" || !metrics[0].LastDeleted.IsNull()) {
+				return fmt.Errorf("metrics: %+v", metrics)
+			}
+			return err
+		},
+		request: "GET " + hostMetricsAPIEndpoint + "?deleted=false",
+	}, {
+		name: "get host metric",
+		call: func() error {
+			metric, err := a.HostMetricsService.GetHostMetricByID(3, url.Values{})
+			if err == nil && (!metric.Deleted || !metric.LastDeleted.Valid()) {
+				return fmt.Errorf("metric: %+v", metric)
+			}
+			return err
+		},
+		request: "GET " + hostMetricsAPIEndpoint + "3/",
+	}, {
+		name:    "delete host metric",
+		call:    func() error { return a.HostMetricsService.DeleteHostMetric(3) },
+		request: "DELETE " + hostMetricsAPIEndpoint + "3/",
+	}, {
+		name: "list monthly summaries",
+		call: func() error {
+			summaries, _, err := a.HostMetricSummaryMonthlyService.ListHostMetricSummaryMonthly(url.Values{"order_by": {"-date"}})
+			if err == nil && (len(summaries) != 1 || summaries[0].LicenseConsumed != 12) {
+				return fmt.Errorf("summaries: %+v", summaries)
+			}
+			return err
+		},
+		request: "GET " + hostMetricSummaryAPIEndpoint + "?order_by=-date",
+	}, {
+		name: "build usage report",
+		call: func() error {
+			_, err := NewHostUsageReportBuilder(a).Build(context.Background())
+			return err
+		},
+		request: "GET " + hostMetricsAPIEndpoint + "?page_size=200",
+	}}
+
+	versions := []struct {
+		version   Version
+		supported bool
+	}{
+		{version: Version{}, supported: true},
+		{version: Version{Major: 21, Minor: 14}},
+		{version: Version{Major: 4, Minor: 3}},
+		{version: Version{Major: 22}, supported: true},
+		{version: Version{Major: 4, Minor: 4}, supported: true},
+	}
+	for _, v := range versions {
+		a.client.Requester.version.set(v.version)
+		for _, c := range calls {
+			requests = nil
+			err := c.call()
+			if !v.supported {
+				var unsupported *UnsupportedFeatureError
+				if !errors.As(err, &unsupported) || unsupported.Feature != FeatureHostMetrics {
+					t.Errorf("%s, %s: expected an unsupported feature error, got %v", v.version, c.name, err)
+				}
+				if len(requests) != 0 {
+					t.Errorf("%s, %s: requests sent: %v", v.version, c.name, requests)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%s, %s: %s", v.version, c.name, err)
+			}
+			if want := []string{c.request}; !reflect.DeepEqual(requests, want) {
+				t.Errorf("%s, %s: requests %v, want %v", v.version, c.name, requests, want)
+			}
+		}
+	}
+}
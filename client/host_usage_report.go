@@ -0,0 +1,341 @@
+package awx
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HostUsageNoOrganization is the organization of the automated hosts which
+// are no longer in any inventory.
+const HostUsageNoOrganization = "(none)"
+
+const (
+	defaultHostUsageStaleAfterDays = 30
+	hostUsagePageSize              = 200
+	hostUsageMonthLayout           = "2006-01"
+)
+
+// HostUsageReportBuilder builds the managed hosts usage report of a subscription
+// true-up from the host metrics, and the inventories hosts for the organizations.
+//
+// The host metrics only record the first and the last automation of a host, a
+// month counts two numbers of hosts. The managed hosts are the license
+// consumption: a host is managed in every month from its first automation,
+// until the month it was soft deleted in, as the subscription usage counts it.
+// A host automated again after its soft delete is managed in every month from
+// its first automation, the host metrics don't record when it was automated
+// again. The automated hosts are the hosts which first or last automation
+// falls in the month, a host automated between them is not counted in the
+// months between them.
+type HostUsageReportBuilder struct {
+	hosts         HostAPI
+	metrics       HostMetricsAPI
+	organizations OrganizationsAPI
+	// ByOrganization breaks the months down per organization, listing the
+	// inventories hosts and the organizations.
+	ByOrganization bool
+	// StaleAfterDays is the number of days without automation after which a
+	// host is stale, 30 by default.
+	StaleAfterDays int
+	// Since and Until bound the reported months, from the first automation
+	// until now by default. Until is the reference time of the stale hosts.
+	Since time.Time
+	Until time.Time
+}
+
+// NewHostUsageReportBuilder returns a builder of the host usage report of the connected AWX.
+func NewHostUsageReportBuilder(a *AWX) *HostUsageReportBuilder {
+	return &HostUsageReportBuilder{hosts: a.HostService, metrics: a.HostMetricsService, organizations: a.OrganizationsService}
+}
+
+// HostUsageReport represents the managed hosts usage per month and per organization.
+type HostUsageReport struct {
+	Since          time.Time         `json:"since"`
+	Until          time.Time         `json:"until"`
+	StaleAfterDays int               `json:"stale_after_days"`
+	Months         []*HostUsageMonth `json:"months"`
+	Hosts          []*HostUsage      `json:"hosts"`
+}
+
+// HostUsageMonth is the number of unique hosts counted in a month.
+type HostUsageMonth struct {
+	// Month is formatted as `2006-01`.
+	Month string `json:"month"`
+	HostUsageCount
+	// Organizations breaks the month down per organization name, a host of
+	// several organizations counts in each of them.
+	Organizations map[string]*HostUsageCount `json:"organizations,omitempty"`
+}
+
+// HostUsageCount is the number of unique hosts counted in a month, by an organization or all of them.
+type HostUsageCount struct {
+	// ManagedHosts is the license consumption, the hosts automated before the
+	// end of the month and not deleted before it.
+	ManagedHosts int `json:"managed_hosts"`
+	// NewHosts is the managed hosts first automated in the month.
+	NewHosts int `json:"new_hosts"`
+	// AutomatedHosts is the hosts which first or last automation falls in the month.
+	AutomatedHosts int `json:"automated_hosts"`
+}
+
+func (c *HostUsageCount) add(managed, isNew, automated bool) {
+	if managed {
+		c.ManagedHosts++
+		if isNew {
+			c.NewHosts++
+		}
+	}
+	if automated {
+		c.AutomatedHosts++
+	}
+}
+
+// HostUsage is the automation history of a host.
+type HostUsage struct {
+	Hostname         string              `json:"hostname"`
+	Organizations    []string            `json:"organizations"`
+	FirstAutomation  time.Time           `json:"first_automation"`
+	LastAutomation   time.Time           `json:"last_automation"`
+	AutomatedCounter int                 `json:"automated_counter"`
+	Deleted          bool                `json:"deleted"`
+	LastDeleted      Nullable[time.Time] `json:"last_deleted,omitzero"`
+	// Stale reports the hosts, not deleted, which were not automated for StaleAfterDays days.
+	Stale bool `json:"stale"`
+}
+
+// Build lists the host metrics and the hosts, and aggregates them into a report.
+func (b *HostUsageReportBuilder) Build(ctx context.Context) (*HostUsageReport, error) {
+	metrics, err := listPages(ctx, func(params url.Values) ([]*HostMetric, bool, error) {
+		metrics, resp, err := b.metrics.ListHostMetrics(params)
+		return metrics, resp != nil && resp.Next != nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	organizations, err := b.hostsOrganizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.aggregate(metrics, organizations), nil
+}
+
+// hostsOrganizations returns the organizations names of the hosts names.
+func (b *HostUsageReportBuilder) hostsOrganizations(ctx context.Context) (map[string][]string, error) {
+	if !b.ByOrganization {
+		return nil, nil
+	}
+	hosts, err := listPages(ctx, func(params url.Values) ([]*Host, bool, error) {
+		hosts, resp, err := b.hosts.ListHosts(params)
+		return hosts, resp != nil && resp.Next != nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	organizations, err := b.organizations.ListOrganizations(hostUsageQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[int]string{}
+	for _, organization := range organizations {
+		names[organization.ID] = organization.Name
+	}
+
+	result := map[string][]string{}
+	for _, host := range hosts {
+		if host.SummaryFields == nil || host.SummaryFields.Inventory == nil {
+			continue
+		}
+		id := host.SummaryFields.Inventory.OrganizationID
+		name, ok := names[id]
+		if !ok {
+			name = strconv.Itoa(id)
+		}
+		if !containsString(result[host.Name], name) {
+			result[host.Name] = append(result[host.Name], name)
+		}
+	}
+	return result, nil
+}
+
+func hostUsageQuery() url.Values {
+	return url.Values{"page_size": {strconv.Itoa(hostUsagePageSize)}}
+}
+
+// listPages lists every page of a list method, `next` reports whether a page
+// follows the listed one.
+func listPages[T any](ctx context.Context, list func(params url.Values) (results []*T, next bool, err error)) ([]*T, error) {
+	results := make([]*T, 0)
+	query := hostUsageQuery()
+	for page := 1; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if page > 1 {
+			query.Set("page", strconv.Itoa(page))
+		}
+		pageResults, next, err := list(query)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, pageResults...)
+		if !next || len(pageResults) == 0 {
+			return results, nil
+		}
+	}
+}
+
+func (b *HostUsageReportBuilder) aggregate(metrics []*HostMetric, organizations map[string][]string) *HostUsageReport {
+	report := &HostUsageReport{Since: b.Since, Until: b.Until, StaleAfterDays: b.StaleAfterDays}
+	if report.Until.IsZero() {
+		report.Until = time.Now()
+	}
+	if report.StaleAfterDays <= 0 {
+		report.StaleAfterDays = defaultHostUsageStaleAfterDays
+	}
+	if report.Since.IsZero() {
+		for _, metric := range metrics {
+			if report.Since.IsZero() || metric.FirstAutomation.Before(report.Since) {
+				report.Since = metric.FirstAutomation
+			}
+		}
+	}
+	staleBefore := report.Until.AddDate(0, 0, -report.StaleAfterDays)
+
+	for _, metric := range metrics {
+		usage := &HostUsage{
+			Hostname:         metric.Hostname,
+			Organizations:    organizations[metric.Hostname],
+			FirstAutomation:  metric.FirstAutomation,
+			LastAutomation:   metric.LastAutomation,
+			AutomatedCounter: metric.AutomatedCounter,
+			Deleted:          metric.Deleted,
+			LastDeleted:      metric.LastDeleted,
+			Stale:            !metric.Deleted && metric.LastAutomation.Before(staleBefore),
+		}
+		if organizations != nil && len(usage.Organizations) == 0 {
+			usage.Organizations = []string{HostUsageNoOrganization}
+		}
+		sort.Strings(usage.Organizations)
+		report.Hosts = append(report.Hosts, usage)
+	}
+	sort.Slice(report.Hosts, func(i, j int) bool { return report.Hosts[i].Hostname < report.Hosts[j].Hostname })
+
+	if report.Since.IsZero() {
+		return report
+	}
+	location := report.Until.Location()
+	start := time.Date(report.Since.Year(), report.Since.Month(), 1, 0, 0, 0, 0, location)
+	for ; start.Before(report.Until); start = start.AddDate(0, 1, 0) {
+		end := start.AddDate(0, 1, 0)
+		month := &HostUsageMonth{Month: start.Format(hostUsageMonthLayout)}
+		if organizations != nil {
+			month.Organizations = map[string]*HostUsageCount{}
+		}
+		inMonth := func(t time.Time) bool { return !t.Before(start) && t.Before(end) }
+		for _, usage := range report.Hosts {
+			managed := usage.FirstAutomation.Before(end)
+			if deleted, ok := usage.LastDeleted.Get(); ok && usage.Deleted && deleted.Before(start) {
+				managed = false
+			}
+			isNew := inMonth(usage.FirstAutomation)
+			automated := isNew || inMonth(usage.LastAutomation)
+			if !managed && !automated {
+				continue
+			}
+			month.add(managed, isNew, automated)
+			for _, name := range usage.Organizations {
+				count := month.Organizations[name]
+				if count == nil {
+					count = new(HostUsageCount)
+					month.Organizations[name] = count
+				}
+				count.add(managed, isNew, automated)
+			}
+		}
+		report.Months = append(report.Months, month)
+	}
+	return report
+}
+
+// Stale returns the stale hosts.
+func (r *HostUsageReport) Stale() []*HostUsage {
+	var stale []*HostUsage
+	for _, usage := range r.Hosts {
+		if usage.Stale {
+			stale = append(stale, usage)
+		}
+	}
+	return stale
+}
+
+// WriteJSON writes the report as indented json.
+func (r *HostUsageReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteCSV writes the months as csv rows
+// `month,organization,managed_hosts,new_hosts,automated_hosts`, the row of the
+// whole month has an empty organization.
+func (r *HostUsageReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"month", "organization", "managed_hosts", "new_hosts", "automated_hosts"}); err != nil {
+		return err
+	}
+	for _, month := range r.Months {
+		if err := writer.Write([]string{month.Month, "", strconv.Itoa(month.ManagedHosts), strconv.Itoa(month.NewHosts), strconv.Itoa(month.AutomatedHosts)}); err != nil {
+			return err
+		}
+		names := make([]string, 0, len(month.Organizations))
+		for name := range month.Organizations {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			count := month.Organizations[name]
+			if err := writer.Write([]string{month.Month, name, strconv.Itoa(count.ManagedHosts), strconv.Itoa(count.NewHosts), strconv.Itoa(count.AutomatedHosts)}); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteHostsCSV writes the hosts as csv rows, the organizations separated by semicolons.
+func (r *HostUsageReport) WriteHostsCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	header := []string{"hostname", "organizations", "first_automation", "last_automation", "automated_counter", "deleted", "stale"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, usage := range r.Hosts {
+		row := []string{
+			usage.Hostname,
+			strings.Join(usage.Organizations, ";"),
+			usage.FirstAutomation.Format(time.RFC3339),
+			usage.LastAutomation.Format(time.RFC3339),
+			strconv.Itoa(usage.AutomatedCounter),
+			strconv.FormatBool(usage.Deleted),
+			strconv.FormatBool(usage.Stale),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
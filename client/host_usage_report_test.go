@@ -0,0 +1,222 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// hostUsageServer serves the host metrics, the hosts and the organizations
+// lists as AWX pages them, by `page` and `page_size`, and records the requests.
+type hostUsageServer struct {
+	lists    map[string][]string
+	requests []string
+}
+
+func (s *hostUsageServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests = append(s.requests, r.URL.RequestURI())
+	items, ok := s.lists[r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"detail": "Not found."}`)
+		return
+	}
+	page, size := 1, 25
+	if value := r.URL.Query().Get("page"); value != "" {
+		page, _ = strconv.Atoi(value)
+	}
+	if value := r.URL.Query().Get("page_size"); value != "" {
+		size, _ = strconv.Atoi(value)
+	}
+	start, end := min((page-1)*size, len(items)), min(page*size, len(items))
+	next := "null"
+	if end < len(items) {
+		next = strconv.Quote(r.URL.Path + "?" + url.Values{"page": {strconv.Itoa(page + 1)}, "page_size": {strconv.Itoa(size)}}.Encode())
+	}
+	fmt.Fprintf(w, `{"count": %d, "next": %s, "results": [%s]}`, len(items), next, strings.Join(items[start:end], ","))
+}
+
+func hostMetricJSON(hostname string, first, last time.Time, deleted bool, lastDeleted time.Time) string {
+	deletedAt := "null"
+	if !lastDeleted.IsZero() {
+		deletedAt = strconv.Quote(lastDeleted.Format(time.RFC3339))
+	}
+	return fmt.Sprintf(`{"hostname": %q, "first_automation": %q, "last_automation": %q, "automated_counter": 1, "deleted": %t, "last_deleted": %s}`,
+		hostname, first.Format(time.RFC3339), last.Format(time.RFC3339), deleted, deletedAt)
+}
+
+func TestHostUsageReport(t *testing.T) {
+	day := func(month time.Month, day int) time.Time { return time.Date(2024, month, day, 12, 0, 0, 0, time.UTC) }
+	var never time.Time
+
+	tests := []struct {
+		name    string
+		metrics []string
+		since   time.Time
+		months  string
+		stale   []string
+	}{{
+		name: "automated hosts",
+		metrics: []string{
+			hostMetricJSON("web1", day(1, 10), day(3, 20), false, never),
+			hostMetricJSON("db1", day(2, 5), day(2, 6), false, never),
+		},
+		months: "2024-01:1/1/1 2024-02:2/1/1 2024-03:2/0/1",
+		stale:  []string{"db1"},
+	}, {
+		name: "deleted host",
+		metrics: []string{
+			hostMetricJSON("web1", day(1, 10), day(3, 20), false, never),
+			hostMetricJSON("old", day(1, 2), day(1, 3), true, day(2, 20)),
+		},
+		months: "2024-01:2/2/2 2024-02:2/0/0 2024-03:1/0/1",
+	}, {
+		name: "deleted host automated again",
+		metrics: []string{
+			hostMetricJSON("again", day(1, 2), day(3, 10), false, day(1, 20)),
+		},
+		months: "2024-01:1/1/1 2024-02:1/0/0 2024-03:1/0/1",
+	}, {
+		name: "since",
+		metrics: []string{
+			hostMetricJSON("web1", day(1, 10), day(3, 20), false, never),
+			hostMetricJSON("old", day(1, 2), day(1, 3), true, day(1, 20)),
+		},
+		since:  day(2, 1),
+		months: "2024-02:1/0/0 2024-03:1/0/1",
+	}, {
+		name: "no host metrics",
+	}}
+	for _, tt := range tests {
+		handler := &hostUsageServer{lists: map[string][]string{hostMetricsAPIEndpoint: tt.metrics}}
+		server := httptest.NewServer(handler)
+		builder := NewHostUsageReportBuilder(newAWX(newTestClient(server)))
+		builder.Since, builder.Until = tt.since, day(3, 25)
+		report, err := builder.Build(context.Background())
+		server.Close()
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+
+		var months []string
+		for _, month := range report.Months {
+			months = append(months, fmt.Sprintf("%s:%d/%d/%d", month.Month, month.ManagedHosts, month.NewHosts, month.AutomatedHosts))
+			if month.Organizations != nil {
+				t.Errorf("%s: organizations without ByOrganization: %v", tt.name, month.Organizations)
+			}
+		}
+		if got := strings.Join(months, " "); got != tt.months {
+			t.Errorf("%s: months %q, want %q", tt.name, got, tt.months)
+		}
+		var stale []string
+		for _, usage := range report.Stale() {
+			stale = append(stale, usage.Hostname)
+		}
+		if !reflect.DeepEqual(stale, tt.stale) {
+			t.Errorf("%s: stale %v, want %v", tt.name, stale, tt.stale)
+		}
+		if want := []string{hostMetricsAPIEndpoint + "?page_size=200"}; !reflect.DeepEqual(handler.requests, want) {
+			t.Errorf("%s: requests %v, want %v", tt.name, handler.requests, want)
+		}
+	}
+}
+
+func TestHostUsageReportByOrganization(t *testing.T) {
+	day := func(month time.Month, day int) time.Time { return time.Date(2024, month, day, 12, 0, 0, 0, time.UTC) }
+	host := func(name string, organization int) string {
+		return fmt.Sprintf(`{"name": %q, "summary_fields": {"inventory": {"organization_id": %d}}}`, name, organization)
+	}
+	handler := &hostUsageServer{lists: map[string][]string{
+		hostMetricsAPIEndpoint: {
+			hostMetricJSON("web1", day(1, 10), day(3, 20), false, time.Time{}),
+			hostMetricJSON("db1", day(2, 5), day(2, 6), false, time.Time{}),
+			hostMetricJSON("old", day(1, 2), day(1, 3), true, day(1, 20)),
+		},
+		hostsAPIEndpoint:         {host("web1", 1), host("web1", 2), host("db1", 2), host("db1", 2)},
+		organizationsAPIEndpoint: {`{"id": 1, "name": "Default"}`},
+	}}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	builder := NewHostUsageReportBuilder(newAWX(newTestClient(server)))
+	builder.ByOrganization, builder.Until = true, day(3, 25)
+	report, err := builder.Build(context.Background())
+	if err != nil {
+		t.Fatalf("build: %s", err)
+	}
+
+	organizations := map[string][]string{}
+	for _, usage := range report.Hosts {
+		organizations[usage.Hostname] = usage.Organizations
+	}
+	want := map[string][]string{"web1": {"2", "Default"}, "db1": {"2"}, "old": {HostUsageNoOrganization}}
+	if !reflect.DeepEqual(organizations, want) {
+		t.Errorf("organizations: %v, want %v", organizations, want)
+	}
+	requests := []string{
+		hostMetricsAPIEndpoint + "?page_size=200",
+		hostsAPIEndpoint + "?page_size=200",
+		organizationsAPIEndpoint + "?page_size=200",
+	}
+	if !reflect.DeepEqual(handler.requests, requests) {
+		t.Errorf("requests %v, want %v", handler.requests, requests)
+	}
+
+	output := new(bytes.Buffer)
+	if err := report.WriteCSV(output); err != nil {
+		t.Fatal(err)
+	}
+	wantCSV := "month,organization,managed_hosts,new_hosts,automated_hosts\n" +
+		"2024-01,,2,2,2\n2024-01,(none),1,1,1\n2024-01,2,1,1,1\n2024-01,Default,1,1,1\n" +
+		"2024-02,,2,1,1\n2024-02,2,2,1,1\n2024-02,Default,1,0,0\n" +
+		"2024-03,,2,0,1\n2024-03,2,2,0,1\n2024-03,Default,1,0,1\n"
+	if output.String() != wantCSV {
+		t.Errorf("csv:\n%s\nwant:\n%s", output, wantCSV)
+	}
+}
+
+func TestHostUsageReportPaging(t *testing.T) {
+	first := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+	metrics := make([]string, 450)
+	for i := range metrics {
+		metrics[i] = hostMetricJSON(fmt.Sprintf("host%03d", i), first, first, false, time.Time{})
+	}
+	handler := &hostUsageServer{lists: map[string][]string{hostMetricsAPIEndpoint: metrics}}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	builder := NewHostUsageReportBuilder(newAWX(newTestClient(server)))
+	builder.Until = first.AddDate(0, 0, 1)
+	report, err := builder.Build(context.Background())
+	if err != nil {
+		t.Fatalf("build: %s", err)
+	}
+
+	if len(report.Hosts) != 450 || report.Hosts[0].Hostname != "host000" || report.Hosts[449].Hostname != "host449" {
+		t.Errorf("hosts: %d", len(report.Hosts))
+	}
+	if len(report.Months) != 1 || report.Months[0].ManagedHosts != 450 {
+		t.Errorf("months: %+v", report.Months)
+	}
+	want := []string{
+		hostMetricsAPIEndpoint + "?page_size=200",
+		hostMetricsAPIEndpoint + "?page=2&page_size=200",
+		hostMetricsAPIEndpoint + "?page=3&page_size=200",
+	}
+	if !reflect.DeepEqual(handler.requests, want) {
+		t.Errorf("requests %v, want %v", handler.requests, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := builder.Build(ctx); err == nil {
+		t.Errorf("expected a canceled context error")
+	}
+}
@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // HostService implements awx Hosts apis.
@@ -26,7 +28,7 @@ type ListHostsResponse struct {
 const hostsAPIEndpoint = "/api/v2/hosts/"
 
 // GetHostByID shows the details of a awx inventroy sources.
-func (h *HostService) GetHostByID(id int, params map[string]string) (*Host, error) {
+func (h *HostService) GetHostByID(id int, params url.Values) (*Host, error) {
 	result := new(Host)
 	endpoint := fmt.Sprintf("%s%d/", hostsAPIEndpoint, id)
 	resp, err := h.client.Requester.GetJSON(endpoint, result, params)
@@ -41,8 +43,19 @@ func (h *HostService) GetHostByID(id int, params map[string]string) (*Host, erro
 	return result, nil
 }
 
+// GetByNamedURL shows the details of a host by its named url identifier, see `NamedURLHost`.
+func (h *HostService) GetByNamedURL(identifier string, params url.Values) (*Host, error) {
+	return getByNamedURL[Host](h.client, hostsAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only host named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (h *HostService) FindByName(ctx context.Context, name string, scope Scope) (*Host, error) {
+	return findByName[Host](ctx, h.client, "host", hostsAPIEndpoint, "name", name, scope, inventoryScope)
+}
+
 // ListHosts shows list of awx Hosts.
-func (h *HostService) ListHosts(params map[string]string) ([]*Host, *ListHostsResponse, error) {
+func (h *HostService) ListHosts(params url.Values) ([]*Host, *ListHostsResponse, error) {
 	result := new(ListHostsResponse)
 	resp, err := h.client.Requester.GetJSON(hostsAPIEndpoint, result, params)
 	if err != nil {
@@ -57,7 +70,7 @@ func (h *HostService) ListHosts(params map[string]string) ([]*Host, *ListHostsRe
 }
 
 // CreateHost creates an awx Host.
-func (h *HostService) CreateHost(data map[string]interface{}, params map[string]string) (*Host, error) {
+func (h *HostService) CreateHost(data map[string]interface{}, params url.Values) (*Host, error) {
 	mandatoryFields = []string{"name", "inventory"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -87,7 +100,7 @@ func (h *HostService) CreateHost(data map[string]interface{}, params map[string]
 }
 
 // UpdateHost update an awx Host
-func (h *HostService) UpdateHost(id int, data map[string]interface{}, params map[string]string) (*Host, error) {
+func (h *HostService) UpdateHost(id int, data map[string]interface{}, params url.Values) (*Host, error) {
 	result := new(Host)
 	endpoint := fmt.Sprintf("%s%d", hostsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -107,7 +120,7 @@ func (h *HostService) UpdateHost(id int, data map[string]interface{}, params map
 }
 
 // AssociateGroup update an awx Host
-func (h *HostService) AssociateGroup(id int, data map[string]interface{}, params map[string]string) (*Host, error) {
+func (h *HostService) AssociateGroup(id int, data map[string]interface{}, params url.Values) (*Host, error) {
 	result := new(Host)
 	endpoint := fmt.Sprintf("%s%d/groups/", hostsAPIEndpoint, id)
 	data["associate"] = true
@@ -134,7 +147,7 @@ func (h *HostService) AssociateGroup(id int, data map[string]interface{}, params
 }
 
 // DisAssociateGroup update an awx Host
-func (h *HostService) DisAssociateGroup(id int, data map[string]interface{}, params map[string]string) (*Host, error) {
+func (h *HostService) DisAssociateGroup(id int, data map[string]interface{}, params url.Values) (*Host, error) {
 	result := new(Host)
 	endpoint := fmt.Sprintf("%s%d/groups/", hostsAPIEndpoint, id)
 	data["disassociate"] = true
@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // InstanceGroupsService implements awx execution environments apis.
@@ -20,7 +22,7 @@ type ListInstanceGroupsResponse struct {
 const InstanceGroupsAPIEndpoint = "/api/v2/instance_groups/"
 
 // ListInstanceGroups shows list of awx execution environments.
-func (p *InstanceGroupsService) ListInstanceGroups(params map[string]string) ([]*InstanceGroup, *ListInstanceGroupsResponse, error) {
+func (p *InstanceGroupsService) ListInstanceGroups(params url.Values) ([]*InstanceGroup, *ListInstanceGroupsResponse, error) {
 	result := new(ListInstanceGroupsResponse)
 	resp, err := p.client.Requester.GetJSON(InstanceGroupsAPIEndpoint, result, params)
 	if err != nil {
@@ -35,7 +37,7 @@ func (p *InstanceGroupsService) ListInstanceGroups(params map[string]string) ([]
 }
 
 // GetInstanceGroupByID shows the details of a InstanceGroup.
-func (p *InstanceGroupsService) GetInstanceGroupByID(id int, params map[string]string) (*InstanceGroup, error) {
+func (p *InstanceGroupsService) GetInstanceGroupByID(id int, params url.Values) (*InstanceGroup, error) {
 	result := new(InstanceGroup)
 	endpoint := fmt.Sprintf("%s%d/", InstanceGroupsAPIEndpoint, id)
 	resp, err := p.client.Requester.GetJSON(endpoint, result, params)
@@ -50,8 +52,19 @@ func (p *InstanceGroupsService) GetInstanceGroupByID(id int, params map[string]s
 	return result, nil
 }
 
+// GetByNamedURL shows the details of an instance group by its named url identifier, see `NamedURLInstanceGroup`.
+func (p *InstanceGroupsService) GetByNamedURL(identifier string, params url.Values) (*InstanceGroup, error) {
+	return getByNamedURL[InstanceGroup](p.client, InstanceGroupsAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only instance group named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (p *InstanceGroupsService) FindByName(ctx context.Context, name string, scope Scope) (*InstanceGroup, error) {
+	return findByName[InstanceGroup](ctx, p.client, "instance group", InstanceGroupsAPIEndpoint, "name", name, scope, noScope)
+}
+
 // CreateInstanceGroup creates an awx InstanceGroup.
-func (p *InstanceGroupsService) CreateInstanceGroup(data map[string]interface{}, params map[string]string) (*InstanceGroup, error) {
+func (p *InstanceGroupsService) CreateInstanceGroup(data map[string]interface{}, params url.Values) (*InstanceGroup, error) {
 	mandatoryFields = []string{"name"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -78,7 +91,7 @@ func (p *InstanceGroupsService) CreateInstanceGroup(data map[string]interface{},
 }
 
 // UpdateInstanceGroup update an awx InstanceGroup.
-func (p *InstanceGroupsService) UpdateInstanceGroup(id int, data map[string]interface{}, params map[string]string) (*InstanceGroup, error) {
+func (p *InstanceGroupsService) UpdateInstanceGroup(id int, data map[string]interface{}, params url.Values) (*InstanceGroup, error) {
 	result := new(InstanceGroup)
 	endpoint := fmt.Sprintf("%s%d", InstanceGroupsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -0,0 +1,464 @@
+package awx
+
+import (
+	"context"
+	"net/url"
+)
+
+//go:generate go run ../internal/fakegen -in interfaces.go -awx awx.go -out awxfake/fakes.go
+
+// ActivityStreamAPI is the interface implemented by `*ActivityStreamService`.
+type ActivityStreamAPI interface {
+	ListActivityStream(params url.Values) ([]*ActivityStream, *ListActivityStreamResponse, error)
+	GetActivityStreamByID(id int, params url.Values) (*ActivityStream, error)
+	ListRelatedActivityStream(related *Related, params url.Values) ([]*ActivityStream, *ListActivityStreamResponse, error)
+}
+
+// ApplicationAPI is the interface implemented by `*ApplicationService`.
+type ApplicationAPI interface {
+	ListApplication(params url.Values) ([]*Application, *ListApplicationResponse, error)
+	GetApplicationByID(id int, params url.Values) (*Application, error)
+	GetByNamedURL(identifier string, params url.Values) (*Application, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*Application, error)
+	CreateApplication(data map[string]interface{}, params url.Values) (*Application, error)
+	UpdateApplication(id int, data map[string]interface{}, params url.Values) (*Application, error)
+	DeleteApplication(id int) (*Application, error)
+}
+
+// ConfigAPI is the interface implemented by `*ConfigService`.
+type ConfigAPI interface {
+	GetConfig(params url.Values) (*Config, error)
+	AttachManifest(path string) (*LicenseInfo, error)
+	AttachSubscription(poolID string) (*LicenseInfo, error)
+}
+
+// DashboardAPI is the interface implemented by `*DashboardService`.
+type DashboardAPI interface {
+	GetDashboard(params url.Values) (*Dashboard, error)
+	GetJobsGraph(params url.Values) (*DashboardJobsGraph, error)
+}
+
+// ExecutionEnvironmentsAPI is the interface implemented by `*ExecutionEnvironmentsService`.
+type ExecutionEnvironmentsAPI interface {
+	ListExecutionEnvironments(params url.Values) ([]*ExecutionEnvironment, *ListExecutionEnvironmentsResponse, error)
+	GetExecutionEnvironmentByID(id int, params url.Values) (*ExecutionEnvironment, error)
+	GetByNamedURL(identifier string, params url.Values) (*ExecutionEnvironment, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*ExecutionEnvironment, error)
+	CreateExecutionEnvironment(data map[string]interface{}, params url.Values) (*ExecutionEnvironment, error)
+	UpdateExecutionEnvironment(id int, data map[string]interface{}, params url.Values) (*ExecutionEnvironment, error)
+	DeleteExecutionEnvironment(id int) (*ExecutionEnvironment, error)
+}
+
+// PingAPI is the interface implemented by `*PingService`.
+type PingAPI interface {
+	Ping() (*Ping, error)
+}
+
+// InventoriesAPI is the interface implemented by `*InventoriesService`.
+type InventoriesAPI interface {
+	GetInventoryByID(id int, params url.Values) (*Inventory, error)
+	GetByNamedURL(identifier string, params url.Values) (*Inventory, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*Inventory, error)
+	ListInventories(params url.Values) ([]*Inventory, *ListInventoriesResponse, error)
+	CreateInventory(data map[string]interface{}, params url.Values) (*Inventory, error)
+	UpdateInventory(id int, data map[string]interface{}, params url.Values) (*Inventory, error)
+	GetInventory(id int, params url.Values) (*Inventory, error)
+	DeleteInventory(id int) (*Inventory, error)
+}
+
+// JobAPI is the interface implemented by `*JobService`.
+type JobAPI interface {
+	GetJob(id int, params url.Values) (*Job, error)
+	CancelJob(id int, data map[string]interface{}, params url.Values) (*CancelJobResponse, error)
+	RelaunchJob(id int, data map[string]interface{}, params url.Values) (*JobLaunch, error)
+	GetHostSummaries(id int, params url.Values) ([]HostSummary, *HostSummariesResponse, error)
+	GetJobEvents(id int, params url.Values) ([]JobEvent, *JobEventsResponse, error)
+}
+
+// WorkflowJobAPI is the interface implemented by `*WorkflowJobService`.
+type WorkflowJobAPI interface {
+	GetWorkflowJob(id int, params url.Values) (*WorkflowJob, error)
+	CancelWorkflowJob(id int, data map[string]interface{}, params url.Values) (*CancelWorkflowJobResponse, error)
+	RelaunchWorkflowJob(id int, data map[string]interface{}, params url.Values) (*WorkflowJobLaunch, error)
+}
+
+// JobTemplateAPI is the interface implemented by `*JobTemplateService`.
+type JobTemplateAPI interface {
+	GetJobTemplateByID(id int, params url.Values) (*JobTemplate, error)
+	GetByNamedURL(identifier string, params url.Values) (*JobTemplate, error)
+	GetSurveySpec(id int) (*SurveySpec, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*JobTemplate, error)
+	ListJobTemplates(params url.Values) ([]*JobTemplate, *ListJobTemplatesResponse, error)
+	Launch(id int, data map[string]interface{}, params url.Values) (*JobLaunch, error)
+	CreateJobTemplate(data map[string]interface{}, params url.Values) (*JobTemplate, error)
+	UpdateJobTemplate(id int, data map[string]interface{}, params url.Values) (*JobTemplate, error)
+	DeleteJobTemplate(id int) (*JobTemplate, error)
+	DisAssociateCredentials(id int, data map[string]interface{}, params url.Values) (*JobTemplate, error)
+	AssociateCredentials(id int, data map[string]interface{}, params url.Values) (*JobTemplate, error)
+}
+
+// JobTemplateNotificationTemplatesAPI is the interface implemented by `*JobTemplateNotificationTemplatesService`.
+type JobTemplateNotificationTemplatesAPI interface {
+	AssociateJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+}
+
+// ProjectAPI is the interface implemented by `*ProjectService`.
+type ProjectAPI interface {
+	ListProjects(params url.Values) ([]*Project, *ListProjectsResponse, error)
+	GetProjectByID(id int, params url.Values) (*Project, error)
+	GetByNamedURL(identifier string, params url.Values) (*Project, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*Project, error)
+	CreateProject(data map[string]interface{}, params url.Values) (*Project, error)
+	UpdateProject(id int, data map[string]interface{}, params url.Values) (*Project, error)
+	DeleteProject(id int) (*Project, error)
+}
+
+// ProjectUpdatesAPI is the interface implemented by `*ProjectUpdatesService`.
+type ProjectUpdatesAPI interface {
+	ProjectUpdateCancel(id int) (*ProjectUpdateCancel, error)
+	ProjectUpdateGet(id int) (*Job, error)
+}
+
+// UserAPI is the interface implemented by `*UserService`.
+type UserAPI interface {
+	ListUsers(params url.Values) ([]*User, *ListUsersResponse, error)
+	CreateUser(data map[string]interface{}, params url.Values) (*User, error)
+	UpdateUser(id int, data map[string]interface{}, params url.Values) (*User, error)
+	DeleteUser(id int) (*User, error)
+	GetUserByID(id int, params url.Values) (*User, error)
+	GetByNamedURL(identifier string, params url.Values) (*User, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*User, error)
+	ListUserRoleEntitlements(id int, params url.Values) ([]*ApplyRole, *ListUsersEntitlementsResponse, error)
+	UpdateUserRoleEntitlement(id int, data map[string]interface{}, params url.Values) (interface{}, error)
+}
+
+// GroupAPI is the interface implemented by `*GroupService`.
+type GroupAPI interface {
+	GetGroupByID(id int, params url.Values) (*Group, error)
+	GetByNamedURL(identifier string, params url.Values) (*Group, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*Group, error)
+	ListGroups(params url.Values) ([]*Group, *ListGroupsResponse, error)
+	CreateGroup(data map[string]interface{}, params url.Values) (*Group, error)
+	UpdateGroup(id int, data map[string]interface{}, params url.Values) (*Group, error)
+	DeleteGroup(id int) (*Group, error)
+}
+
+// HostAPI is the interface implemented by `*HostService`.
+type HostAPI interface {
+	GetHostByID(id int, params url.Values) (*Host, error)
+	GetByNamedURL(identifier string, params url.Values) (*Host, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*Host, error)
+	ListHosts(params url.Values) ([]*Host, *ListHostsResponse, error)
+	CreateHost(data map[string]interface{}, params url.Values) (*Host, error)
+	UpdateHost(id int, data map[string]interface{}, params url.Values) (*Host, error)
+	AssociateGroup(id int, data map[string]interface{}, params url.Values) (*Host, error)
+	DisAssociateGroup(id int, data map[string]interface{}, params url.Values) (*Host, error)
+	DeleteHost(id int) (*Host, error)
+}
+
+// HostMetricsAPI is the interface implemented by `*HostMetricsService`.
+type HostMetricsAPI interface {
+	ListHostMetrics(params url.Values) ([]*HostMetric, *ListHostMetricsResponse, error)
+	GetHostMetricByID(id int, params url.Values) (*HostMetric, error)
+	DeleteHostMetric(id int) error
+}
+
+// HostMetricSummaryMonthlyAPI is the interface implemented by `*HostMetricSummaryMonthlyService`.
+type HostMetricSummaryMonthlyAPI interface {
+	ListHostMetricSummaryMonthly(params url.Values) ([]*HostMetricSummaryMonthly, *ListHostMetricSummaryMonthlyResponse, error)
+}
+
+// CredentialsAPI is the interface implemented by `*CredentialsService`.
+type CredentialsAPI interface {
+	ListCredentials(params url.Values) ([]*Credential, error)
+	CreateCredentials(data map[string]interface{}, params url.Values) (*Credential, error)
+	GetCredentialsByID(id int, params url.Values) (*Credential, error)
+	UpdateCredentialsByID(id int, data map[string]interface{}, params url.Values) (*Credential, error)
+	DeleteCredentialsByID(id int, params url.Values) error
+	GetByNamedURL(identifier string, params url.Values) (*Credential, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*Credential, error)
+}
+
+// CredentialTypeAPI is the interface implemented by `*CredentialTypeService`.
+type CredentialTypeAPI interface {
+	ListCredentialTypes(params url.Values) ([]*CredentialType, *ListCredentialTypeResponse, error)
+	CreateCredentialType(data map[string]interface{}, params url.Values) (*CredentialType, error)
+	GetCredentialTypeByID(id int, params url.Values) (*CredentialType, error)
+	UpdateCredentialTypeByID(id int, data map[string]interface{}, params url.Values) (*CredentialType, error)
+	DeleteCredentialTypeByID(id int, params url.Values) error
+	GetByNamedURL(identifier string, params url.Values) (*CredentialType, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*CredentialType, error)
+}
+
+// CredentialInputSourceAPI is the interface implemented by `*CredentialInputSourceService`.
+type CredentialInputSourceAPI interface {
+	ListCredentialInputSources(params url.Values) ([]*CredentialInputSource, *ListCredentialInputSourceResponse, error)
+	CreateCredentialInputSource(data map[string]interface{}, params url.Values) (*CredentialInputSource, error)
+	GetCredentialInputSourceByID(id int, params url.Values) (*CredentialInputSource, error)
+	UpdateCredentialInputSourceByID(id int, data map[string]interface{}, params url.Values) (*CredentialInputSource, error)
+	DeleteCredentialInputSourceByID(id int, params url.Values) error
+}
+
+// InventorySourcesAPI is the interface implemented by `*InventorySourcesService`.
+type InventorySourcesAPI interface {
+	GetInventorySourceByID(id int, params url.Values) (*InventorySource, error)
+	GetByNamedURL(identifier string, params url.Values) (*InventorySource, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*InventorySource, error)
+	ListInventorySources(params url.Values) ([]*InventorySource, *ListInventorySourcesResponse, error)
+	CreateInventorySource(data map[string]interface{}, params url.Values) (*InventorySource, error)
+	UpdateInventorySource(id int, data map[string]interface{}, params url.Values) (*InventorySource, error)
+	GetInventorySource(id int, params url.Values) (*InventorySource, error)
+	DeleteInventorySource(id int) (*InventorySource, error)
+}
+
+// InventorySourcesSchedulesAPI is the interface implemented by `*InventorySourcesSchedulesService`.
+type InventorySourcesSchedulesAPI interface {
+	ListInventorySourcesSchedules(id int, params url.Values) ([]*Schedule, *ListSchedulesResponse, error)
+	CreateInventorySourcesSchedule(id int, data map[string]interface{}, params url.Values) (*Schedule, error)
+}
+
+// InventoryGroupAPI is the interface implemented by `*InventoryGroupService`.
+type InventoryGroupAPI interface {
+	ListInventoryGroups(id int, params url.Values) ([]*Group, *ListGroupsResponse, error)
+}
+
+// InstanceGroupsAPI is the interface implemented by `*InstanceGroupsService`.
+type InstanceGroupsAPI interface {
+	ListInstanceGroups(params url.Values) ([]*InstanceGroup, *ListInstanceGroupsResponse, error)
+	GetInstanceGroupByID(id int, params url.Values) (*InstanceGroup, error)
+	GetByNamedURL(identifier string, params url.Values) (*InstanceGroup, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*InstanceGroup, error)
+	CreateInstanceGroup(data map[string]interface{}, params url.Values) (*InstanceGroup, error)
+	UpdateInstanceGroup(id int, data map[string]interface{}, params url.Values) (*InstanceGroup, error)
+	DeleteInstanceGroup(id int) (*InstanceGroup, error)
+}
+
+// LabelsAPI is the interface implemented by `*LabelsService`.
+type LabelsAPI interface {
+	ListLabels(params url.Values) ([]*Label, *ListLabelsResponse, error)
+	GetLabelByID(id int, params url.Values) (*Label, error)
+	GetByNamedURL(identifier string, params url.Values) (*Label, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*Label, error)
+	CreateLabel(data map[string]interface{}, params url.Values) (*Label, error)
+	UpdateLabel(id int, data map[string]interface{}, params url.Values) (*Label, error)
+	ListResourceLabels(target LabelTarget, id int, params url.Values) ([]*Label, *ListLabelsResponse, error)
+	AssociateLabel(target LabelTarget, id int, labelID int) error
+	AssociateNewLabel(target LabelTarget, id int, name string, organization int) error
+	DisassociateLabel(target LabelTarget, id int, labelID int) error
+}
+
+// MeAPI is the interface implemented by `*MeService`.
+type MeAPI interface {
+	GetMe(params url.Values) (*User, error)
+}
+
+// NotificationTemplatesAPI is the interface implemented by `*NotificationTemplatesService`.
+type NotificationTemplatesAPI interface {
+	List(params url.Values) ([]*NotificationTemplate, *ListNotificationTemplatesResponse, error)
+	GetByID(id int, params url.Values) (*NotificationTemplate, error)
+	GetByNamedURL(identifier string, params url.Values) (*NotificationTemplate, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*NotificationTemplate, error)
+	Create(data map[string]interface{}, params url.Values) (*NotificationTemplate, error)
+	Update(id int, data map[string]interface{}, params url.Values) (*NotificationTemplate, error)
+	Delete(id int) (*NotificationTemplate, error)
+	Test(ctx context.Context, id int) (*Notification, error)
+}
+
+// NotificationsAPI is the interface implemented by `*NotificationsService`.
+type NotificationsAPI interface {
+	ListNotifications(params url.Values) ([]*Notification, *ListNotificationsResponse, error)
+	GetNotificationByID(id int, params url.Values) (*Notification, error)
+	ListJobNotifications(jobID int, params url.Values) ([]*Notification, *ListNotificationsResponse, error)
+	ListNotificationTemplateNotifications(id int, params url.Values) ([]*Notification, *ListNotificationsResponse, error)
+}
+
+// OrganizationsAPI is the interface implemented by `*OrganizationsService`.
+type OrganizationsAPI interface {
+	ListOrganizations(params url.Values) ([]*Organization, error)
+	GetOrganizationsByID(id int, params url.Values) (*Organization, error)
+	GetByNamedURL(identifier string, params url.Values) (*Organization, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*Organization, error)
+	CreateOrganization(data map[string]interface{}, params url.Values) (*Organization, error)
+	UpdateOrganization(id int, data map[string]interface{}, params url.Values) (*Organization, error)
+	DeleteOrganization(id int) (*Organization, error)
+	DisAssociateGalaxyCredentials(id int, data map[string]interface{}, params url.Values) (*Organization, error)
+	AssociateGalaxyCredentials(id int, data map[string]interface{}, params url.Values) (*Organization, error)
+	DisAssociateInstanceGroups(id int, data map[string]interface{}, params url.Values) (*Organization, error)
+	AssociateInstanceGroups(id int, data map[string]interface{}, params url.Values) (*Organization, error)
+}
+
+// SchedulesAPI is the interface implemented by `*SchedulesService`.
+type SchedulesAPI interface {
+	List(params url.Values) ([]*Schedule, *ListSchedulesResponse, error)
+	GetByID(id int, params url.Values) (*Schedule, error)
+	GetByNamedURL(identifier string, params url.Values) (*Schedule, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*Schedule, error)
+	Create(data map[string]interface{}, params url.Values) (*Schedule, error)
+	Update(id int, data map[string]interface{}, params url.Values) (*Schedule, error)
+	Delete(id int) (*Schedule, error)
+}
+
+// RolesAPI is the interface implemented by `*RolesService`.
+type RolesAPI interface {
+	ListRoles(params url.Values) ([]*Role, *ListRolesResponse, error)
+	GetRoleByID(id int, params url.Values) (*Role, error)
+	ListRoleUsers(id int, params url.Values) ([]*User, *ListUsersResponse, error)
+	ListRoleTeams(id int, params url.Values) ([]*Team, *ListTeamsResponse, error)
+	Grant(ctx context.Context, principal Principal, resource interface{}, role string) error
+	Revoke(ctx context.Context, principal Principal, resource interface{}, role string) error
+}
+
+// SettingAPI is the interface implemented by `*SettingService`.
+type SettingAPI interface {
+	ListSettings(params url.Values) ([]*SettingSummary, *ListSettingsResponse, error)
+	GetSettingsBySlug(slug string, params url.Values) (*Setting, error)
+	UpdateSettings(slug string, data map[string]interface{}, params url.Values) (*Setting, error)
+	DeleteSettings(slug string) (*Setting, error)
+}
+
+// SystemJobTemplatesAPI is the interface implemented by `*SystemJobTemplatesService`.
+type SystemJobTemplatesAPI interface {
+	ListSystemJobTemplates(params url.Values) ([]*SystemJobTemplate, *ListSystemJobTemplatesResponse, error)
+	GetSystemJobTemplateByID(id int, params url.Values) (*SystemJobTemplate, error)
+	Launch(id int, data map[string]interface{}, params url.Values) (*SystemJobLaunch, error)
+	LaunchCleanup(jobType string, days int) (*SystemJobLaunch, error)
+	ListSystemJobTemplateSchedules(id int, params url.Values) ([]*Schedule, *ListSchedulesResponse, error)
+	CreateSystemJobTemplateSchedule(id int, data map[string]interface{}, params url.Values) (*Schedule, error)
+}
+
+// SystemJobsAPI is the interface implemented by `*SystemJobsService`.
+type SystemJobsAPI interface {
+	ListSystemJobs(params url.Values) ([]*SystemJob, *ListSystemJobsResponse, error)
+	GetSystemJob(id int, params url.Values) (*SystemJob, error)
+	CancelSystemJob(id int, data map[string]interface{}, params url.Values) (*CancelJobResponse, error)
+	GetSystemJobStdout(id int, params url.Values) (string, error)
+	GetSystemJobEvents(id int, params url.Values) ([]SystemJobEvent, *SystemJobEventsResponse, error)
+}
+
+// TeamAPI is the interface implemented by `*TeamService`.
+type TeamAPI interface {
+	ListTeams(params url.Values) ([]*Team, *ListTeamsResponse, error)
+	ListTeamRoleEntitlements(id int, params url.Values) ([]*ApplyRole, *ListTeamRolesResponse, error)
+	GetTeamObjectRoles(id int, params url.Values, pagination *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error)
+	GetTeamUsers(id int, params url.Values, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error)
+	GetTeamAccessList(id int, params url.Values, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error)
+	AddTeamUser(id int, data map[string]interface{}) error
+	RemoveTeamUser(id int, data map[string]interface{}) error
+	GetTeamByID(id int, params url.Values) (*Team, error)
+	GetByNamedURL(identifier string, params url.Values) (*Team, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*Team, error)
+	CreateTeam(data map[string]interface{}, params url.Values) (*Team, error)
+	UpdateTeam(id int, data map[string]interface{}, params url.Values) (*Team, error)
+	UpdateTeamRoleEntitlement(id int, data map[string]interface{}, params url.Values) (interface{}, error)
+	DeleteTeam(id int) (*Team, error)
+}
+
+// UnifiedJobTemplatesAPI is the interface implemented by `*UnifiedJobTemplatesService`.
+type UnifiedJobTemplatesAPI interface {
+	ListUnifiedJobTemplates(params url.Values) ([]AnyJobTemplate, *ListUnifiedJobTemplatesResponse, error)
+}
+
+// UnifiedJobsAPI is the interface implemented by `*UnifiedJobsService`.
+type UnifiedJobsAPI interface {
+	ListUnifiedJobs(params url.Values) ([]AnyJob, *ListUnifiedJobsResponse, error)
+}
+
+// WorkflowJobTemplateScheduleAPI is the interface implemented by `*WorkflowJobTemplateScheduleService`.
+type WorkflowJobTemplateScheduleAPI interface {
+	ListWorkflowJobTemplateSchedules(id int, params url.Values) ([]*Schedule, *ListSchedulesResponse, error)
+	CreateWorkflowJobTemplateSchedule(id int, data map[string]interface{}, params url.Values) (*Schedule, error)
+}
+
+// WorkflowJobTemplateAPI is the interface implemented by `*WorkflowJobTemplateService`.
+type WorkflowJobTemplateAPI interface {
+	GetWorkflowJobTemplateByID(id int, params url.Values) (*WorkflowJobTemplate, error)
+	GetByNamedURL(identifier string, params url.Values) (*WorkflowJobTemplate, error)
+	GetSurveySpec(id int) (*SurveySpec, error)
+	FindByName(ctx context.Context, name string, scope Scope) (*WorkflowJobTemplate, error)
+	ListWorkflowJobTemplates(params url.Values) ([]*WorkflowJobTemplate, *ListWorkflowJobTemplatesResponse, error)
+	CreateWorkflowJobTemplate(data map[string]interface{}, params url.Values) (*WorkflowJobTemplate, error)
+	UpdateWorkflowJobTemplate(id int, data map[string]interface{}, params url.Values) (*WorkflowJobTemplate, error)
+	DeleteWorkflowJobTemplate(id int) (*WorkflowJobTemplate, error)
+	Launch(id int, data map[string]interface{}, params url.Values) (*JobLaunch, error)
+}
+
+// WorkflowJobTemplateNodeAPI is the interface implemented by `*WorkflowJobTemplateNodeService`.
+type WorkflowJobTemplateNodeAPI interface {
+	GetWorkflowJobTemplateNodeByID(id int, params url.Values) (*WorkflowJobTemplateNode, error)
+	GetByNamedURL(identifier string, params url.Values) (*WorkflowJobTemplateNode, error)
+	FindByName(ctx context.Context, identifier string, scope Scope) (*WorkflowJobTemplateNode, error)
+	ListWorkflowJobTemplateNodes(params url.Values) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error)
+	CreateWorkflowJobTemplateNode(data map[string]interface{}, params url.Values) (*WorkflowJobTemplateNode, error)
+	UpdateWorkflowJobTemplateNode(id int, data map[string]interface{}, params url.Values) (*WorkflowJobTemplateNode, error)
+	DeleteWorkflowJobTemplateNode(id int) (*WorkflowJobTemplateNode, error)
+}
+
+// WorkflowJobTemplateNodeStepAPI is the interface implemented by `*WorkflowJobTemplateNodeStepService`.
+type WorkflowJobTemplateNodeStepAPI interface {
+	ListWorkflowJobTemplateNodes(id int, params url.Values) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error)
+	CreateWorkflowJobTemplateNodeStep(id int, data map[string]interface{}, params url.Values) (*WorkflowJobTemplateNode, error)
+}
+
+// WorkflowJobTemplateNotificationTemplatesAPI is the interface implemented by `*WorkflowJobTemplateNotificationTemplatesService`.
+type WorkflowJobTemplateNotificationTemplatesAPI interface {
+	AssociateWorkflowJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateWorkflowJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateWorkflowJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	AssociateWorkflowJobTemplateNotificationTemplatesApprovals(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+	DisassociateWorkflowJobTemplateNotificationTemplatesApprovals(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error)
+}
+
+// Compile time checks of the services interfaces.
+var (
+	_ ActivityStreamAPI                           = (*ActivityStreamService)(nil)
+	_ ApplicationAPI                              = (*ApplicationService)(nil)
+	_ ConfigAPI                                   = (*ConfigService)(nil)
+	_ DashboardAPI                                = (*DashboardService)(nil)
+	_ ExecutionEnvironmentsAPI                    = (*ExecutionEnvironmentsService)(nil)
+	_ PingAPI                                     = (*PingService)(nil)
+	_ InventoriesAPI                              = (*InventoriesService)(nil)
+	_ JobAPI                                      = (*JobService)(nil)
+	_ WorkflowJobAPI                              = (*WorkflowJobService)(nil)
+	_ JobTemplateAPI                              = (*JobTemplateService)(nil)
+	_ JobTemplateNotificationTemplatesAPI         = (*JobTemplateNotificationTemplatesService)(nil)
+	_ ProjectAPI                                  = (*ProjectService)(nil)
+	_ ProjectUpdatesAPI                           = (*ProjectUpdatesService)(nil)
+	_ UserAPI                                     = (*UserService)(nil)
+	_ GroupAPI                                    = (*GroupService)(nil)
+	_ HostAPI                                     = (*HostService)(nil)
+	_ HostMetricsAPI                              = (*HostMetricsService)(nil)
+	_ HostMetricSummaryMonthlyAPI                 = (*HostMetricSummaryMonthlyService)(nil)
+	_ CredentialsAPI                              = (*CredentialsService)(nil)
+	_ CredentialTypeAPI                           = (*CredentialTypeService)(nil)
+	_ CredentialInputSourceAPI                    = (*CredentialInputSourceService)(nil)
+	_ InventorySourcesAPI                         = (*InventorySourcesService)(nil)
+	_ InventorySourcesSchedulesAPI                = (*InventorySourcesSchedulesService)(nil)
+	_ InventoryGroupAPI                           = (*InventoryGroupService)(nil)
+	_ InstanceGroupsAPI                           = (*InstanceGroupsService)(nil)
+	_ LabelsAPI                                   = (*LabelsService)(nil)
+	_ MeAPI                                       = (*MeService)(nil)
+	_ NotificationTemplatesAPI                    = (*NotificationTemplatesService)(nil)
+	_ NotificationsAPI                            = (*NotificationsService)(nil)
+	_ OrganizationsAPI                            = (*OrganizationsService)(nil)
+	_ RolesAPI                                    = (*RolesService)(nil)
+	_ SchedulesAPI                                = (*SchedulesService)(nil)
+	_ SettingAPI                                  = (*SettingService)(nil)
+	_ SystemJobTemplatesAPI                       = (*SystemJobTemplatesService)(nil)
+	_ SystemJobsAPI                               = (*SystemJobsService)(nil)
+	_ TeamAPI                                     = (*TeamService)(nil)
+	_ UnifiedJobTemplatesAPI                      = (*UnifiedJobTemplatesService)(nil)
+	_ UnifiedJobsAPI                              = (*UnifiedJobsService)(nil)
+	_ WorkflowJobTemplateScheduleAPI              = (*WorkflowJobTemplateScheduleService)(nil)
+	_ WorkflowJobTemplateAPI                      = (*WorkflowJobTemplateService)(nil)
+	_ WorkflowJobTemplateNodeAPI                  = (*WorkflowJobTemplateNodeService)(nil)
+	_ WorkflowJobTemplateNodeStepAPI              = (*WorkflowJobTemplateNodeStepService)(nil)
+	_ WorkflowJobTemplateNotificationTemplatesAPI = (*WorkflowJobTemplateNotificationTemplatesService)(nil)
+)
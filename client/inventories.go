@@ -2,8 +2,11 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 )
 
 // InventoriesService implements awx inventories apis.
@@ -20,7 +23,7 @@ type ListInventoriesResponse struct {
 const inventoriesAPIEndpoint = "/api/v2/inventories/"
 
 // GetInventoryByID shows the details of a awx inventroy sources.
-func (i *InventoriesService) GetInventoryByID(id int, params map[string]string) (*Inventory, error) {
+func (i *InventoriesService) GetInventoryByID(id int, params url.Values) (*Inventory, error) {
 	result := new(Inventory)
 	endpoint := fmt.Sprintf("%s%d/", inventoriesAPIEndpoint, id)
 	resp, err := i.client.Requester.GetJSON(endpoint, result, params)
@@ -35,8 +38,19 @@ func (i *InventoriesService) GetInventoryByID(id int, params map[string]string)
 	return result, nil
 }
 
+// GetByNamedURL shows the details of an inventory by its named url identifier, see `NamedURLInventory`.
+func (i *InventoriesService) GetByNamedURL(identifier string, params url.Values) (*Inventory, error) {
+	return getByNamedURL[Inventory](i.client, inventoriesAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only inventory named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (i *InventoriesService) FindByName(ctx context.Context, name string, scope Scope) (*Inventory, error) {
+	return findByName[Inventory](ctx, i.client, "inventory", inventoriesAPIEndpoint, "name", name, scope, organizationScope)
+}
+
 // ListInventories shows list of awx inventories.
-func (i *InventoriesService) ListInventories(params map[string]string) ([]*Inventory, *ListInventoriesResponse, error) {
+func (i *InventoriesService) ListInventories(params url.Values) ([]*Inventory, *ListInventoriesResponse, error) {
 	result := new(ListInventoriesResponse)
 	resp, err := i.client.Requester.GetJSON(inventoriesAPIEndpoint, result, params)
 	if err != nil {
@@ -51,7 +65,7 @@ func (i *InventoriesService) ListInventories(params map[string]string) ([]*Inven
 }
 
 // CreateInventory creates an awx inventory.
-func (i *InventoriesService) CreateInventory(data map[string]interface{}, params map[string]string) (*Inventory, error) {
+func (i *InventoriesService) CreateInventory(data map[string]interface{}, params url.Values) (*Inventory, error) {
 	mandatoryFields = []string{"name", "organization"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -59,6 +73,9 @@ func (i *InventoriesService) CreateInventory(data map[string]interface{}, params
 		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
 		return nil, err
 	}
+	if err := validateResource(inventorySchema, http.MethodPost, inventoriesAPIEndpoint, data); err != nil {
+		return nil, err
+	}
 
 	result := new(Inventory)
 	payload, err := json.Marshal(data)
@@ -81,9 +98,12 @@ func (i *InventoriesService) CreateInventory(data map[string]interface{}, params
 }
 
 // UpdateInventory update an awx inventory
-func (i *InventoriesService) UpdateInventory(id int, data map[string]interface{}, params map[string]string) (*Inventory, error) {
+func (i *InventoriesService) UpdateInventory(id int, data map[string]interface{}, params url.Values) (*Inventory, error) {
 	result := new(Inventory)
 	endpoint := fmt.Sprintf("%s%d", inventoriesAPIEndpoint, id)
+	if err := validateResource(inventorySchema, http.MethodPatch, endpoint, data); err != nil {
+		return nil, err
+	}
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -101,10 +121,10 @@ func (i *InventoriesService) UpdateInventory(id int, data map[string]interface{}
 }
 
 // GetInventory retrives the inventory information from its ID or Name
-func (i *InventoriesService) GetInventory(id int, params map[string]string) (*Inventory, error) {
+func (i *InventoriesService) GetInventory(id int, params url.Values) (*Inventory, error) {
 	endpoint := fmt.Sprintf("%s%d", inventoriesAPIEndpoint, id)
 	result := new(Inventory)
-	resp, err := i.client.Requester.GetJSON(endpoint, result, map[string]string{})
+	resp, err := i.client.Requester.GetJSON(endpoint, result, url.Values{})
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"fmt"
+	"net/url"
 )
 
 // InventoryGroupService implements awx inventory group apis.
@@ -10,7 +11,7 @@ type InventoryGroupService struct {
 }
 
 // ListInventoryGroups shows list of awx groups in some inventory.
-func (i *InventoryGroupService) ListInventoryGroups(id int, params map[string]string) ([]*Group, *ListGroupsResponse, error) {
+func (i *InventoryGroupService) ListInventoryGroups(id int, params url.Values) ([]*Group, *ListGroupsResponse, error) {
 	result := new(ListGroupsResponse)
 	endpoint := fmt.Sprintf("%s%d/groups/", inventoriesAPIEndpoint, id)
 	resp, err := i.client.Requester.GetJSON(endpoint, result, params)
@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // InventorySourcesService implements awx inventory sources apis.
@@ -20,7 +22,7 @@ type ListInventorySourcesResponse struct {
 const inventorySourcesAPIEndpoint = "/api/v2/inventory_sources/"
 
 // GetInventorySourceByID shows the details of a awx inventroy sources.
-func (i *InventorySourcesService) GetInventorySourceByID(id int, params map[string]string) (*InventorySource, error) {
+func (i *InventorySourcesService) GetInventorySourceByID(id int, params url.Values) (*InventorySource, error) {
 	result := new(InventorySource)
 	endpoint := fmt.Sprintf("%s%d/", inventorySourcesAPIEndpoint, id)
 	resp, err := i.client.Requester.GetJSON(endpoint, result, params)
@@ -35,8 +37,19 @@ func (i *InventorySourcesService) GetInventorySourceByID(id int, params map[stri
 	return result, nil
 }
 
+// GetByNamedURL shows the details of an inventory source by its named url identifier, see `NamedURLInventorySource`.
+func (i *InventorySourcesService) GetByNamedURL(identifier string, params url.Values) (*InventorySource, error) {
+	return getByNamedURL[InventorySource](i.client, inventorySourcesAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only inventory source named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (i *InventorySourcesService) FindByName(ctx context.Context, name string, scope Scope) (*InventorySource, error) {
+	return findByName[InventorySource](ctx, i.client, "inventory source", inventorySourcesAPIEndpoint, "name", name, scope, inventoryScope)
+}
+
 // ListInventorySources shows list of awx inventories.
-func (i *InventorySourcesService) ListInventorySources(params map[string]string) ([]*InventorySource, *ListInventorySourcesResponse, error) {
+func (i *InventorySourcesService) ListInventorySources(params url.Values) ([]*InventorySource, *ListInventorySourcesResponse, error) {
 	result := new(ListInventorySourcesResponse)
 	resp, err := i.client.Requester.GetJSON(inventorySourcesAPIEndpoint, result, params)
 	if err != nil {
@@ -51,7 +64,7 @@ func (i *InventorySourcesService) ListInventorySources(params map[string]string)
 }
 
 // CreateInventorySource creates an awx InventorySource.
-func (i *InventorySourcesService) CreateInventorySource(data map[string]interface{}, params map[string]string) (*InventorySource, error) {
+func (i *InventorySourcesService) CreateInventorySource(data map[string]interface{}, params url.Values) (*InventorySource, error) {
 	mandatoryFields = []string{"name", "inventory"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -81,7 +94,7 @@ func (i *InventorySourcesService) CreateInventorySource(data map[string]interfac
 }
 
 // UpdateInventorySource update an awx InventorySource
-func (i *InventorySourcesService) UpdateInventorySource(id int, data map[string]interface{}, params map[string]string) (*InventorySource, error) {
+func (i *InventorySourcesService) UpdateInventorySource(id int, data map[string]interface{}, params url.Values) (*InventorySource, error) {
 	result := new(InventorySource)
 	endpoint := fmt.Sprintf("%s%d", inventorySourcesAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -101,10 +114,10 @@ func (i *InventorySourcesService) UpdateInventorySource(id int, data map[string]
 }
 
 // GetInventorySource retrives the InventorySource information from its ID or Name
-func (i *InventorySourcesService) GetInventorySource(id int, params map[string]string) (*InventorySource, error) {
+func (i *InventorySourcesService) GetInventorySource(id int, params url.Values) (*InventorySource, error) {
 	endpoint := fmt.Sprintf("%s%d", inventorySourcesAPIEndpoint, id)
 	result := new(InventorySource)
-	resp, err := i.client.Requester.GetJSON(endpoint, result, map[string]string{})
+	resp, err := i.client.Requester.GetJSON(endpoint, result, url.Values{})
 	if err != nil {
 		return nil, err
 	}
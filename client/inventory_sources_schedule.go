@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
 )
 
 const inventorySourcesSchedulesAPIEndpoint = "/api/v2/inventory_sources/%d/schedules/"
@@ -15,7 +16,7 @@ type InventorySourcesSchedulesService struct {
 }
 
 // ListInventorySourcesSchedules shows a list of schedules for a given inventory_source
-func (is *InventorySourcesSchedulesService) ListInventorySourcesSchedules(id int, params map[string]string) ([]*Schedule, *ListSchedulesResponse, error) {
+func (is *InventorySourcesSchedulesService) ListInventorySourcesSchedules(id int, params url.Values) ([]*Schedule, *ListSchedulesResponse, error) {
 	result := new(ListSchedulesResponse)
 	resp, err := is.client.Requester.GetJSON(
 		fmt.Sprintf(inventorySourcesSchedulesAPIEndpoint, id),
@@ -32,7 +33,7 @@ func (is *InventorySourcesSchedulesService) ListInventorySourcesSchedules(id int
 }
 
 // CreateInventorySourcesSchedule will create a schedule for an existing inventory_source
-func (is *InventorySourcesSchedulesService) CreateInventorySourcesSchedule(id int, data map[string]interface{}, params map[string]string) (*Schedule, error) {
+func (is *InventorySourcesSchedulesService) CreateInventorySourcesSchedule(id int, data map[string]interface{}, params url.Values) (*Schedule, error) {
 	mandatoryFields = []string{"name", "rrule"}
 	validate, status := ValidateParams(data, mandatoryFields)
 	if !status {
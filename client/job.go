@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // Enum of job statuses.
@@ -43,7 +44,7 @@ type CancelJobResponse struct {
 const jobAPIEndpoint = "/api/v2/jobs/"
 
 // GetJob shows the details of a job.
-func (j *JobService) GetJob(id int, params map[string]string) (*Job, error) {
+func (j *JobService) GetJob(id int, params url.Values) (*Job, error) {
 	result := new(Job)
 	endpoint := fmt.Sprintf("%s%d/", jobAPIEndpoint, id)
 	resp, err := j.client.Requester.GetJSON(endpoint, result, params)
@@ -59,7 +60,7 @@ func (j *JobService) GetJob(id int, params map[string]string) (*Job, error) {
 }
 
 // CancelJob cancels a job.
-func (j *JobService) CancelJob(id int, data map[string]interface{}, params map[string]string) (*CancelJobResponse, error) {
+func (j *JobService) CancelJob(id int, data map[string]interface{}, params url.Values) (*CancelJobResponse, error) {
 	result := new(CancelJobResponse)
 	endpoint := fmt.Sprintf("%s%d/cancel/", jobAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -80,7 +81,7 @@ func (j *JobService) CancelJob(id int, data map[string]interface{}, params map[s
 }
 
 // RelaunchJob relaunch a job.
-func (j *JobService) RelaunchJob(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
+func (j *JobService) RelaunchJob(id int, data map[string]interface{}, params url.Values) (*JobLaunch, error) {
 	result := new(JobLaunch)
 	endpoint := fmt.Sprintf("%s%d/relaunch/", jobAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -101,7 +102,7 @@ func (j *JobService) RelaunchJob(id int, data map[string]interface{}, params map
 }
 
 // GetHostSummaries get a job hosts summaries.
-func (j *JobService) GetHostSummaries(id int, params map[string]string) ([]HostSummary, *HostSummariesResponse, error) {
+func (j *JobService) GetHostSummaries(id int, params url.Values) ([]HostSummary, *HostSummariesResponse, error) {
 	result := new(HostSummariesResponse)
 	endpoint := fmt.Sprintf("%s%d/job_host_summaries/", jobAPIEndpoint, id)
 	resp, err := j.client.Requester.GetJSON(endpoint, result, params)
@@ -117,7 +118,7 @@ func (j *JobService) GetHostSummaries(id int, params map[string]string) ([]HostS
 }
 
 // GetJobEvents get a list of job events.
-func (j *JobService) GetJobEvents(id int, params map[string]string) ([]JobEvent, *JobEventsResponse, error) {
+func (j *JobService) GetJobEvents(id int, params url.Values) ([]JobEvent, *JobEventsResponse, error) {
 	result := new(JobEventsResponse)
 	endpoint := fmt.Sprintf("%s%d/job_events/", jobAPIEndpoint, id)
 	resp, err := j.client.Requester.GetJSON(endpoint, result, params)
@@ -2,9 +2,12 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 )
 
 // JobTemplateService implements awx job template apis.
@@ -21,7 +24,7 @@ type ListJobTemplatesResponse struct {
 const jobTemplateAPIEndpoint = "/api/v2/job_templates/"
 
 // GetJobTemplateByID shows the details of a job template.
-func (jt *JobTemplateService) GetJobTemplateByID(id int, params map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) GetJobTemplateByID(id int, params url.Values) (*JobTemplate, error) {
 	result := new(JobTemplate)
 	endpoint := fmt.Sprintf("%s%d/", jobTemplateAPIEndpoint, id)
 	resp, err := jt.client.Requester.GetJSON(endpoint, result, params)
@@ -36,8 +39,35 @@ func (jt *JobTemplateService) GetJobTemplateByID(id int, params map[string]strin
 	return result, nil
 }
 
+// GetByNamedURL shows the details of a job template by its named url identifier, see `NamedURLJobTemplate`.
+func (jt *JobTemplateService) GetByNamedURL(identifier string, params url.Values) (*JobTemplate, error) {
+	return getByNamedURL[JobTemplate](jt.client, jobTemplateAPIEndpoint, identifier, params)
+}
+
+// GetSurveySpec returns the survey of a job template, AWX returns an empty survey when none was created.
+func (jt *JobTemplateService) GetSurveySpec(id int) (*SurveySpec, error) {
+	result := new(SurveySpec)
+	endpoint := fmt.Sprintf("%s%d/survey_spec/", jobTemplateAPIEndpoint, id)
+	resp, err := jt.client.Requester.GetJSON(endpoint, result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// FindByName returns the only job template named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (jt *JobTemplateService) FindByName(ctx context.Context, name string, scope Scope) (*JobTemplate, error) {
+	return findByName[JobTemplate](ctx, jt.client, "job template", jobTemplateAPIEndpoint, "name", name, scope, templateScope)
+}
+
 // ListJobTemplates shows a list of job templates.
-func (jt *JobTemplateService) ListJobTemplates(params map[string]string) ([]*JobTemplate, *ListJobTemplatesResponse, error) {
+func (jt *JobTemplateService) ListJobTemplates(params url.Values) ([]*JobTemplate, *ListJobTemplatesResponse, error) {
 	result := new(ListJobTemplatesResponse)
 	resp, err := jt.client.Requester.GetJSON(jobTemplateAPIEndpoint, result, params)
 	if err != nil {
@@ -52,9 +82,18 @@ func (jt *JobTemplateService) ListJobTemplates(params map[string]string) ([]*Job
 }
 
 // Launch lauchs a job with the job template.
-func (jt *JobTemplateService) Launch(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
+// Labels given on launch, e.g. `data["labels"] = []int{3}`, need the
+// template `AskLabelsOnLaunch`, checked before launching, and AWX 21.11 or later.
+// Instance groups given on launch need AWX 21.11 or later too.
+func (jt *JobTemplateService) Launch(id int, data map[string]interface{}, params url.Values) (*JobLaunch, error) {
 	result := new(JobLaunch)
 	endpoint := fmt.Sprintf("%s%d/launch/", jobTemplateAPIEndpoint, id)
+	if err := checkLaunchInstanceGroups(jt.client, data); err != nil {
+		return nil, err
+	}
+	if err := checkLaunchLabels(jt.client, fmt.Sprintf("%s%d/", jobTemplateAPIEndpoint, id), data); err != nil {
+		return nil, err
+	}
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -77,8 +116,20 @@ func (jt *JobTemplateService) Launch(id int, data map[string]interface{}, params
 	return result, nil
 }
 
+// checkLaunchInstanceGroups returns an UnsupportedFeatureError when a launch
+// payload has instance groups the connected AWX can't prompt for.
+func checkLaunchInstanceGroups(c *Client, data map[string]interface{}) error {
+	if _, ok := data["instance_groups"]; !ok {
+		return nil
+	}
+	if !c.Requester.version.supports(FeatureAskInstanceGroups) {
+		return &UnsupportedFeatureError{Feature: FeatureAskInstanceGroups, Version: c.Requester.version.get()}
+	}
+	return nil
+}
+
 // CreateJobTemplate creates a job template
-func (jt *JobTemplateService) CreateJobTemplate(data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) CreateJobTemplate(data map[string]interface{}, params url.Values) (*JobTemplate, error) {
 	result := new(JobTemplate)
 	mandatoryFields = []string{"name", "job_type", "inventory", "project"}
 	validate, status := ValidateParams(data, mandatoryFields)
@@ -86,6 +137,9 @@ func (jt *JobTemplateService) CreateJobTemplate(data map[string]interface{}, par
 		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
 		return nil, err
 	}
+	if err := validateResource(jobTemplateSchema, http.MethodPost, jobTemplateAPIEndpoint, data); err != nil {
+		return nil, err
+	}
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -102,9 +156,12 @@ func (jt *JobTemplateService) CreateJobTemplate(data map[string]interface{}, par
 }
 
 // UpdateJobTemplate updates a job template
-func (jt *JobTemplateService) UpdateJobTemplate(id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) UpdateJobTemplate(id int, data map[string]interface{}, params url.Values) (*JobTemplate, error) {
 	result := new(JobTemplate)
 	endpoint := fmt.Sprintf("%s%d", jobTemplateAPIEndpoint, id)
+	if err := validateResource(jobTemplateSchema, http.MethodPatch, endpoint, data); err != nil {
+		return nil, err
+	}
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -138,7 +195,7 @@ func (jt *JobTemplateService) DeleteJobTemplate(id int) (*JobTemplate, error) {
 }
 
 // DisAssociateCredentials remove Credentials form an awx job template
-func (jt *JobTemplateService) DisAssociateCredentials(id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) DisAssociateCredentials(id int, data map[string]interface{}, params url.Values) (*JobTemplate, error) {
 	result := new(JobTemplate)
 	endpoint := fmt.Sprintf("%s%d/credentials/", jobTemplateAPIEndpoint, id)
 	data["disassociate"] = true
@@ -165,7 +222,7 @@ func (jt *JobTemplateService) DisAssociateCredentials(id int, data map[string]in
 }
 
 // AssociateCredentials  adding credentials to JobTemplate.
-func (jt *JobTemplateService) AssociateCredentials(id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) AssociateCredentials(id int, data map[string]interface{}, params url.Values) (*JobTemplate, error) {
 	result := new(JobTemplate)
 
 	endpoint := fmt.Sprintf("%s%d/credentials/", jobTemplateAPIEndpoint, id)
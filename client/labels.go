@@ -0,0 +1,195 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// LabelsService implements awx labels apis.
+type LabelsService struct {
+	client *Client
+}
+
+// ListLabelsResponse represents `ListLabels` endpoint response.
+type ListLabelsResponse struct {
+	Pagination
+	Results []*Label `json:"results"`
+}
+
+const labelsAPIEndpoint = "/api/v2/labels/"
+
+// LabelTarget is the endpoint of a resource type labels are associated to.
+type LabelTarget string
+
+// Enum of the resources types having labels.
+const (
+	LabelTargetJobTemplate             LabelTarget = jobTemplateAPIEndpoint
+	LabelTargetWorkflowJobTemplate     LabelTarget = workflowJobTemplateAPIEndpoint
+	LabelTargetInventory               LabelTarget = inventoriesAPIEndpoint
+	LabelTargetJob                     LabelTarget = jobAPIEndpoint
+	LabelTargetWorkflowJobTemplateNode LabelTarget = workflowJobTemplateNodeAPIEndpoint
+)
+
+// ListLabels shows list of awx labels.
+func (l *LabelsService) ListLabels(params url.Values) ([]*Label, *ListLabelsResponse, error) {
+	result := new(ListLabelsResponse)
+	resp, err := l.client.Requester.GetJSON(labelsAPIEndpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// GetLabelByID shows the details of a label.
+func (l *LabelsService) GetLabelByID(id int, params url.Values) (*Label, error) {
+	result := new(Label)
+	endpoint := fmt.Sprintf("%s%d/", labelsAPIEndpoint, id)
+	resp, err := l.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetByNamedURL shows the details of a label by its named url identifier, see `NamedURLLabel`.
+func (l *LabelsService) GetByNamedURL(identifier string, params url.Values) (*Label, error) {
+	return getByNamedURL[Label](l.client, labelsAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only label named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (l *LabelsService) FindByName(ctx context.Context, name string, scope Scope) (*Label, error) {
+	return findByName[Label](ctx, l.client, "label", labelsAPIEndpoint, "name", name, scope, organizationScope)
+}
+
+// CreateLabel creates an awx label.
+func (l *LabelsService) CreateLabel(data map[string]interface{}, params url.Values) (*Label, error) {
+	mandatoryFields = []string{"name", "organization"}
+	validate, status := ValidateParams(data, mandatoryFields)
+	if !status {
+		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
+		return nil, err
+	}
+
+	result := new(Label)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := l.client.Requester.PostJSON(labelsAPIEndpoint, bytes.NewReader(payload), result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateLabel updates an awx label.
+func (l *LabelsService) UpdateLabel(id int, data map[string]interface{}, params url.Values) (*Label, error) {
+	result := new(Label)
+	endpoint := fmt.Sprintf("%s%d/", labelsAPIEndpoint, id)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := l.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListResourceLabels shows the labels of a resource.
+func (l *LabelsService) ListResourceLabels(target LabelTarget, id int, params url.Values) ([]*Label, *ListLabelsResponse, error) {
+	result := new(ListLabelsResponse)
+	endpoint := fmt.Sprintf("%s%d/labels/", target, id)
+	resp, err := l.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// AssociateLabel adds an existing label to a resource.
+func (l *LabelsService) AssociateLabel(target LabelTarget, id int, labelID int) error {
+	return l.postLabel(target, id, map[string]interface{}{"id": labelID})
+}
+
+// AssociateNewLabel creates a label in the organization, unless it exists, and adds it to a resource.
+func (l *LabelsService) AssociateNewLabel(target LabelTarget, id int, name string, organization int) error {
+	return l.postLabel(target, id, map[string]interface{}{"name": name, "organization": organization})
+}
+
+// DisassociateLabel removes a label from a resource, without deleting the label.
+func (l *LabelsService) DisassociateLabel(target LabelTarget, id int, labelID int) error {
+	return l.postLabel(target, id, map[string]interface{}{"id": labelID, "disassociate": true})
+}
+
+func (l *LabelsService) postLabel(target LabelTarget, id int, data map[string]interface{}) error {
+	endpoint := fmt.Sprintf("%s%d/labels/", target, id)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	resp, err := l.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return CheckResponse(resp)
+}
+
+// ErrLabelsNotPrompted is returned when labels are given on the launch of a
+// template which doesn't prompt for them, AWX would ignore them.
+var ErrLabelsNotPrompted = errors.New("the template doesn't prompt for labels on launch")
+
+// checkLaunchLabels returns an UnsupportedFeatureError when a launch payload
+// has labels the connected AWX can't prompt for, and an error wrapping
+// ErrLabelsNotPrompted when the template at `endpoint` doesn't ask for them.
+func checkLaunchLabels(c *Client, endpoint string, data map[string]interface{}) error {
+	if _, ok := data["labels"]; !ok {
+		return nil
+	}
+	if !c.Requester.version.supports(FeatureAskLabelsOnLaunch) {
+		return &UnsupportedFeatureError{Feature: FeatureAskLabelsOnLaunch, Version: c.Requester.version.get()}
+	}
+
+	template := new(struct {
+		AskLabelsOnLaunch bool `json:"ask_labels_on_launch"`
+	})
+	if err := rawDo(context.Background(), c, "GET", endpoint, nil, template, nil); err != nil {
+		return err
+	}
+	if !template.AskLabelsOnLaunch {
+		return fmt.Errorf("launch %s: %w", endpoint, ErrLabelsNotPrompted)
+	}
+	return nil
+}
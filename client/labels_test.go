@@ -0,0 +1,99 @@
+package awx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestLaunchLabels(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case jobTemplateAPIEndpoint + "5/", workflowJobTemplateAPIEndpoint + "5/":
+			fmt.Fprint(w, `{"id": 5, "ask_labels_on_launch": true}`)
+		case jobTemplateAPIEndpoint + "6/", workflowJobTemplateAPIEndpoint + "6/":
+			fmt.Fprint(w, `{"id": 6, "ask_labels_on_launch": false}`)
+		default:
+			fmt.Fprint(w, `{"job": 12, "id": 12}`)
+		}
+	}))
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+	withLabels := map[string]interface{}{"labels": []int{3}}
+
+	launches := map[string]func(id int, data map[string]interface{}) error{
+		"job template": func(id int, data map[string]interface{}) error {
+			_, err := a.JobTemplateService.Launch(id, data, url.Values{})
+			return err
+		},
+		"workflow job template": func(id int, data map[string]interface{}) error {
+			_, err := a.WorkflowJobTemplateService.Launch(id, data, url.Values{})
+			return err
+		},
+	}
+	endpoints := map[string]string{"job template": jobTemplateAPIEndpoint, "workflow job template": workflowJobTemplateAPIEndpoint}
+
+	for name, launch := range launches {
+		endpoint := endpoints[name]
+		tests := []struct {
+			name     string
+			version  Version
+			id       int
+			data     map[string]interface{}
+			requests []string
+			check    func(error) bool
+		}{{
+			name:     "prompted labels",
+			version:  Version{Major: 23},
+			id:       5,
+			data:     withLabels,
+			requests: []string{"GET " + endpoint + "5/", "POST " + endpoint + "5/launch/"},
+			check:    func(err error) bool { return err == nil },
+		}, {
+			name:     "labels not prompted",
+			version:  Version{Major: 23},
+			id:       6,
+			data:     withLabels,
+			requests: []string{"GET " + endpoint + "6/"},
+			check:    func(err error) bool { return errors.Is(err, ErrLabelsNotPrompted) },
+		}, {
+			name:     "unknown version",
+			id:       5,
+			data:     withLabels,
+			requests: []string{"GET " + endpoint + "5/", "POST " + endpoint + "5/launch/"},
+			check:    func(err error) bool { return err == nil },
+		}, {
+			name:    "unsupported version",
+			version: Version{Major: 21, Minor: 10},
+			id:      5,
+			data:    withLabels,
+			check: func(err error) bool {
+				var unsupported *UnsupportedFeatureError
+				return errors.As(err, &unsupported) && unsupported.Feature == FeatureAskLabelsOnLaunch
+			},
+		}, {
+			name:     "no labels",
+			version:  Version{Major: 21, Minor: 10},
+			id:       6,
+			data:     map[string]interface{}{"limit": "web"},
+			requests: []string{"POST " + endpoint + "6/launch/"},
+			check:    func(err error) bool { return err == nil },
+		}}
+		for _, tt := range tests {
+			requests = nil
+			a.client.Requester.version.set(tt.version)
+			if err := launch(tt.id, tt.data); !tt.check(err) {
+				t.Errorf("%s, %s: unexpected error %v", name, tt.name, err)
+			}
+			if !reflect.DeepEqual(requests, tt.requests) {
+				t.Errorf("%s, %s: requests %v, want %v", name, tt.name, requests, tt.requests)
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package awx
+
+import (
+	"errors"
+	"net/url"
+)
+
+// MeService implements awx me apis.
+type MeService struct {
+	client *Client
+}
+
+const meAPIEndpoint = "/api/v2/me/"
+
+// GetMe shows the authenticated user, its summary fields hold its capabilities.
+func (m *MeService) GetMe(params url.Values) (*User, error) {
+	result := new(ListUsersResponse)
+	resp, err := m.client.Requester.GetJSON(meAPIEndpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if len(result.Results) == 0 {
+		return nil, errors.New("no authenticated user")
+	}
+	return result.Results[0], nil
+}
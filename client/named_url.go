@@ -0,0 +1,145 @@
+package awx
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// namedURLReservedChars are the url path reserved characters `url.PathEscape` keeps, which AWX expects percent-encoded.
+const namedURLReservedChars = ":@=&$"
+
+// NamedURLComponent escapes a name for a named url identifier the way AWX
+// expects: the name is percent-encoded as a path segment, url path reserved
+// characters included, and `+` is replaced by `[+]`.
+func NamedURLComponent(name string) string {
+	var b strings.Builder
+	for _, r := range url.PathEscape(name) {
+		if strings.ContainsRune(namedURLReservedChars, r) {
+			fmt.Fprintf(&b, "%%%02X", r)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return strings.ReplaceAll(b.String(), "+", "[+]")
+}
+
+// NamedURLIdentifier escapes each name and joins them with `++`, an empty name
+// stands for a null foreign key (e.g. a job template without organization).
+func NamedURLIdentifier(names ...string) string {
+	components := make([]string, 0, len(names))
+	for _, name := range names {
+		components = append(components, NamedURLComponent(name))
+	}
+	return strings.Join(components, "++")
+}
+
+// NamedURLOrganization returns the named url identifier of an organization.
+func NamedURLOrganization(name string) string {
+	return NamedURLIdentifier(name)
+}
+
+// NamedURLUser returns the named url identifier of a user.
+func NamedURLUser(username string) string {
+	return NamedURLIdentifier(username)
+}
+
+// NamedURLInstanceGroup returns the named url identifier of an instance group.
+func NamedURLInstanceGroup(name string) string {
+	return NamedURLIdentifier(name)
+}
+
+// NamedURLExecutionEnvironment returns the named url identifier of an execution environment.
+func NamedURLExecutionEnvironment(name string) string {
+	return NamedURLIdentifier(name)
+}
+
+// NamedURLTeam returns the named url identifier of a team.
+func NamedURLTeam(name, organization string) string {
+	return NamedURLIdentifier(name, organization)
+}
+
+// NamedURLProject returns the named url identifier of a project.
+func NamedURLProject(name, organization string) string {
+	return NamedURLIdentifier(name, organization)
+}
+
+// NamedURLInventory returns the named url identifier of an inventory.
+func NamedURLInventory(name, organization string) string {
+	return NamedURLIdentifier(name, organization)
+}
+
+// NamedURLJobTemplate returns the named url identifier of a job template.
+func NamedURLJobTemplate(name, organization string) string {
+	return NamedURLIdentifier(name, organization)
+}
+
+// NamedURLWorkflowJobTemplate returns the named url identifier of a workflow job template.
+func NamedURLWorkflowJobTemplate(name, organization string) string {
+	return NamedURLIdentifier(name, organization)
+}
+
+// NamedURLNotificationTemplate returns the named url identifier of a notification template.
+func NamedURLNotificationTemplate(name, organization string) string {
+	return NamedURLIdentifier(name, organization)
+}
+
+// NamedURLApplication returns the named url identifier of an application.
+func NamedURLApplication(name, organization string) string {
+	return NamedURLIdentifier(name, organization)
+}
+
+// NamedURLLabel returns the named url identifier of a label.
+func NamedURLLabel(name, organization string) string {
+	return NamedURLIdentifier(name, organization)
+}
+
+// NamedURLHost returns the named url identifier of a host.
+func NamedURLHost(name, inventory, organization string) string {
+	return NamedURLIdentifier(name, inventory, organization)
+}
+
+// NamedURLGroup returns the named url identifier of a group.
+func NamedURLGroup(name, inventory, organization string) string {
+	return NamedURLIdentifier(name, inventory, organization)
+}
+
+// NamedURLInventorySource returns the named url identifier of an inventory source.
+func NamedURLInventorySource(name, inventory, organization string) string {
+	return NamedURLIdentifier(name, inventory, organization)
+}
+
+// NamedURLWorkflowJobTemplateNode returns the named url identifier of a workflow job template node.
+func NamedURLWorkflowJobTemplateNode(identifier, workflowJobTemplate, organization string) string {
+	return NamedURLIdentifier(identifier, workflowJobTemplate, organization)
+}
+
+// NamedURLCredentialType returns the named url identifier of a credential type, e.g. `Machine+ssh`.
+func NamedURLCredentialType(name, kind string) string {
+	return NamedURLComponent(name) + "+" + NamedURLComponent(kind)
+}
+
+// NamedURLCredential returns the named url identifier of a credential.
+func NamedURLCredential(name, credentialTypeName, credentialTypeKind, organization string) string {
+	return strings.Join([]string{
+		NamedURLComponent(name),
+		NamedURLCredentialType(credentialTypeName, credentialTypeKind),
+		NamedURLComponent(organization),
+	}, "++")
+}
+
+// getByNamedURL shows the details of a resource by its named url identifier.
+func getByNamedURL[T any](client *Client, apiEndpoint, identifier string, params url.Values) (*T, error) {
+	result := new(T)
+	endpoint := fmt.Sprintf("%s%s/", apiEndpoint, identifier)
+	resp, err := client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
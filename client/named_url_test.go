@@ -0,0 +1,109 @@
+package awx
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestNamedURLComponent(t *testing.T) {
+	tests := map[string]string{
+		"deploy":           "deploy",
+		"web 01":           "web%2001",
+		"a+b":              "a[+]b",
+		"a++b":             "a[+][+]b",
+		"prod/eu":          "prod%2Feu",
+		"[tag]":            "%5Btag%5D",
+		"[+]":              "%5B[+]%5D",
+		"a;b?c:d@e=f&g$h":  "a%3Bb%3Fc%3Ad%40e%3Df%26g%24h",
+		"déploiement":      "d%C3%A9ploiement",
+		"":                 "",
+		"100% automation!": "100%25%20automation%21",
+		"v1.2_final-~":     "v1.2_final-~",
+	}
+	for in, want := range tests {
+		if got := NamedURLComponent(in); got != want {
+			t.Errorf("%q: got %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNamedURLIdentifiers(t *testing.T) {
+	tests := []struct {
+		got, want string
+	}{
+		{NamedURLOrganization("Default"), "Default"},
+		{NamedURLUser("admin"), "admin"},
+		{NamedURLJobTemplate("deploy", "Default"), "deploy++Default"},
+		{NamedURLJobTemplate("deploy", ""), "deploy++"},
+		{NamedURLJobTemplate("c++ build", "R&D"), "c[+][+]%20build++R%26D"},
+		{NamedURLHost("web01", "prod", "Engineering"), "web01++prod++Engineering"},
+		{NamedURLWorkflowJobTemplateNode("approve", "release/main", "Default"), "approve++release%2Fmain++Default"},
+		{NamedURLLabel("blue/green", "Default"), "blue%2Fgreen++Default"},
+		{NamedURLCredentialType("Machine", "ssh"), "Machine+ssh"},
+		{NamedURLCredentialType("A+B", "cloud"), "A[+]B+cloud"},
+		{NamedURLCredential("deploy-key", "Machine", "ssh", "Default"), "deploy-key++Machine+ssh++Default"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %q, want %q", tt.got, tt.want)
+		}
+	}
+}
+
+func TestGetByNamedURL(t *testing.T) {
+	var requestURI string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURI = r.RequestURI
+		io.WriteString(w, `{"id": 7, "name": "nightly"}`)
+	}))
+	defer server.Close()
+	client := newTestClient(server)
+
+	tests := []struct {
+		get  func() (int, error)
+		want string
+	}{{
+		get: func() (int, error) {
+			template, err := (&JobTemplateService{client: client}).GetByNamedURL(NamedURLJobTemplate("c++ build", "prod/eu"), url.Values{})
+			if err != nil {
+				return 0, err
+			}
+			return template.ID, nil
+		},
+		want: "/api/v2/job_templates/c[+][+]%20build++prod%2Feu/",
+	}, {
+		get: func() (int, error) {
+			schedule, err := (&SchedulesService{client: client}).GetByNamedURL("nightly++deploy++Default", url.Values{"fields": {"id"}})
+			if err != nil {
+				return 0, err
+			}
+			return schedule.ID, nil
+		},
+		want: "/api/v2/schedules/nightly++deploy++Default/?fields=id",
+	}, {
+		get: func() (int, error) {
+			label, err := (&LabelsService{client: client}).GetByNamedURL(NamedURLLabel("c++", "Default"), url.Values{})
+			if err != nil {
+				return 0, err
+			}
+			return label.ID, nil
+		},
+		want: "/api/v2/labels/c[+][+]++Default/",
+	}}
+	for _, tt := range tests {
+		id, err := tt.get()
+		if err != nil {
+			t.Errorf("%s: %s", tt.want, err)
+			continue
+		}
+		if id != 7 {
+			t.Errorf("%s: id %d", tt.want, id)
+		}
+		if requestURI != tt.want {
+			t.Errorf("request: %s, want %s", requestURI, tt.want)
+		}
+	}
+}
@@ -0,0 +1,420 @@
+package awx
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Enum of notification types.
+const (
+	NotificationTypeEmail      = "email"
+	NotificationTypeSlack      = "slack"
+	NotificationTypeWebhook    = "webhook"
+	NotificationTypePagerDuty  = "pagerduty"
+	NotificationTypeMattermost = "mattermost"
+	NotificationTypeRocketChat = "rocketchat"
+	NotificationTypeIRC        = "irc"
+	NotificationTypeGrafana    = "grafana"
+	NotificationTypeTwilio     = "twilio"
+	NotificationTypeAWSSNS     = "awssns"
+)
+
+// NotificationConfig is the typed `notification_configuration` of a notification type.
+type NotificationConfig interface {
+	NotificationType() string
+	// requiredKeys lists the configuration keys which must not be empty.
+	requiredKeys() []string
+}
+
+// EmailNotificationConfig is the configuration of the email notifications.
+type EmailNotificationConfig struct {
+	Host       string   `json:"host"`
+	Port       int      `json:"port"`
+	Username   string   `json:"username"`
+	Password   string   `json:"password"`
+	UseTLS     bool     `json:"use_tls"`
+	UseSSL     bool     `json:"use_ssl"`
+	Sender     string   `json:"sender"`
+	Recipients []string `json:"recipients"`
+	Timeout    int      `json:"timeout,omitempty"`
+}
+
+// SlackNotificationConfig is the configuration of the slack notifications.
+type SlackNotificationConfig struct {
+	Token    string   `json:"token"`
+	Channels []string `json:"channels"`
+	HexColor string   `json:"hex_color,omitempty"`
+}
+
+// WebhookNotificationConfig is the configuration of the webhook notifications.
+type WebhookNotificationConfig struct {
+	URL                    string            `json:"url"`
+	Headers                map[string]string `json:"headers"`
+	HTTPMethod             string            `json:"http_method,omitempty"`
+	Username               string            `json:"username,omitempty"`
+	Password               string            `json:"password,omitempty"`
+	DisableSSLVerification bool              `json:"disable_ssl_verification"`
+}
+
+// MarshalJSON sends nil headers as an empty object, AWX requires the key but
+// accepts no header.
+func (c WebhookNotificationConfig) MarshalJSON() ([]byte, error) {
+	type webhookConfig WebhookNotificationConfig
+	if c.Headers == nil {
+		c.Headers = map[string]string{}
+	}
+	return json.Marshal(webhookConfig(c))
+}
+
+// PagerDutyNotificationConfig is the configuration of the pagerduty notifications.
+type PagerDutyNotificationConfig struct {
+	Token      string `json:"token"`
+	Subdomain  string `json:"subdomain"`
+	ServiceKey string `json:"service_key"`
+	ClientName string `json:"client_name"`
+}
+
+// MattermostNotificationConfig is the configuration of the mattermost notifications.
+type MattermostNotificationConfig struct {
+	URL         string `json:"mattermost_url"`
+	Username    string `json:"mattermost_username,omitempty"`
+	Channel     string `json:"mattermost_channel,omitempty"`
+	IconURL     string `json:"mattermost_icon_url,omitempty"`
+	NoVerifySSL bool   `json:"mattermost_no_verify_ssl"`
+}
+
+// RocketChatNotificationConfig is the configuration of the rocket.chat notifications.
+type RocketChatNotificationConfig struct {
+	URL         string `json:"rocketchat_url"`
+	Username    string `json:"rocketchat_username,omitempty"`
+	IconURL     string `json:"rocketchat_icon_url,omitempty"`
+	NoVerifySSL bool   `json:"rocketchat_no_verify_ssl"`
+}
+
+// IRCNotificationConfig is the configuration of the irc notifications.
+type IRCNotificationConfig struct {
+	Server   string   `json:"server"`
+	Port     int      `json:"port"`
+	Nickname string   `json:"nickname"`
+	Password string   `json:"password"`
+	UseSSL   bool     `json:"use_ssl"`
+	Targets  []string `json:"targets"`
+}
+
+// GrafanaNotificationConfig is the configuration of the grafana notifications.
+type GrafanaNotificationConfig struct {
+	URL            string   `json:"grafana_url"`
+	Key            string   `json:"grafana_key"`
+	DashboardID    int      `json:"dashboardId,omitempty"`
+	PanelID        int      `json:"panelId,omitempty"`
+	AnnotationTags []string `json:"annotation_tags,omitempty"`
+	NoVerifySSL    bool     `json:"grafana_no_verify_ssl"`
+	IsRegion       bool     `json:"isRegion"`
+}
+
+// TwilioNotificationConfig is the configuration of the twilio notifications.
+type TwilioNotificationConfig struct {
+	AccountSID   string   `json:"account_sid"`
+	AccountToken string   `json:"account_token"`
+	FromNumber   string   `json:"from_number"`
+	ToNumbers    []string `json:"to_numbers"`
+}
+
+// AWSSNSNotificationConfig is the configuration of the aws sns notifications,
+// the credentials default to the AWX host ones.
+type AWSSNSNotificationConfig struct {
+	Region          string `json:"aws_region"`
+	TopicARN        string `json:"sns_topic_arn"`
+	AccessKeyID     string `json:"aws_access_key_id,omitempty"`
+	SecretAccessKey string `json:"aws_secret_access_key,omitempty"`
+	SessionToken    string `json:"aws_session_token,omitempty"`
+}
+
+func (*EmailNotificationConfig) NotificationType() string      { return NotificationTypeEmail }
+func (*SlackNotificationConfig) NotificationType() string      { return NotificationTypeSlack }
+func (*WebhookNotificationConfig) NotificationType() string    { return NotificationTypeWebhook }
+func (*PagerDutyNotificationConfig) NotificationType() string  { return NotificationTypePagerDuty }
+func (*MattermostNotificationConfig) NotificationType() string { return NotificationTypeMattermost }
+func (*RocketChatNotificationConfig) NotificationType() string { return NotificationTypeRocketChat }
+func (*IRCNotificationConfig) NotificationType() string        { return NotificationTypeIRC }
+func (*GrafanaNotificationConfig) NotificationType() string    { return NotificationTypeGrafana }
+func (*TwilioNotificationConfig) NotificationType() string     { return NotificationTypeTwilio }
+func (*AWSSNSNotificationConfig) NotificationType() string     { return NotificationTypeAWSSNS }
+
+func (*EmailNotificationConfig) requiredKeys() []string {
+	return []string{"host", "port", "sender", "recipients"}
+}
+
+func (*SlackNotificationConfig) requiredKeys() []string {
+	return []string{"token", "channels"}
+}
+
+func (*WebhookNotificationConfig) requiredKeys() []string {
+	return []string{"url"}
+}
+
+func (*PagerDutyNotificationConfig) requiredKeys() []string {
+	return []string{"token", "subdomain", "service_key", "client_name"}
+}
+
+func (*MattermostNotificationConfig) requiredKeys() []string {
+	return []string{"mattermost_url"}
+}
+
+func (*RocketChatNotificationConfig) requiredKeys() []string {
+	return []string{"rocketchat_url"}
+}
+
+func (*IRCNotificationConfig) requiredKeys() []string {
+	return []string{"server", "port", "nickname", "targets"}
+}
+
+func (*GrafanaNotificationConfig) requiredKeys() []string {
+	return []string{"grafana_url", "grafana_key"}
+}
+
+func (*TwilioNotificationConfig) requiredKeys() []string {
+	return []string{"account_sid", "account_token", "from_number", "to_numbers"}
+}
+
+func (*AWSSNSNotificationConfig) requiredKeys() []string {
+	return []string{"aws_region", "sns_topic_arn"}
+}
+
+// newNotificationConfig returns an empty configuration of a notification type.
+func newNotificationConfig(notificationType string) (NotificationConfig, error) {
+	switch notificationType {
+	case NotificationTypeEmail:
+		return &EmailNotificationConfig{}, nil
+	case NotificationTypeSlack:
+		return &SlackNotificationConfig{}, nil
+	case NotificationTypeWebhook:
+		return &WebhookNotificationConfig{}, nil
+	case NotificationTypePagerDuty:
+		return &PagerDutyNotificationConfig{}, nil
+	case NotificationTypeMattermost:
+		return &MattermostNotificationConfig{}, nil
+	case NotificationTypeRocketChat:
+		return &RocketChatNotificationConfig{}, nil
+	case NotificationTypeIRC:
+		return &IRCNotificationConfig{}, nil
+	case NotificationTypeGrafana:
+		return &GrafanaNotificationConfig{}, nil
+	case NotificationTypeTwilio:
+		return &TwilioNotificationConfig{}, nil
+	case NotificationTypeAWSSNS:
+		return &AWSSNSNotificationConfig{}, nil
+	}
+	return nil, fmt.Errorf("unknown notification type %q", notificationType)
+}
+
+// ParseNotificationConfig decodes the `notification_configuration` map of a notification type.
+func ParseNotificationConfig(notificationType string, configuration map[string]interface{}) (NotificationConfig, error) {
+	config, err := newNotificationConfig(notificationType)
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("decode %s notification configuration: %w", notificationType, err)
+	}
+	return config, nil
+}
+
+// NotificationConfigMap encodes a configuration into its `notification_configuration` map.
+func NotificationConfigMap(config NotificationConfig) (map[string]interface{}, error) {
+	content, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	configuration := map[string]interface{}{}
+	if err := json.Unmarshal(content, &configuration); err != nil {
+		return nil, err
+	}
+	return configuration, nil
+}
+
+// ValidateNotificationConfig checks the required keys of a configuration are not empty.
+func ValidateNotificationConfig(config NotificationConfig) error {
+	configuration, err := NotificationConfigMap(config)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, key := range config.requiredKeys() {
+		if isEmptyValue(configuration[key]) {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("Mandatory %s notification configuration keys are absent: %s", config.NotificationType(), missing)
+	}
+	return nil
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// Config decodes the typed configuration of the notification template.
+func (t *NotificationTemplate) Config() (NotificationConfig, error) {
+	return ParseNotificationConfig(t.NotificationType, t.NotificationConfiguration)
+}
+
+// NotificationMessage is a custom message, the message is the subject or the
+// short text, the body is used by the email, webhook and pagerduty types.
+type NotificationMessage struct {
+	Message string `json:"message,omitempty"`
+	Body    string `json:"body,omitempty"`
+}
+
+// NotificationApprovalMessages are the custom messages of the workflow approvals.
+type NotificationApprovalMessages struct {
+	Running  *NotificationMessage `json:"running,omitempty"`
+	Approved *NotificationMessage `json:"approved,omitempty"`
+	TimedOut *NotificationMessage `json:"timed_out,omitempty"`
+	Denied   *NotificationMessage `json:"denied,omitempty"`
+}
+
+// NotificationMessages are the custom `messages` of a notification template,
+// nil messages use the AWX default ones.
+type NotificationMessages struct {
+	Started          *NotificationMessage          `json:"started,omitempty"`
+	Success          *NotificationMessage          `json:"success,omitempty"`
+	Error            *NotificationMessage          `json:"error,omitempty"`
+	WorkflowApproval *NotificationApprovalMessages `json:"workflow_approval,omitempty"`
+}
+
+// notificationJobVariables are the variables of the jobs messages templates.
+var notificationJobVariables = []string{"job", "job_friendly_name", "job_metadata", "url"}
+
+// notificationApprovalVariables are the variables of the workflow approvals messages templates.
+var notificationApprovalVariables = []string{"approval_node_name", "approval_status", "job", "job_friendly_name", "job_metadata", "url", "workflow_url"}
+
+// templateVariable matches the first name of the `{{ }}` expressions.
+var templateVariable = regexp.MustCompile(`\{\{-?\s*([A-Za-z_][A-Za-z0-9_]*)`)
+
+// templateAssignment matches the names a template binds, the `{% for %}` loop
+// variables and the `{% set %}` and `{% with %}` targets.
+var templateAssignment = regexp.MustCompile(`\{%-?\s*(?:for\s+([A-Za-z_][A-Za-z0-9_]*(?:\s*,\s*[A-Za-z_][A-Za-z0-9_]*)*)\s+in\b|(?:set|with)\s+([A-Za-z_][A-Za-z0-9_]*)\s*=)`)
+
+// Validate checks the messages templates only use the variables AWX provides,
+// or the ones they bind with `{% for %}`, `{% set %}` or `{% with %}`, and
+// have balanced `{{ }}` delimiters.
+func (m *NotificationMessages) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	var errs []string
+	check := func(name string, message *NotificationMessage, variables []string) {
+		if message == nil {
+			return
+		}
+		errs = append(errs, checkMessageTemplate(name+".message", message.Message, variables)...)
+		errs = append(errs, checkMessageTemplate(name+".body", message.Body, variables)...)
+	}
+	check("started", m.Started, notificationJobVariables)
+	check("success", m.Success, notificationJobVariables)
+	check("error", m.Error, notificationJobVariables)
+	if approval := m.WorkflowApproval; approval != nil {
+		check("workflow_approval.running", approval.Running, notificationApprovalVariables)
+		check("workflow_approval.approved", approval.Approved, notificationApprovalVariables)
+		check("workflow_approval.timed_out", approval.TimedOut, notificationApprovalVariables)
+		check("workflow_approval.denied", approval.Denied, notificationApprovalVariables)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid notification messages: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func checkMessageTemplate(field, text string, variables []string) []string {
+	var errs []string
+	if strings.Count(text, "{{") != strings.Count(text, "}}") {
+		errs = append(errs, fmt.Sprintf("%s: unbalanced {{ }}", field))
+	}
+
+	// the names bound by the template, `loop` is the state of the for loops
+	local := map[string]bool{}
+	for _, match := range templateAssignment.FindAllStringSubmatch(text, -1) {
+		if match[1] != "" {
+			local["loop"] = true
+		}
+		for _, name := range strings.Split(match[1]+","+match[2], ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				local[name] = true
+			}
+		}
+	}
+
+	unknown := map[string]bool{}
+	for _, match := range templateVariable.FindAllStringSubmatch(text, -1) {
+		if !containsString(variables, match[1]) && !local[match[1]] {
+			unknown[match[1]] = true
+		}
+	}
+	names := make([]string, 0, len(unknown))
+	for name := range unknown {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		errs = append(errs, fmt.Sprintf("%s: unknown variable %s, expecting one of %s", field, name, strings.Join(variables, ", ")))
+	}
+	return errs
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationTemplateData returns the `notification_type`,
+// `notification_configuration` and `messages` fields of a notification
+// template payload, once validated. Add the name and organization to create
+// a notification template.
+func NotificationTemplateData(config NotificationConfig, messages *NotificationMessages) (map[string]interface{}, error) {
+	if err := ValidateNotificationConfig(config); err != nil {
+		return nil, err
+	}
+	if err := messages.Validate(); err != nil {
+		return nil, err
+	}
+	configuration, err := NotificationConfigMap(config)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"notification_type":          config.NotificationType(),
+		"notification_configuration": configuration,
+	}
+	if messages != nil {
+		data["messages"] = messages
+	}
+	return data, nil
+}
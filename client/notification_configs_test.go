@@ -0,0 +1,178 @@
+package awx
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNotificationConfig(t *testing.T) {
+	configuration := map[string]interface{}{
+		"url":                      "https://hooks.example.com/awx",
+		"headers":                  map[string]interface{}{"X-Team": "platform"},
+		"http_method":              "POST",
+		"disable_ssl_verification": false,
+	}
+	config, err := ParseNotificationConfig(NotificationTypeWebhook, configuration)
+	if err != nil {
+		t.Fatalf("parse: %s", err)
+	}
+	webhook, ok := config.(*WebhookNotificationConfig)
+	if !ok || webhook.Headers["X-Team"] != "platform" {
+		t.Fatalf("parse: %#v", config)
+	}
+	if got, err := NotificationConfigMap(config); err != nil || !reflect.DeepEqual(got, configuration) {
+		t.Errorf("round trip: %v, %v", got, err)
+	}
+
+	messages := &NotificationMessages{
+		Started: &NotificationMessage{Message: "{{ job_friendly_name }} #{{ job.id }} started", Body: "{% for h in hosts %}{{ h }}{% endfor %}"},
+		Success: &NotificationMessage{
+			Message: "{% set name = job.name %}{{ name }} succeeded",
+			Body:    "{% for host, summary in job.host_status_counts.items() %}{{ loop.index }}. {{ host }}: {{ summary }}{% endfor %}",
+		},
+		Error: &NotificationMessage{Message: "{{ job.name }} failed on {{ h }}", Body: "{{ approval_status }} {{ url }"},
+		WorkflowApproval: &NotificationApprovalMessages{
+			Denied: &NotificationMessage{Message: "{{ approval_node_name }} denied"},
+		},
+	}
+	err = messages.Validate()
+	if err == nil {
+		t.Fatal("expecting invalid messages")
+	}
+	for _, want := range []string{"error.body: unbalanced", "error.body: unknown variable approval_status", "error.message: unknown variable h,"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("%s not in %s", want, err)
+		}
+	}
+	if strings.Contains(err.Error(), "started") || strings.Contains(err.Error(), "success") || strings.Contains(err.Error(), "denied") {
+		t.Errorf("valid messages reported: %s", err)
+	}
+}
+
+func TestNotificationConfigTypes(t *testing.T) {
+	tests := []struct {
+		notificationType string
+		configuration    map[string]interface{}
+		want             NotificationConfig
+		missing          NotificationConfig
+		missingKeys      string
+	}{
+		{
+			notificationType: NotificationTypeEmail,
+			configuration: map[string]interface{}{
+				"host": "smtp.example.com", "port": float64(587), "username": "awx", "password": "secret",
+				"use_tls": true, "use_ssl": false, "sender": "awx@example.com", "recipients": []interface{}{"ops@example.com"},
+			},
+			want: &EmailNotificationConfig{
+				Host: "smtp.example.com", Port: 587, Username: "awx", Password: "secret", UseTLS: true,
+				Sender: "awx@example.com", Recipients: []string{"ops@example.com"},
+			},
+			missing:     &EmailNotificationConfig{Host: "smtp.example.com"},
+			missingKeys: "[port sender recipients]",
+		},
+		{
+			notificationType: NotificationTypeSlack,
+			configuration:    map[string]interface{}{"token": "xoxb", "channels": []interface{}{"#ops"}, "hex_color": "#ff0000"},
+			want:             &SlackNotificationConfig{Token: "xoxb", Channels: []string{"#ops"}, HexColor: "#ff0000"},
+			missing:          &SlackNotificationConfig{Token: "xoxb"},
+			missingKeys:      "[channels]",
+		},
+		{
+			notificationType: NotificationTypeWebhook,
+			configuration:    map[string]interface{}{"url": "https://hooks.example.com/awx", "headers": map[string]interface{}{}, "disable_ssl_verification": true},
+			want:             &WebhookNotificationConfig{URL: "https://hooks.example.com/awx", Headers: map[string]string{}, DisableSSLVerification: true},
+			missing:          &WebhookNotificationConfig{Headers: map[string]string{"X-Team": "platform"}},
+			missingKeys:      "[url]",
+		},
+		{
+			notificationType: NotificationTypePagerDuty,
+			configuration:    map[string]interface{}{"token": "pd", "subdomain": "example", "service_key": "key", "client_name": "awx"},
+			want:             &PagerDutyNotificationConfig{Token: "pd", Subdomain: "example", ServiceKey: "key", ClientName: "awx"},
+			missing:          &PagerDutyNotificationConfig{Token: "pd", Subdomain: "example"},
+			missingKeys:      "[service_key client_name]",
+		},
+		{
+			notificationType: NotificationTypeMattermost,
+			configuration:    map[string]interface{}{"mattermost_url": "https://mm.example.com/hooks/1", "mattermost_channel": "ops", "mattermost_no_verify_ssl": false},
+			want:             &MattermostNotificationConfig{URL: "https://mm.example.com/hooks/1", Channel: "ops"},
+			missing:          &MattermostNotificationConfig{Channel: "ops"},
+			missingKeys:      "[mattermost_url]",
+		},
+		{
+			notificationType: NotificationTypeRocketChat,
+			configuration:    map[string]interface{}{"rocketchat_url": "https://chat.example.com/hooks/1", "rocketchat_username": "awx", "rocketchat_no_verify_ssl": true},
+			want:             &RocketChatNotificationConfig{URL: "https://chat.example.com/hooks/1", Username: "awx", NoVerifySSL: true},
+			missing:          &RocketChatNotificationConfig{Username: "awx"},
+			missingKeys:      "[rocketchat_url]",
+		},
+		{
+			notificationType: NotificationTypeIRC,
+			configuration: map[string]interface{}{
+				"server": "irc.example.com", "port": float64(6697), "nickname": "awx", "password": "",
+				"use_ssl": true, "targets": []interface{}{"#ops"},
+			},
+			want:        &IRCNotificationConfig{Server: "irc.example.com", Port: 6697, Nickname: "awx", UseSSL: true, Targets: []string{"#ops"}},
+			missing:     &IRCNotificationConfig{Server: "irc.example.com", Port: 6697},
+			missingKeys: "[nickname targets]",
+		},
+		{
+			notificationType: NotificationTypeGrafana,
+			configuration: map[string]interface{}{
+				"grafana_url": "https://grafana.example.com", "grafana_key": "key", "dashboardId": float64(3),
+				"annotation_tags": []interface{}{"awx"}, "grafana_no_verify_ssl": false, "isRegion": true,
+			},
+			want: &GrafanaNotificationConfig{
+				URL: "https://grafana.example.com", Key: "key", DashboardID: 3, AnnotationTags: []string{"awx"}, IsRegion: true,
+			},
+			missing:     &GrafanaNotificationConfig{URL: "https://grafana.example.com"},
+			missingKeys: "[grafana_key]",
+		},
+		{
+			notificationType: NotificationTypeTwilio,
+			configuration: map[string]interface{}{
+				"account_sid": "AC1", "account_token": "token", "from_number": "+15550100", "to_numbers": []interface{}{"+15550101"},
+			},
+			want:        &TwilioNotificationConfig{AccountSID: "AC1", AccountToken: "token", FromNumber: "+15550100", ToNumbers: []string{"+15550101"}},
+			missing:     &TwilioNotificationConfig{AccountSID: "AC1", FromNumber: "+15550100"},
+			missingKeys: "[account_token to_numbers]",
+		},
+		{
+			notificationType: NotificationTypeAWSSNS,
+			configuration:    map[string]interface{}{"aws_region": "eu-west-1", "sns_topic_arn": "arn:aws:sns:eu-west-1:123456789012:awx"},
+			want:             &AWSSNSNotificationConfig{Region: "eu-west-1", TopicARN: "arn:aws:sns:eu-west-1:123456789012:awx"},
+			missing:          &AWSSNSNotificationConfig{AccessKeyID: "AKIA"},
+			missingKeys:      "[aws_region sns_topic_arn]",
+		},
+	}
+	for _, tt := range tests {
+		config, err := ParseNotificationConfig(tt.notificationType, tt.configuration)
+		if err != nil {
+			t.Errorf("%s: parse: %s", tt.notificationType, err)
+			continue
+		}
+		if !reflect.DeepEqual(config, tt.want) || config.NotificationType() != tt.notificationType {
+			t.Errorf("%s: parse: %#v, want %#v", tt.notificationType, config, tt.want)
+		}
+		if got, err := NotificationConfigMap(config); err != nil || !reflect.DeepEqual(got, tt.configuration) {
+			t.Errorf("%s: round trip: %v, %v", tt.notificationType, got, err)
+		}
+		if err := ValidateNotificationConfig(config); err != nil {
+			t.Errorf("%s: valid configuration: %s", tt.notificationType, err)
+		}
+		if err := ValidateNotificationConfig(tt.missing); err == nil || !strings.Contains(err.Error(), tt.missingKeys) {
+			t.Errorf("%s: missing keys: %v, want %s", tt.notificationType, err, tt.missingKeys)
+		}
+	}
+}
+
+func TestWebhookNotificationConfigHeaders(t *testing.T) {
+	data, err := NotificationTemplateData(&WebhookNotificationConfig{URL: "https://hooks.example.com/awx"}, nil)
+	if err != nil {
+		t.Fatalf("no headers: %s", err)
+	}
+	configuration := data["notification_configuration"].(map[string]interface{})
+	if headers, ok := configuration["headers"].(map[string]interface{}); !ok || len(headers) != 0 {
+		t.Errorf("headers: %#v", configuration["headers"])
+	}
+}
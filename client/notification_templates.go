@@ -2,8 +2,12 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"time"
 )
 
 // NotificationTemplatesService implements awx projects apis.
@@ -19,7 +23,23 @@ type ListNotificationTemplatesResponse struct {
 
 const notificationTemplatesAPIEndpoint = "/api/v2/notification_templates/"
 
-func (s *NotificationTemplatesService) List(params map[string]string) ([]*NotificationTemplate, *ListNotificationTemplatesResponse, error) {
+// notificationPollInterval is the delay between two reads of a test notification status.
+var notificationPollInterval = time.Second
+
+// notificationTestTimeout bounds the wait of `Test` for the delivery of the
+// test notification when its context has no deadline.
+var notificationTestTimeout = 2 * time.Minute
+
+// NotificationError is returned by `Test` when the test notification could not be delivered.
+type NotificationError struct {
+	Notification *Notification
+}
+
+func (e *NotificationError) Error() string {
+	return fmt.Sprintf("notification %d failed: %s", e.Notification.ID, e.Notification.Error)
+}
+
+func (s *NotificationTemplatesService) List(params url.Values) ([]*NotificationTemplate, *ListNotificationTemplatesResponse, error) {
 	result := new(ListNotificationTemplatesResponse)
 	resp, err := s.client.Requester.GetJSON(notificationTemplatesAPIEndpoint, result, params)
 	if err != nil {
@@ -34,7 +54,7 @@ func (s *NotificationTemplatesService) List(params map[string]string) ([]*Notifi
 }
 
 // GetByID shows the details of a notification_template.
-func (s *NotificationTemplatesService) GetByID(id int, params map[string]string) (*NotificationTemplate, error) {
+func (s *NotificationTemplatesService) GetByID(id int, params url.Values) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 	endpoint := fmt.Sprintf("%s%d/", notificationTemplatesAPIEndpoint, id)
 	resp, err := s.client.Requester.GetJSON(endpoint, result, params)
@@ -49,14 +69,28 @@ func (s *NotificationTemplatesService) GetByID(id int, params map[string]string)
 	return result, nil
 }
 
+// GetByNamedURL shows the details of a notification template by its named url identifier, see `NamedURLNotificationTemplate`.
+func (s *NotificationTemplatesService) GetByNamedURL(identifier string, params url.Values) (*NotificationTemplate, error) {
+	return getByNamedURL[NotificationTemplate](s.client, notificationTemplatesAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only notification template named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (s *NotificationTemplatesService) FindByName(ctx context.Context, name string, scope Scope) (*NotificationTemplate, error) {
+	return findByName[NotificationTemplate](ctx, s.client, "notification template", notificationTemplatesAPIEndpoint, "name", name, scope, organizationScope)
+}
+
 // Create creates an awx notification_template.
-func (s *NotificationTemplatesService) Create(data map[string]interface{}, params map[string]string) (*NotificationTemplate, error) {
+func (s *NotificationTemplatesService) Create(data map[string]interface{}, params url.Values) (*NotificationTemplate, error) {
 	mandatoryFields = []string{"name", "organization", "notification_type"}
 	validate, status := ValidateParams(data, mandatoryFields)
 	if !status {
 		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
 		return nil, err
 	}
+	if err := validateResource(notificationTemplateSchema, http.MethodPost, notificationTemplatesAPIEndpoint, data); err != nil {
+		return nil, err
+	}
 
 	result := new(NotificationTemplate)
 	payload, err := json.Marshal(data)
@@ -77,9 +111,12 @@ func (s *NotificationTemplatesService) Create(data map[string]interface{}, param
 }
 
 // Update update an awx notification_template.
-func (s *NotificationTemplatesService) Update(id int, data map[string]interface{}, params map[string]string) (*NotificationTemplate, error) {
+func (s *NotificationTemplatesService) Update(id int, data map[string]interface{}, params url.Values) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 	endpoint := fmt.Sprintf("%s%d", notificationTemplatesAPIEndpoint, id)
+	if err := validateResource(notificationTemplateSchema, http.MethodPatch, endpoint, data); err != nil {
+		return nil, err
+	}
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -112,3 +149,50 @@ func (s *NotificationTemplatesService) Delete(id int) (*NotificationTemplate, er
 
 	return result, nil
 }
+
+// Test sends a test notification through a notification template and waits
+// for its delivery until the context is done, 2 minutes at most when the
+// context has no deadline. It returns the notification and a
+// `*NotificationError` when the delivery failed. In dry-run mode it returns
+// the pending `DryRunID` notification without waiting.
+func (s *NotificationTemplatesService) Test(ctx context.Context, id int) (*Notification, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, notificationTestTimeout)
+		defer cancel()
+	}
+
+	started := new(struct {
+		Notification int `json:"notification"`
+	})
+	endpoint := fmt.Sprintf("%s%d/test/", notificationTemplatesAPIEndpoint, id)
+	if err := rawDo(ctx, s.client, "POST", endpoint, map[string]interface{}{}, started, nil); err != nil {
+		return nil, err
+	}
+	if started.Notification == 0 {
+		return nil, fmt.Errorf("notification template %d test: no notification returned", id)
+	}
+	if started.Notification == DryRunID {
+		return &Notification{ID: DryRunID, NotificationTemplate: id, Status: NotificationStatusPending}, nil
+	}
+
+	notificationEndpoint := fmt.Sprintf("%s%d/", notificationsAPIEndpoint, started.Notification)
+	for {
+		notification := new(Notification)
+		if err := rawDo(ctx, s.client, "GET", notificationEndpoint, nil, notification, nil); err != nil {
+			return nil, err
+		}
+		switch notification.Status {
+		case NotificationStatusSuccessful:
+			return notification, nil
+		case NotificationStatusFailed:
+			return notification, &NotificationError{Notification: notification}
+		}
+
+		select {
+		case <-ctx.Done():
+			return notification, ctx.Err()
+		case <-time.After(notificationPollInterval):
+		}
+	}
+}
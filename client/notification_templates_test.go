@@ -0,0 +1,115 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// notificationServer starts test notifications, their status reads pending `pending` times then `status`.
+type notificationServer struct {
+	pending int
+	status  string
+	reads   int
+}
+
+func (s *notificationServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case notificationTemplatesAPIEndpoint + "3/test/":
+		fmt.Fprint(w, `{"notification": 8}`)
+	case notificationTemplatesAPIEndpoint + "4/test/":
+		fmt.Fprint(w, `{}`)
+	case notificationsAPIEndpoint + "8/":
+		s.reads++
+		status := s.status
+		if s.reads <= s.pending {
+			status = NotificationStatusPending
+		}
+		fmt.Fprintf(w, `{"id": 8, "status": %q, "error": "invalid token", "notifications_sent": 1}`, status)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestNotificationTemplateTest(t *testing.T) {
+	interval, timeout := notificationPollInterval, notificationTestTimeout
+	notificationPollInterval, notificationTestTimeout = time.Millisecond, 50*time.Millisecond
+	defer func() { notificationPollInterval, notificationTestTimeout = interval, timeout }()
+
+	withDeadline := func() context.Context {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		t.Cleanup(cancel)
+		return ctx
+	}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		id      int
+		pending int
+		status  string
+		check   func(*Notification, error) bool
+	}{{
+		name:    "delivered after polling",
+		ctx:     context.Background(),
+		id:      3,
+		pending: 2,
+		status:  NotificationStatusSuccessful,
+		check: func(n *Notification, err error) bool {
+			return err == nil && n.Status == NotificationStatusSuccessful
+		},
+	}, {
+		name:   "delivery failed",
+		ctx:    context.Background(),
+		id:     3,
+		status: NotificationStatusFailed,
+		check: func(n *Notification, err error) bool {
+			var failed *NotificationError
+			return errors.As(err, &failed) && failed.Notification.Error == "invalid token" && n.ID == 8
+		},
+	}, {
+		name:    "context deadline",
+		ctx:     withDeadline(),
+		id:      3,
+		pending: 1 << 20,
+		check: func(n *Notification, err error) bool {
+			return errors.Is(err, context.DeadlineExceeded)
+		},
+	}, {
+		name:    "default timeout",
+		ctx:     context.Background(),
+		id:      3,
+		pending: 1 << 20,
+		check: func(n *Notification, err error) bool {
+			return errors.Is(err, context.DeadlineExceeded)
+		},
+	}, {
+		name: "no notification started",
+		ctx:  context.Background(),
+		id:   4,
+		check: func(n *Notification, err error) bool {
+			return n == nil && err != nil
+		},
+	}}
+	for _, tt := range tests {
+		handler := &notificationServer{pending: tt.pending, status: tt.status}
+		server := httptest.NewServer(handler)
+		s := &NotificationTemplatesService{client: newTestClient(server)}
+
+		started := time.Now()
+		notification, err := s.Test(tt.ctx, tt.id)
+		if !tt.check(notification, err) {
+			t.Errorf("%s: %+v, %v", tt.name, notification, err)
+		}
+		if elapsed := time.Since(started); elapsed > time.Second {
+			t.Errorf("%s: waited %s", tt.name, elapsed)
+		}
+		if tt.pending > 0 && tt.pending < 10 && handler.reads != tt.pending+1 {
+			t.Errorf("%s: %d reads, want %d", tt.name, handler.reads, tt.pending+1)
+		}
+		server.Close()
+	}
+}
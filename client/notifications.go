@@ -0,0 +1,71 @@
+package awx
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Enum of notification statuses.
+const (
+	NotificationStatusPending    = "pending"
+	NotificationStatusSuccessful = "successful"
+	NotificationStatusFailed     = "failed"
+)
+
+// NotificationsService implements awx notifications apis.
+type NotificationsService struct {
+	client *Client
+}
+
+// ListNotificationsResponse represents `ListNotifications` endpoint response.
+type ListNotificationsResponse struct {
+	Pagination
+	Results []*Notification `json:"results"`
+}
+
+const notificationsAPIEndpoint = "/api/v2/notifications/"
+
+// ListNotifications shows list of awx notifications.
+func (n *NotificationsService) ListNotifications(params url.Values) ([]*Notification, *ListNotificationsResponse, error) {
+	return n.list(notificationsAPIEndpoint, params)
+}
+
+// GetNotificationByID shows the details of a notification.
+func (n *NotificationsService) GetNotificationByID(id int, params url.Values) (*Notification, error) {
+	result := new(Notification)
+	endpoint := fmt.Sprintf("%s%d/", notificationsAPIEndpoint, id)
+	resp, err := n.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListJobNotifications shows the notifications sent for a job.
+func (n *NotificationsService) ListJobNotifications(jobID int, params url.Values) ([]*Notification, *ListNotificationsResponse, error) {
+	return n.list(fmt.Sprintf("%s%d/notifications/", jobAPIEndpoint, jobID), params)
+}
+
+// ListNotificationTemplateNotifications shows the notifications sent through a notification template.
+func (n *NotificationsService) ListNotificationTemplateNotifications(id int, params url.Values) ([]*Notification, *ListNotificationsResponse, error) {
+	return n.list(fmt.Sprintf("%s%d/notifications/", notificationTemplatesAPIEndpoint, id), params)
+}
+
+func (n *NotificationsService) list(endpoint string, params url.Values) ([]*Notification, *ListNotificationsResponse, error) {
+	result := new(ListNotificationsResponse)
+	resp, err := n.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
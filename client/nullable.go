@@ -0,0 +1,118 @@
+package awx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+type nullableState uint8
+
+const (
+	nullableUnset nullableState = iota
+	nullableNull
+	nullableValue
+)
+
+// Nullable represents an AWX field which may be null, e.g. an optional foreign
+// key. It tells apart a field absent from a response (unset), a null field and
+// a field holding a value, zero values included.
+//
+// A Nullable marshals to its value, or to an explicit `null` otherwise: put
+// `Null[int]()` in a data map to clear an association. It reports unset
+// fields as zero, the Nullable struct fields are tagged `omitzero` so an unset
+// field is left out instead of marshaled as `null`.
+type Nullable[T any] struct {
+	value T
+	state nullableState
+}
+
+// NewNullable returns a Nullable holding the value.
+func NewNullable[T any](value T) Nullable[T] {
+	return Nullable[T]{value: value, state: nullableValue}
+}
+
+// Null returns an explicitly null Nullable.
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{state: nullableNull}
+}
+
+// NullableFromPtr returns a Nullable holding the pointed value, null for a nil pointer.
+func NullableFromPtr[T any](value *T) Nullable[T] {
+	if value == nil {
+		return Null[T]()
+	}
+	return NewNullable(*value)
+}
+
+// Get returns the value and whether the field holds one.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.value, n.state == nullableValue
+}
+
+// Value returns the value, the zero value of T when the field is null or unset.
+func (n Nullable[T]) Value() T {
+	return n.value
+}
+
+// ValueOr returns the value, or fallback when the field is null or unset.
+func (n Nullable[T]) ValueOr(fallback T) T {
+	if n.state != nullableValue {
+		return fallback
+	}
+	return n.value
+}
+
+// Ptr returns a pointer to a copy of the value, nil when the field is null or unset.
+func (n Nullable[T]) Ptr() *T {
+	if n.state != nullableValue {
+		return nil
+	}
+	value := n.value
+	return &value
+}
+
+// Valid reports whether the field holds a value.
+func (n Nullable[T]) Valid() bool {
+	return n.state == nullableValue
+}
+
+// IsNull reports whether the field is explicitly null.
+func (n Nullable[T]) IsNull() bool {
+	return n.state == nullableNull
+}
+
+// IsZero reports whether the field is unset.
+func (n Nullable[T]) IsZero() bool {
+	return n.state == nullableUnset
+}
+
+func (n Nullable[T]) String() string {
+	if n.state != nullableValue {
+		return "null"
+	}
+	return fmt.Sprint(n.value)
+}
+
+// MarshalJSON implements json.Marshaler, an unset value marshals to `null`
+// unless its field is tagged `omitzero`.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.state != nullableValue {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		var zero T
+		n.value, n.state = zero, nullableNull
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.value); err != nil {
+		return err
+	}
+	n.state = nullableValue
+	return nil
+}
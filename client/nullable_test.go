@@ -0,0 +1,58 @@
+package awx
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullableMarshal(t *testing.T) {
+	type payload struct {
+		Inventory Nullable[int] `json:"inventory,omitzero"`
+		Project   Nullable[int] `json:"project"`
+	}
+	tests := []struct {
+		name string
+		in   payload
+		want string
+	}{
+		{"set", payload{Inventory: NewNullable(0), Project: NewNullable(3)}, `{"inventory":0,"project":3}`},
+		{"null", payload{Inventory: Null[int](), Project: Null[int]()}, `{"inventory":null,"project":null}`},
+		{"unset", payload{}, `{"project":null}`},
+		{"from nil pointer", payload{Inventory: NullableFromPtr[int](nil)}, `{"inventory":null,"project":null}`},
+	}
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.in)
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNullableRoundTrip(t *testing.T) {
+	var host Host
+	if err := json.Unmarshal([]byte(`{"id": 1, "name": "web01", "last_job": null}`), &host); err != nil {
+		t.Fatal(err)
+	}
+	if !host.LastJob.IsNull() || !host.LastJobHostSummary.IsZero() {
+		t.Errorf("host: %+v", host)
+	}
+
+	content, err := json.Marshal(host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(content, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if value, ok := fields["last_job"]; !ok || value != nil {
+		t.Errorf("last_job: %v, %t", value, ok)
+	}
+	if _, ok := fields["last_job_host_summary"]; ok {
+		t.Errorf("the unset last_job_host_summary was marshaled: %s", content)
+	}
+}
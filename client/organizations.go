@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 )
 
@@ -21,8 +23,8 @@ type ListOrganizationsResponse struct {
 const organizationsAPIEndpoint = "/api/v2/organizations/"
 
 // ListOrganizations shows list of awx organizations.
-func (p *OrganizationsService) ListOrganizations(params map[string]string) ([]*Organization, error) {
-	results, err := p.getAllPages(organizationsAPIEndpoint, params)
+func (p *OrganizationsService) ListOrganizations(params url.Values) ([]*Organization, error) {
+	results, err := listAll[Organization](context.Background(), p.client, organizationsAPIEndpoint, params)
 	if err != nil {
 		return nil, err
 	}
@@ -30,7 +32,7 @@ func (p *OrganizationsService) ListOrganizations(params map[string]string) ([]*O
 }
 
 // GetOrganizationsByID shows the details of a Organization.
-func (p *OrganizationsService) GetOrganizationsByID(id int, params map[string]string) (*Organization, error) {
+func (p *OrganizationsService) GetOrganizationsByID(id int, params url.Values) (*Organization, error) {
 	result := new(Organization)
 	endpoint := fmt.Sprintf("%s%d/", organizationsAPIEndpoint, id)
 	resp, err := p.client.Requester.GetJSON(endpoint, result, params)
@@ -45,8 +47,19 @@ func (p *OrganizationsService) GetOrganizationsByID(id int, params map[string]st
 	return result, nil
 }
 
+// GetByNamedURL shows the details of an organization by its named url identifier, see `NamedURLOrganization`.
+func (p *OrganizationsService) GetByNamedURL(identifier string, params url.Values) (*Organization, error) {
+	return getByNamedURL[Organization](p.client, organizationsAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only organization named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (p *OrganizationsService) FindByName(ctx context.Context, name string, scope Scope) (*Organization, error) {
+	return findByName[Organization](ctx, p.client, "organization", organizationsAPIEndpoint, "name", name, scope, noScope)
+}
+
 // CreateOrganization creates an awx Organization.
-func (p *OrganizationsService) CreateOrganization(data map[string]interface{}, params map[string]string) (*Organization, error) {
+func (p *OrganizationsService) CreateOrganization(data map[string]interface{}, params url.Values) (*Organization, error) {
 	mandatoryFields = []string{"name"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -54,6 +67,9 @@ func (p *OrganizationsService) CreateOrganization(data map[string]interface{}, p
 		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
 		return nil, err
 	}
+	if err := validateResource(organizationSchema, http.MethodPost, organizationsAPIEndpoint, data); err != nil {
+		return nil, err
+	}
 
 	result := new(Organization)
 	payload, err := json.Marshal(data)
@@ -73,9 +89,12 @@ func (p *OrganizationsService) CreateOrganization(data map[string]interface{}, p
 }
 
 // UpdateOrganization update an awx Organization.
-func (p *OrganizationsService) UpdateOrganization(id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
+func (p *OrganizationsService) UpdateOrganization(id int, data map[string]interface{}, params url.Values) (*Organization, error) {
 	result := new(Organization)
 	endpoint := fmt.Sprintf("%s%d", organizationsAPIEndpoint, id)
+	if err := validateResource(organizationSchema, http.MethodPatch, endpoint, data); err != nil {
+		return nil, err
+	}
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -110,27 +129,27 @@ func (p *OrganizationsService) DeleteOrganization(id int) (*Organization, error)
 }
 
 // DisAssociateGalaxyCredentials remove Credentials from an organization
-func (p *OrganizationsService) DisAssociateGalaxyCredentials(id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
+func (p *OrganizationsService) DisAssociateGalaxyCredentials(id int, data map[string]interface{}, params url.Values) (*Organization, error) {
 	return p.disAssociate(id, "galaxy_credentials", data, params)
 }
 
 // AssociateGalaxyCredentials adding credentials to Organization.
-func (p *OrganizationsService) AssociateGalaxyCredentials(id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
+func (p *OrganizationsService) AssociateGalaxyCredentials(id int, data map[string]interface{}, params url.Values) (*Organization, error) {
 	return p.associate(id, "galaxy_credentials", data, params)
 }
 
 // DisAssociateInstanceGroups remove instance_groups from an organization
-func (p *OrganizationsService) DisAssociateInstanceGroups(id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
+func (p *OrganizationsService) DisAssociateInstanceGroups(id int, data map[string]interface{}, params url.Values) (*Organization, error) {
 	return p.disAssociate(id, "instance_groups", data, params)
 }
 
 // AssociateInstanceGroups adding instance_groups to Organization.
-func (p *OrganizationsService) AssociateInstanceGroups(id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
+func (p *OrganizationsService) AssociateInstanceGroups(id int, data map[string]interface{}, params url.Values) (*Organization, error) {
 	return p.associate(id, "instance_groups", data, params)
 }
 
 // Associate associate an element to Organization.
-func (p *OrganizationsService) associate(id int, typ string, data map[string]interface{}, params map[string]string) (*Organization, error) {
+func (p *OrganizationsService) associate(id int, typ string, data map[string]interface{}, params url.Values) (*Organization, error) {
 	result := new(Organization)
 
 	endpoint := fmt.Sprintf("%s%d/%s/", organizationsAPIEndpoint, id, typ)
@@ -158,7 +177,7 @@ func (p *OrganizationsService) associate(id int, typ string, data map[string]int
 }
 
 // DisAssociate remove element from an organization
-func (p *OrganizationsService) disAssociate(id int, typ string, data map[string]interface{}, params map[string]string) (*Organization, error) {
+func (p *OrganizationsService) disAssociate(id int, typ string, data map[string]interface{}, params url.Values) (*Organization, error) {
 	result := new(Organization)
 	endpoint := fmt.Sprintf("%s%d/%s/", organizationsAPIEndpoint, id, typ)
 	data["disassociate"] = true
@@ -183,45 +202,3 @@ func (p *OrganizationsService) disAssociate(id int, typ string, data map[string]
 
 	return result, nil
 }
-
-// Must be replaced by a generic function
-// But upgrade to version go 1.18 before
-func (p *OrganizationsService) getAllPages(firstURL string, params map[string]string) ([]*Organization, error) {
-	results := make([]*Organization, 0)
-	nextURL := firstURL
-	for {
-		nextURLParsed, err := url.Parse(nextURL)
-		if err != nil {
-			return nil, err
-		}
-
-		nextURLQueryParams := make(map[string]string)
-		for paramName, paramValues := range nextURLParsed.Query() {
-			if len(paramValues) > 0 {
-				nextURLQueryParams[paramName] = paramValues[0]
-			}
-		}
-
-		for paramName, paramValue := range params {
-			nextURLQueryParams[paramName] = paramValue
-		}
-
-		result := new(ListOrganizationsResponse)
-		resp, err := p.client.Requester.GetJSON(nextURLParsed.Path, result, nextURLQueryParams)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := CheckResponse(resp); err != nil {
-			return nil, err
-		}
-
-		results = append(results, result.Results...)
-
-		if result.Next == nil || result.Next.(string) == "" {
-			break
-		}
-		nextURL = result.Next.(string)
-	}
-	return results, nil
-}
@@ -1,5 +1,7 @@
 package awx
 
+import "net/url"
+
 // PingService implements awx ping apis.
 type PingService struct {
 	client *Client
@@ -10,7 +12,7 @@ const pingAPIEndpoint = "/api/v2/ping/"
 // Ping do ping with awx servers.
 func (p *PingService) Ping() (*Ping, error) {
 	result := new(Ping)
-	resp, err := p.client.Requester.GetJSON(pingAPIEndpoint, result, map[string]string{})
+	resp, err := p.client.Requester.GetJSON(pingAPIEndpoint, result, url.Values{})
 	if err != nil {
 		return nil, err
 	}
@@ -19,5 +21,10 @@ func (p *PingService) Ping() (*Ping, error) {
 		return nil, err
 	}
 
+	// keep the server version cached for features gating
+	if version, err := ParseVersion(result.Version); err == nil {
+		p.client.Requester.version.set(version)
+	}
+
 	return result, nil
 }
@@ -2,8 +2,11 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 )
 
 // ProjectService implements awx projects apis.
@@ -20,7 +23,7 @@ type ListProjectsResponse struct {
 const projectsAPIEndpoint = "/api/v2/projects/"
 
 // ListProjects shows list of awx projects.
-func (p *ProjectService) ListProjects(params map[string]string) ([]*Project, *ListProjectsResponse, error) {
+func (p *ProjectService) ListProjects(params url.Values) ([]*Project, *ListProjectsResponse, error) {
 	result := new(ListProjectsResponse)
 	resp, err := p.client.Requester.GetJSON(projectsAPIEndpoint, result, params)
 	if err != nil {
@@ -35,7 +38,7 @@ func (p *ProjectService) ListProjects(params map[string]string) ([]*Project, *Li
 }
 
 // GetProjectByID shows the details of a project.
-func (p *ProjectService) GetProjectByID(id int, params map[string]string) (*Project, error) {
+func (p *ProjectService) GetProjectByID(id int, params url.Values) (*Project, error) {
 	result := new(Project)
 	endpoint := fmt.Sprintf("%s%d/", projectsAPIEndpoint, id)
 	resp, err := p.client.Requester.GetJSON(endpoint, result, params)
@@ -50,8 +53,19 @@ func (p *ProjectService) GetProjectByID(id int, params map[string]string) (*Proj
 	return result, nil
 }
 
+// GetByNamedURL shows the details of a project by its named url identifier, see `NamedURLProject`.
+func (p *ProjectService) GetByNamedURL(identifier string, params url.Values) (*Project, error) {
+	return getByNamedURL[Project](p.client, projectsAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only project named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (p *ProjectService) FindByName(ctx context.Context, name string, scope Scope) (*Project, error) {
+	return findByName[Project](ctx, p.client, "project", projectsAPIEndpoint, "name", name, scope, organizationScope)
+}
+
 // CreateProject creates an awx project.
-func (p *ProjectService) CreateProject(data map[string]interface{}, params map[string]string) (*Project, error) {
+func (p *ProjectService) CreateProject(data map[string]interface{}, params url.Values) (*Project, error) {
 	mandatoryFields = []string{"name", "organization", "scm_type"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -59,6 +73,9 @@ func (p *ProjectService) CreateProject(data map[string]interface{}, params map[s
 		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
 		return nil, err
 	}
+	if err := validateResource(projectSchema, http.MethodPost, projectsAPIEndpoint, data); err != nil {
+		return nil, err
+	}
 
 	result := new(Project)
 	payload, err := json.Marshal(data)
@@ -81,9 +98,12 @@ func (p *ProjectService) CreateProject(data map[string]interface{}, params map[s
 }
 
 // UpdateProject update an awx Project.
-func (p *ProjectService) UpdateProject(id int, data map[string]interface{}, params map[string]string) (*Project, error) {
+func (p *ProjectService) UpdateProject(id int, data map[string]interface{}, params url.Values) (*Project, error) {
 	result := new(Project)
 	endpoint := fmt.Sprintf("%s%d", projectsAPIEndpoint, id)
+	if err := validateResource(projectSchema, http.MethodPatch, endpoint, data); err != nil {
+		return nil, err
+	}
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,149 @@
+package awx
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Lookup represents an AWX field lookup.
+type Lookup string
+
+// Enum of AWX field lookups.
+const (
+	Exact       Lookup = ""
+	IExact      Lookup = "iexact"
+	Contains    Lookup = "contains"
+	IContains   Lookup = "icontains"
+	StartsWith  Lookup = "startswith"
+	IStartsWith Lookup = "istartswith"
+	EndsWith    Lookup = "endswith"
+	IEndsWith   Lookup = "iendswith"
+	Regex       Lookup = "regex"
+	IRegex      Lookup = "iregex"
+	GT          Lookup = "gt"
+	GTE         Lookup = "gte"
+	LT          Lookup = "lt"
+	LTE         Lookup = "lte"
+	In          Lookup = "in"
+	IsNull      Lookup = "isnull"
+	Search      Lookup = "search"
+)
+
+// Field joins related fields names into an AWX field path, e.g. `inventory__organization__name`.
+func Field(names ...string) string {
+	return strings.Join(names, "__")
+}
+
+// Query builds AWX list queries with the field lookup syntax.
+// Its `Values()` can be passed to any `List*` method.
+type Query struct {
+	values url.Values
+}
+
+// NewQuery creates an empty query.
+func NewQuery() *Query {
+	return &Query{values: url.Values{}}
+}
+
+// Filter adds a `field__lookup=value` filter.
+func (q *Query) Filter(field string, lookup Lookup, value interface{}) *Query {
+	return q.add("", field, lookup, value)
+}
+
+// Or adds an `or__field__lookup=value` filter, or filters are combined with each other.
+func (q *Query) Or(field string, lookup Lookup, value interface{}) *Query {
+	return q.add("or__", field, lookup, value)
+}
+
+// Not adds a `not__field__lookup=value` filter.
+func (q *Query) Not(field string, lookup Lookup, value interface{}) *Query {
+	return q.add("not__", field, lookup, value)
+}
+
+// Chain adds a `chain__field__lookup=value` filter, applied as a separate filter on the result.
+func (q *Query) Chain(field string, lookup Lookup, value interface{}) *Query {
+	return q.add("chain__", field, lookup, value)
+}
+
+// Search adds a full text `search=term` filter.
+func (q *Query) Search(term string) *Query {
+	q.values.Add("search", term)
+	return q
+}
+
+// OrderBy sorts the results, prefix a field with `-` for descending order.
+func (q *Query) OrderBy(fields ...string) *Query {
+	for _, field := range fields {
+		q.values.Add("order_by", field)
+	}
+	return q
+}
+
+// PageSize sets the number of results per page.
+func (q *Query) PageSize(size int) *Query {
+	q.values.Set("page_size", strconv.Itoa(size))
+	return q
+}
+
+// Page sets the requested page.
+func (q *Query) Page(page int) *Query {
+	q.values.Set("page", strconv.Itoa(page))
+	return q
+}
+
+// Set sets a raw query parameter, replacing any existing value.
+func (q *Query) Set(key, value string) *Query {
+	q.values.Set(key, value)
+	return q
+}
+
+// Values returns a copy of the query values.
+func (q *Query) Values() url.Values {
+	values := make(url.Values, len(q.values))
+	for key, value := range q.values {
+		values[key] = append([]string(nil), value...)
+	}
+	return values
+}
+
+// String returns the encoded query string.
+func (q *Query) String() string {
+	return q.values.Encode()
+}
+
+func (q *Query) add(prefix, field string, lookup Lookup, value interface{}) *Query {
+	key := prefix + field
+	if lookup != Exact {
+		key += "__" + string(lookup)
+	}
+	q.values.Add(key, formatQueryValue(value))
+	return q
+}
+
+// formatQueryValue formats a filter value, slices are joined with commas for the `in` lookup.
+func formatQueryValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		items := make([]string, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			items = append(items, formatQueryValue(rv.Index(i).Interface()))
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(value)
+}
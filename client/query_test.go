@@ -0,0 +1,70 @@
+package awx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestQueryValues(t *testing.T) {
+	query := NewQuery().
+		Filter("name", IContains, "web").
+		Or("status", Exact, "failed").
+		Or("status", Exact, "error").
+		OrderBy("-id").
+		PageSize(50)
+
+	want := url.Values{
+		"name__icontains": {"web"},
+		"or__status":      {"failed", "error"},
+		"order_by":        {"-id"},
+		"page_size":       {"50"},
+	}
+	if got := query.Values(); !reflect.DeepEqual(got, want) {
+		t.Errorf("values: %v, want %v", got, want)
+	}
+}
+
+func TestListAllKeepsQueryValues(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		queries = append(queries, query)
+		if query.Get("page") == "" {
+			// like AWX, the next link keeps the first value of each param only
+			next := url.Values{"page": {"2"}, "or__name": {query.Get("or__name")}}
+			fmt.Fprintf(w, `{"count": 2, "next": %q, "results": [{"id": 1, "name": "a"}]}`, r.URL.Path+"?"+next.Encode())
+			return
+		}
+		fmt.Fprint(w, `{"count": 2, "next": null, "results": [{"id": 2, "name": "b"}]}`)
+	}))
+	defer server.Close()
+
+	service := &OrganizationsService{client: newTestClient(server)}
+	query := NewQuery().Or("name", Exact, "a").Or("name", Exact, "b").OrderBy("id")
+	organizations, err := service.ListOrganizations(query.Values())
+	if err != nil {
+		t.Fatalf("list: %s", err)
+	}
+	if len(organizations) != 2 || organizations[0].ID != 1 || organizations[1].ID != 2 {
+		t.Errorf("organizations: %v", organizations)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("requests: %d", len(queries))
+	}
+	for i, query := range queries {
+		if got := query["or__name"]; !reflect.DeepEqual(got, []string{"a", "b"}) {
+			t.Errorf("page %d or__name: %v", i+1, got)
+		}
+		if got := query.Get("order_by"); got != "id" {
+			t.Errorf("page %d order_by: %q", i+1, got)
+		}
+	}
+	if got := queries[1].Get("page"); got != "2" {
+		t.Errorf("second page: %q", got)
+	}
+}
@@ -0,0 +1,143 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// ListResponse represents a paginated list endpoint response.
+type ListResponse[T any] struct {
+	Pagination
+	Results []*T `json:"results"`
+}
+
+// rawEndpoint prefixes paths which are not absolute api paths with `/api/v2/`.
+func rawEndpoint(path string) string {
+	if strings.HasPrefix(path, "/api/") {
+		return path
+	}
+	return "/api/v2/" + strings.TrimPrefix(path, "/")
+}
+
+// errNoClient is returned by the raw helpers for an AWX without client, e.g. a fake one.
+var errNoClient = errors.New("awx: no client, create the AWX with one of the NewAWX functions")
+
+// rawDo sends a request through the awx requester, sharing the services
+// authentication, credential refresh and error handling.
+func rawDo(ctx context.Context, c *Client, method, path string, body interface{}, result interface{}, query url.Values) error {
+	if c == nil {
+		return errNoClient
+	}
+	var payload io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		payload = bytes.NewReader(data)
+	}
+
+	ar := NewAPIRequest(method, rawEndpoint(path), payload)
+	ar.Context = ctx
+	if payload != nil {
+		ar.SetHeader("Content-Type", "application/json")
+	}
+
+	resp, err := c.Requester.Do(ar, result, query)
+	if err != nil {
+		return err
+	}
+
+	return CheckResponse(resp)
+}
+
+// Get performs a GET request on any awx endpoint and decodes the response into T.
+// Paths not starting with `/api/` are relative to `/api/v2/`.
+func Get[T any](ctx context.Context, a *AWX, path string, query url.Values) (*T, error) {
+	result := new(T)
+	if err := rawDo(ctx, a.client, "GET", path, nil, result, query); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Post performs a POST request with the JSON encoded body and decodes the response into T.
+func Post[T any](ctx context.Context, a *AWX, path string, body interface{}, query url.Values) (*T, error) {
+	result := new(T)
+	if err := rawDo(ctx, a.client, "POST", path, body, result, query); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Put performs a PUT request with the JSON encoded body and decodes the response into T.
+func Put[T any](ctx context.Context, a *AWX, path string, body interface{}, query url.Values) (*T, error) {
+	result := new(T)
+	if err := rawDo(ctx, a.client, "PUT", path, body, result, query); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Patch performs a PATCH request with the JSON encoded body and decodes the response into T.
+func Patch[T any](ctx context.Context, a *AWX, path string, body interface{}, query url.Values) (*T, error) {
+	result := new(T)
+	if err := rawDo(ctx, a.client, "PATCH", path, body, result, query); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete performs a DELETE request on any awx endpoint.
+func Delete(ctx context.Context, a *AWX, path string, query url.Values) error {
+	var content string
+	return rawDo(ctx, a.client, "DELETE", path, nil, &content, query)
+}
+
+// ListPage fetches a single page of a list endpoint.
+func ListPage[T any](ctx context.Context, a *AWX, path string, query url.Values) (*ListResponse[T], error) {
+	return Get[ListResponse[T]](ctx, a, path, query)
+}
+
+// List fetches every page of a list endpoint, following the `next` links.
+func List[T any](ctx context.Context, a *AWX, path string, query url.Values) ([]*T, error) {
+	return listAll[T](ctx, a.client, path, query)
+}
+
+func listAll[T any](ctx context.Context, c *Client, path string, query url.Values) ([]*T, error) {
+	results := make([]*T, 0)
+	nextPath, nextQuery := rawEndpoint(path), query
+	for {
+		page := new(ListResponse[T])
+		if err := rawDo(ctx, c, "GET", nextPath, nil, page, nextQuery); err != nil {
+			return nil, err
+		}
+		results = append(results, page.Results...)
+
+		next, _ := page.Next.(string)
+		if next == "" {
+			return results, nil
+		}
+
+		nextURL, err := url.Parse(next)
+		if err != nil {
+			return nil, err
+		}
+		// every value of the query is kept, the next link only moves the page
+		nextPath = nextURL.Path
+		nextQuery = url.Values{}
+		for name, values := range query {
+			nextQuery[name] = values
+		}
+		for name, values := range nextURL.Query() {
+			if _, ok := query[name]; !ok || name == "page" {
+				nextQuery[name] = values
+			}
+		}
+	}
+}
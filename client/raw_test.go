@@ -0,0 +1,141 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRawEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"instances/":            "/api/v2/instances/",
+		"/instances/":           "/api/v2/instances/",
+		"/api/v2/instances/":    "/api/v2/instances/",
+		"/api/o/applications/":  "/api/o/applications/",
+		"instances/1/health/":   "/api/v2/instances/1/health/",
+		"/api/controller/v2/x/": "/api/controller/v2/x/",
+	}
+	for in, want := range tests {
+		if got := rawEndpoint(in); got != want {
+			t.Errorf("%q: got %q, want %q", in, got, want)
+		}
+	}
+}
+
+type rawInstance struct {
+	ID       int    `json:"id"`
+	Hostname string `json:"hostname"`
+}
+
+func TestRawHelpers(t *testing.T) {
+	type request struct {
+		method, path, query, contentType, body string
+	}
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, request{r.Method, r.URL.Path, r.URL.RawQuery, r.Header.Get("Content-Type"), string(body)})
+		switch {
+		case r.URL.Path == "/api/v2/instances/404/":
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"detail": "Not found."}`)
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			io.WriteString(w, `{"id": 1, "hostname": "awx-1"}`)
+		}
+	}))
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+	ctx := context.Background()
+
+	instance, err := Get[rawInstance](ctx, a, "instances/1/", url.Values{"fields": {"id"}})
+	if err != nil || instance.Hostname != "awx-1" {
+		t.Fatalf("get: %+v, %v", instance, err)
+	}
+	if _, err := Post[rawInstance](ctx, a, "instances/", map[string]string{"hostname": "awx-1"}, nil); err != nil {
+		t.Fatalf("post: %s", err)
+	}
+	if _, err := Put[rawInstance](ctx, a, "/api/v2/instances/1/", map[string]int{"capacity": 1}, nil); err != nil {
+		t.Fatalf("put: %s", err)
+	}
+	if _, err := Patch[rawInstance](ctx, a, "instances/1/", map[string]bool{"enabled": false}, nil); err != nil {
+		t.Fatalf("patch: %s", err)
+	}
+	if err := Delete(ctx, a, "instances/1/", nil); err != nil {
+		t.Fatalf("delete: %s", err)
+	}
+
+	want := []request{
+		{"GET", "/api/v2/instances/1/", "fields=id", "", ""},
+		{"POST", "/api/v2/instances/", "", "application/json", `{"hostname":"awx-1"}`},
+		{"PUT", "/api/v2/instances/1/", "", "application/json", `{"capacity":1}`},
+		{"PATCH", "/api/v2/instances/1/", "", "application/json", `{"enabled":false}`},
+		{"DELETE", "/api/v2/instances/1/", "", "", ""},
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("requests: %+v", requests)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("request %d: %+v, want %+v", i, requests[i], want[i])
+		}
+	}
+
+	if _, err := Get[rawInstance](ctx, a, "instances/404/", nil); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected a 404 error, got %v", err)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := Get[rawInstance](canceled, a, "instances/1/", nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected a canceled error, got %v", err)
+	}
+}
+
+func TestRawList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "1"
+		}
+		response := map[string]interface{}{
+			"count":   3,
+			"results": []map[string]interface{}{{"id": int(page[0] - '0'), "hostname": "awx-" + page}},
+		}
+		if page != "3" {
+			response["next"] = fmt.Sprintf("/api/v2/instances/?page=%c&page_size=1", page[0]+1)
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+
+	first, err := ListPage[rawInstance](context.Background(), a, "instances/", url.Values{"page_size": {"1"}})
+	if err != nil {
+		t.Fatalf("list page: %s", err)
+	}
+	if first.Count != 3 || len(first.Results) != 1 || first.Next == nil {
+		t.Errorf("first page: %+v", first)
+	}
+
+	instances, err := List[rawInstance](context.Background(), a, "instances/", url.Values{"page_size": {"1"}})
+	if err != nil {
+		t.Fatalf("list: %s", err)
+	}
+	if len(instances) != 3 {
+		t.Fatalf("instances: %d, want 3", len(instances))
+	}
+	for i, instance := range instances {
+		if want := fmt.Sprintf("awx-%d", i+1); instance.Hostname != want {
+			t.Errorf("instance %d: %q, want %q", i, instance.Hostname, want)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package awx
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 )
 
 // APIRequest represents the http api communication way.
@@ -18,6 +21,7 @@ type APIRequest struct {
 	Payload  io.Reader
 	Headers  http.Header
 	Suffix   string
+	Context  context.Context
 }
 
 // SetHeader sets http header by passing k,v.
@@ -30,12 +34,12 @@ func (ar *APIRequest) SetHeader(key string, value string) *APIRequest {
 func NewAPIRequest(method string, endpoint string, payload io.Reader) *APIRequest {
 	var headers = http.Header{}
 	var suffix string
-	ar := &APIRequest{method, endpoint, payload, headers, suffix}
+	ar := &APIRequest{Method: method, Endpoint: endpoint, Payload: payload, Headers: headers, Suffix: suffix}
 	return ar
 }
 
 type Authenticator interface {
-	addAuthenticationHeaders(*http.Request)
+	addAuthenticationHeaders(*http.Request) error
 }
 
 // BasicAuth represents http basic auth.
@@ -44,8 +48,9 @@ type BasicAuth struct {
 	Password string
 }
 
-func (ba *BasicAuth) addAuthenticationHeaders(r *http.Request) {
+func (ba *BasicAuth) addAuthenticationHeaders(r *http.Request) error {
 	r.SetBasicAuth(ba.Username, ba.Password)
+	return nil
 }
 
 // TokenAuth represents token authentication
@@ -53,8 +58,9 @@ type TokenAuth struct {
 	Token string
 }
 
-func (ta *TokenAuth) addAuthenticationHeaders(r *http.Request) {
+func (ta *TokenAuth) addAuthenticationHeaders(r *http.Request) error {
 	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ta.Token))
+	return nil
 }
 
 // Requester implemented a base http client.
@@ -67,6 +73,12 @@ type Requester struct {
 	Base          string
 	Authenticator Authenticator
 	Client        *http.Client
+	// StrictDecoding reports response fields unknown to the decoded types as errors.
+	StrictDecoding bool
+
+	version serverVersion
+	dryRun  atomic.Pointer[dryRunPlan]
+	schemas atomic.Pointer[schemaCache]
 }
 
 // Do do the actual http request.
@@ -75,40 +87,88 @@ func (r *Requester) Do(ar *APIRequest, responseStruct interface{}, options ...in
 		ar.Endpoint += "/"
 	}
 
+	if err := r.version.checkEndpoint(ar.Endpoint); err != nil {
+		return nil, err
+	}
+
 	URL, err := url.Parse(r.Base + ar.Endpoint + ar.Suffix)
 	if err != nil {
 		return nil, err
 	}
 
+	querystring := make(url.Values)
 	for _, o := range options {
 		switch v := o.(type) {
-		case map[string]string:
-			querystring := make(url.Values)
+		case url.Values:
 			for key, val := range v {
-				querystring.Set(key, val)
+				querystring[key] = append(querystring[key], val...)
+			}
+		case *Query:
+			for key, val := range v.values {
+				querystring[key] = append(querystring[key], val...)
 			}
-
-			URL.RawQuery = querystring.Encode()
 		}
 	}
+	if len(querystring) > 0 {
+		URL.RawQuery = querystring.Encode()
+	}
 
-	var req *http.Request
-	req, err = http.NewRequest(ar.Method, URL.String(), ar.Payload)
-	if err != nil {
-		return nil, err
+	if schemas := r.schemas.Load(); schemas != nil && ar.Payload != nil {
+		body, err := ioutil.ReadAll(ar.Payload)
+		if err != nil {
+			return nil, err
+		}
+		ar.Payload = bytes.NewReader(body)
+		if err := r.validatePayload(schemas, ar, URL, body); err != nil {
+			return nil, err
+		}
 	}
 
-	r.Authenticator.addAuthenticationHeaders(req)
+	if plan := r.dryRun.Load(); plan != nil && isMutating(ar.Method) {
+		response, err := r.capture(plan, ar, URL)
+		if err != nil {
+			return nil, err
+		}
+		return r.readResponse(response, responseStruct)
+	}
 
-	for k := range ar.Headers {
-		req.Header.Add(k, ar.Headers.Get(k))
+	// the payload is buffered when the credential may be refreshed, so the request can be replayed
+	payload := ar.Payload
+	var body []byte
+	refresher, refreshable := r.Authenticator.(refreshableAuthenticator)
+	if refreshable && payload != nil {
+		body, err = ioutil.ReadAll(payload)
+		if err != nil {
+			return nil, err
+		}
+		payload = bytes.NewReader(body)
 	}
 
-	response, err := r.Client.Do(req)
+	response, err := r.send(ar, URL, payload)
 	if err != nil {
 		return nil, err
 	}
 
+	if response.StatusCode == http.StatusUnauthorized && refreshable {
+		changed, err := refresher.refreshAuthentication(response.Request)
+		if err != nil {
+			response.Body.Close()
+			return nil, err
+		}
+
+		// retry the failed request once with the new credential
+		if changed {
+			response.Body.Close()
+			if body != nil {
+				payload = bytes.NewReader(body)
+			}
+			response, err = r.send(ar, URL, payload)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	if response.StatusCode == 400 { // Bad Request
 		var errorString string
 
@@ -123,6 +183,11 @@ func (r *Requester) Do(ar *APIRequest, responseStruct interface{}, options ...in
 		return response, errors.New(errorString)
 	}
 
+	return r.readResponse(response, responseStruct)
+}
+
+// readResponse reads the response into `responseStruct`, raw for a `*string`, decoded from json otherwise.
+func (r *Requester) readResponse(response *http.Response, responseStruct interface{}) (*http.Response, error) {
 	switch responseStruct.(type) {
 	case *string:
 		return r.ReadRawResponse(response, responseStruct)
@@ -131,6 +196,29 @@ func (r *Requester) Do(ar *APIRequest, responseStruct interface{}, options ...in
 	}
 }
 
+// send builds the authenticated http request and sends it.
+func (r *Requester) send(ar *APIRequest, URL *url.URL, payload io.Reader) (*http.Response, error) {
+	ctx := ar.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, ar.Method, URL.String(), payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Authenticator.addAuthenticationHeaders(req); err != nil {
+		return nil, err
+	}
+
+	for k := range ar.Headers {
+		req.Header.Add(k, ar.Headers.Get(k))
+	}
+
+	return r.Client.Do(req)
+}
+
 // ReadRawResponse reads the http raw response and store it into `responseStruct`.
 func (r *Requester) ReadRawResponse(response *http.Response, responseStruct interface{}) (*http.Response, error) {
 	defer response.Body.Close()
@@ -149,22 +237,54 @@ func (r *Requester) ReadRawResponse(response *http.Response, responseStruct inte
 }
 
 // ReadJSONResponse reads the http raw response and decodes into json.
+// Decoding errors are returned for successful responses only, errors
+// responses are reported by `CheckResponse`.
 func (r *Requester) ReadJSONResponse(response *http.Response, responseStruct interface{}) (*http.Response, error) {
 	defer response.Body.Close()
 
-	json.NewDecoder(response.Body).Decode(responseStruct)
+	content, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if responseStruct == nil || len(bytes.TrimSpace(content)) == 0 {
+		return response, nil
+	}
+
+	err = json.Unmarshal(content, responseStruct)
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response, nil
+	}
+
+	endpoint := ""
+	if response.Request != nil {
+		endpoint = response.Request.URL.Path
+	}
+	if err != nil {
+		return response, fmt.Errorf("decode %s response: %w", endpoint, err)
+	}
+
+	if r.StrictDecoding {
+		fields, err := unknownFields(content, responseStruct)
+		if err != nil {
+			return response, fmt.Errorf("decode %s response: %w", endpoint, err)
+		}
+		if len(fields) > 0 {
+			return response, &UnknownFieldsError{Endpoint: endpoint, Fields: fields}
+		}
+	}
+
 	return response, nil
 }
 
 // Get performs http get request.
-func (r *Requester) Get(endpoint string, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) Get(endpoint string, responseStruct interface{}, querystring url.Values) (*http.Response, error) {
 	ar := NewAPIRequest("GET", endpoint, nil)
 	ar.Suffix = ""
 	return r.Do(ar, responseStruct, querystring)
 }
 
 // GetJSON performs http get request with json response.
-func (r *Requester) GetJSON(endpoint string, responseStruct interface{}, query map[string]string) (*http.Response, error) {
+func (r *Requester) GetJSON(endpoint string, responseStruct interface{}, query url.Values) (*http.Response, error) {
 	ar := NewAPIRequest("GET", endpoint, nil)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
@@ -172,7 +292,7 @@ func (r *Requester) GetJSON(endpoint string, responseStruct interface{}, query m
 }
 
 // Post performs http post request.
-func (r *Requester) Post(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) Post(endpoint string, payload io.Reader, responseStruct interface{}, querystring url.Values) (*http.Response, error) {
 	ar := NewAPIRequest("POST", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
@@ -180,7 +300,7 @@ func (r *Requester) Post(endpoint string, payload io.Reader, responseStruct inte
 }
 
 // PutJSON perform http PUT request with json response
-func (r *Requester) PutJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) PutJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring url.Values) (*http.Response, error) {
 	ar := NewAPIRequest("PUT", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
@@ -188,7 +308,7 @@ func (r *Requester) PutJSON(endpoint string, payload io.Reader, responseStruct i
 }
 
 // PostJSON performs http post request with json response.
-func (r *Requester) PostJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) PostJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring url.Values) (*http.Response, error) {
 	ar := NewAPIRequest("POST", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
@@ -196,7 +316,7 @@ func (r *Requester) PostJSON(endpoint string, payload io.Reader, responseStruct
 }
 
 // PatchJSON perform http patch request with json response
-func (r *Requester) PatchJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) PatchJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring url.Values) (*http.Response, error) {
 	ar := NewAPIRequest("PATCH", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
@@ -204,7 +324,7 @@ func (r *Requester) PatchJSON(endpoint string, payload io.Reader, responseStruct
 }
 
 // Delete performs http Delete request.
-func (r *Requester) Delete(endpoint string, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) Delete(endpoint string, responseStruct interface{}, querystring url.Values) (*http.Response, error) {
 	ar := NewAPIRequest("DELETE", endpoint, nil)
 	ar.Suffix = ""
 	return r.Do(ar, responseStruct, querystring)
@@ -0,0 +1,208 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// RolesService implements awx roles apis.
+type RolesService struct {
+	client *Client
+}
+
+// ListRolesResponse represents `ListRoles` endpoint response.
+type ListRolesResponse struct {
+	Pagination
+	Results []*Role `json:"results"`
+}
+
+const rolesAPIEndpoint = "/api/v2/roles/"
+
+// Principal is a user or a team roles are granted to, `*User` and `*Team` are principals.
+type Principal interface {
+	rolesEndpoint() string
+}
+
+func (u *User) rolesEndpoint() string {
+	return fmt.Sprintf("%s%d/roles/", usersAPIEndpoint, u.ID)
+}
+
+func (t *Team) rolesEndpoint() string {
+	return fmt.Sprintf("%s%d/roles/", teamsAPIEndpoint, t.ID)
+}
+
+// ListRoles shows list of awx roles.
+func (r *RolesService) ListRoles(params url.Values) ([]*Role, *ListRolesResponse, error) {
+	result := new(ListRolesResponse)
+	resp, err := r.client.Requester.GetJSON(rolesAPIEndpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// GetRoleByID shows the details of a role.
+func (r *RolesService) GetRoleByID(id int, params url.Values) (*Role, error) {
+	result := new(Role)
+	endpoint := fmt.Sprintf("%s%d/", rolesAPIEndpoint, id)
+	resp, err := r.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListRoleUsers shows the users having a role.
+func (r *RolesService) ListRoleUsers(id int, params url.Values) ([]*User, *ListUsersResponse, error) {
+	result := new(ListUsersResponse)
+	endpoint := fmt.Sprintf("%s%d/users/", rolesAPIEndpoint, id)
+	resp, err := r.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// ListRoleTeams shows the teams having a role.
+func (r *RolesService) ListRoleTeams(id int, params url.Values) ([]*Team, *ListTeamsResponse, error) {
+	result := new(ListTeamsResponse)
+	endpoint := fmt.Sprintf("%s%d/teams/", rolesAPIEndpoint, id)
+	resp, err := r.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// Grant gives a role of a resource to a user or a team. The role is named
+// after the resource `summary_fields.object_roles`, with or without the
+// `_role` suffix, e.g. "execute", "admin" or "use_role".
+//
+// The resource is any resource type, e.g. `*JobTemplate` or `*Inventory`,
+// its object roles are read from its summary fields when decoded, fetched
+// from its `URL` otherwise. A `*ObjectRoles` is accepted as well.
+func (r *RolesService) Grant(ctx context.Context, principal Principal, resource interface{}, role string) error {
+	return r.setRole(ctx, principal, resource, role, false)
+}
+
+// Revoke removes a role of a resource from a user or a team, see `Grant`.
+func (r *RolesService) Revoke(ctx context.Context, principal Principal, resource interface{}, role string) error {
+	return r.setRole(ctx, principal, resource, role, true)
+}
+
+func (r *RolesService) setRole(ctx context.Context, principal Principal, resource interface{}, role string, revoke bool) error {
+	id, err := r.resolveRole(ctx, resource, role)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{"id": id}
+	if revoke {
+		data["disassociate"] = true
+	}
+	return rawDo(ctx, r.client, "POST", principal.rolesEndpoint(), data, nil, nil)
+}
+
+// resolveRole returns the ID of a role of a resource.
+func (r *RolesService) resolveRole(ctx context.Context, resource interface{}, role string) (int, error) {
+	field := roleField(role)
+	notFound := &LookupError{Resource: "role", Name: field, Err: ErrNotFound}
+
+	if objectRoles, ok := resource.(*ObjectRoles); ok {
+		if id, ok := objectRoleID(objectRoles, field); ok {
+			return id, nil
+		}
+		return 0, notFound
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(resource))
+	if value.Kind() != reflect.Struct {
+		return 0, fmt.Errorf("resolve role %s: unsupported resource %T", field, resource)
+	}
+	summary, _ := structField(value, "SummaryFields").(*Summary)
+	if summary != nil {
+		if id, ok := objectRoleID(summary.ObjectRoles, field); ok {
+			return id, nil
+		}
+	}
+
+	resourceURL, _ := structField(value, "URL").(string)
+	if resourceURL == "" {
+		if summary != nil && summary.ObjectRoles != nil {
+			return 0, notFound
+		}
+		return 0, fmt.Errorf("resolve role %s: %T has neither object roles nor url", field, resource)
+	}
+	result := new(struct {
+		SummaryFields struct {
+			ObjectRoles map[string]*ApplyRole `json:"object_roles"`
+		} `json:"summary_fields"`
+	})
+	if err := rawDo(ctx, r.client, "GET", resourceURL, nil, result, nil); err != nil {
+		return 0, err
+	}
+	if objectRole := result.SummaryFields.ObjectRoles[field]; objectRole != nil {
+		return objectRole.ID, nil
+	}
+	return 0, notFound
+}
+
+// structField returns the value of an exported struct field, nil when it doesn't exist.
+func structField(value reflect.Value, name string) interface{} {
+	field := value.FieldByName(name)
+	if !field.IsValid() || !field.CanInterface() {
+		return nil
+	}
+	return field.Interface()
+}
+
+// roleField returns the object roles field of a role name, e.g. "execute_role" for "Execute".
+func roleField(role string) string {
+	field := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(role)), " ", "_")
+	if !strings.HasSuffix(field, "_role") {
+		field += "_role"
+	}
+	return field
+}
+
+// objectRoleID looks a role up in the object roles by its json field name.
+func objectRoleID(objectRoles *ObjectRoles, field string) (int, bool) {
+	if objectRoles == nil {
+		return 0, false
+	}
+	value := reflect.ValueOf(objectRoles).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		tag := strings.Split(value.Type().Field(i).Tag.Get("json"), ",")[0]
+		if tag != field {
+			continue
+		}
+		if objectRole, ok := value.Field(i).Interface().(*ApplyRole); ok && objectRole != nil {
+			return objectRole.ID, true
+		}
+		return 0, false
+	}
+	return 0, false
+}
@@ -0,0 +1,137 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRoleField(t *testing.T) {
+	tests := map[string]string{
+		"execute":                     "execute_role",
+		"Execute":                     "execute_role",
+		"use_role":                    "use_role",
+		" Job Template Admin ":        "job_template_admin_role",
+		"execution_environment_admin": "execution_environment_admin_role",
+	}
+	for in, want := range tests {
+		if got := roleField(in); got != want {
+			t.Errorf("%q: got %q, want %q", in, got, want)
+		}
+	}
+}
+
+// rolesServer serves the object roles of the inventory 3 and records the role requests.
+type rolesServer struct {
+	gets   []string
+	posts  []string
+	bodies []map[string]interface{}
+}
+
+func (s *rolesServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPost {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		s.posts = append(s.posts, r.URL.Path)
+		s.bodies = append(s.bodies, body)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	s.gets = append(s.gets, r.URL.Path)
+	if r.URL.Path != inventoriesAPIEndpoint+"3/" {
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"detail": "Not found."}`)
+		return
+	}
+	io.WriteString(w, `{"id": 3, "summary_fields": {"object_roles": {
+		"admin_role": {"id": 30, "name": "Admin"},
+		"adhoc_role": {"id": 31, "name": "Ad Hoc"},
+		"custom_viewer_role": {"id": 39, "name": "Viewer"}}}}`)
+}
+
+func TestResolveRole(t *testing.T) {
+	handler := &rolesServer{}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	roles := &RolesService{client: newTestClient(server)}
+	ctx := context.Background()
+
+	decoded := &JobTemplate{URL: jobTemplateAPIEndpoint + "5/", SummaryFields: &Summary{ObjectRoles: &ObjectRoles{
+		ExecuteRole: &ApplyRole{ID: 50},
+		AdminRole:   &ApplyRole{ID: 51},
+	}}}
+	tests := []struct {
+		name     string
+		resource interface{}
+		role     string
+		want     int
+		notFound bool
+		fails    bool
+	}{
+		{name: "decoded summary fields", resource: decoded, role: "execute", want: 50},
+		{name: "struct value", resource: *decoded, role: "Admin", want: 51},
+		{name: "object roles", resource: &ObjectRoles{UseRole: &ApplyRole{ID: 60}}, role: "use_role", want: 60},
+		{name: "object roles without the role", resource: &ObjectRoles{UseRole: &ApplyRole{ID: 60}}, role: "admin", notFound: true},
+		{name: "fetched from the url", resource: &Inventory{URL: inventoriesAPIEndpoint + "3/"}, role: "adhoc", want: 31},
+		{name: "fetched role missing from ObjectRoles", resource: &Inventory{URL: inventoriesAPIEndpoint + "3/", SummaryFields: &Summary{ObjectRoles: &ObjectRoles{}}}, role: "custom_viewer", want: 39},
+		{name: "fetched without the role", resource: &Inventory{URL: inventoriesAPIEndpoint + "3/"}, role: "execute", notFound: true},
+		{name: "decoded without the role nor url", resource: &Inventory{SummaryFields: &Summary{ObjectRoles: &ObjectRoles{}}}, role: "use", notFound: true},
+		{name: "neither object roles nor url", resource: &Inventory{ID: 3}, role: "use", fails: true},
+		{name: "unsupported resource", resource: 3, role: "use", fails: true},
+		{name: "deleted resource", resource: &Inventory{URL: inventoriesAPIEndpoint + "4/"}, role: "use", fails: true},
+	}
+	for _, tt := range tests {
+		id, err := roles.resolveRole(ctx, tt.resource, tt.role)
+		switch {
+		case tt.notFound:
+			var lookupErr *LookupError
+			if !errors.Is(err, ErrNotFound) || !errors.As(err, &lookupErr) || lookupErr.Resource != "role" {
+				t.Errorf("%s: expected a role not found error, got %d, %v", tt.name, id, err)
+			}
+		case tt.fails:
+			if err == nil || errors.Is(err, ErrNotFound) {
+				t.Errorf("%s: expected an error, got %d, %v", tt.name, id, err)
+			}
+		case err != nil:
+			t.Errorf("%s: %s", tt.name, err)
+		case id != tt.want:
+			t.Errorf("%s: got %d, want %d", tt.name, id, tt.want)
+		}
+	}
+	for _, path := range handler.gets {
+		if strings.HasPrefix(path, jobTemplateAPIEndpoint) {
+			t.Errorf("the decoded object roles were fetched again: %s", path)
+		}
+	}
+}
+
+func TestGrantRevoke(t *testing.T) {
+	handler := &rolesServer{}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	roles := &RolesService{client: newTestClient(server)}
+	ctx := context.Background()
+	inventory := &Inventory{URL: inventoriesAPIEndpoint + "3/"}
+
+	if err := roles.Grant(ctx, &User{ID: 2}, inventory, "admin"); err != nil {
+		t.Fatalf("grant: %s", err)
+	}
+	if err := roles.Revoke(ctx, &Team{ID: 7}, inventory, "adhoc_role"); err != nil {
+		t.Fatalf("revoke: %s", err)
+	}
+	if err := roles.Grant(ctx, &Team{ID: 7}, inventory, "execute"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	wantPosts := []string{usersAPIEndpoint + "2/roles/", teamsAPIEndpoint + "7/roles/"}
+	wantBodies := []map[string]interface{}{{"id": float64(30)}, {"id": float64(31), "disassociate": true}}
+	if !reflect.DeepEqual(handler.posts, wantPosts) || !reflect.DeepEqual(handler.bodies, wantBodies) {
+		t.Errorf("requests: %v %v, want %v %v", handler.posts, handler.bodies, wantPosts, wantBodies)
+	}
+}
@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // SchedulesService implements awx projects apis.
@@ -19,7 +21,7 @@ type ListSchedulesResponse struct {
 
 const schedulesAPIEndpoint = "/api/v2/schedules/"
 
-func (s *SchedulesService) List(params map[string]string) ([]*Schedule, *ListSchedulesResponse, error) {
+func (s *SchedulesService) List(params url.Values) ([]*Schedule, *ListSchedulesResponse, error) {
 	result := new(ListSchedulesResponse)
 	resp, err := s.client.Requester.GetJSON(schedulesAPIEndpoint, result, params)
 	if err != nil {
@@ -34,7 +36,7 @@ func (s *SchedulesService) List(params map[string]string) ([]*Schedule, *ListSch
 }
 
 // GetByID shows the details of a schedule.
-func (s *SchedulesService) GetByID(id int, params map[string]string) (*Schedule, error) {
+func (s *SchedulesService) GetByID(id int, params url.Values) (*Schedule, error) {
 	result := new(Schedule)
 	endpoint := fmt.Sprintf("%s%d/", schedulesAPIEndpoint, id)
 	resp, err := s.client.Requester.GetJSON(endpoint, result, params)
@@ -49,8 +51,20 @@ func (s *SchedulesService) GetByID(id int, params map[string]string) (*Schedule,
 	return result, nil
 }
 
+// GetByNamedURL shows the details of a schedule by its named url identifier,
+// as found in the `named_url` of its related fields.
+func (s *SchedulesService) GetByNamedURL(identifier string, params url.Values) (*Schedule, error) {
+	return getByNamedURL[Schedule](s.client, schedulesAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only schedule named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (s *SchedulesService) FindByName(ctx context.Context, name string, scope Scope) (*Schedule, error) {
+	return findByName[Schedule](ctx, s.client, "schedule", schedulesAPIEndpoint, "name", name, scope, scheduleScope)
+}
+
 // Create creates an awx schedule.
-func (s *SchedulesService) Create(data map[string]interface{}, params map[string]string) (*Schedule, error) {
+func (s *SchedulesService) Create(data map[string]interface{}, params url.Values) (*Schedule, error) {
 	mandatoryFields = []string{"name", "rrule", "unified_job_template"}
 	validate, status := ValidateParams(data, mandatoryFields)
 	if !status {
@@ -77,7 +91,7 @@ func (s *SchedulesService) Create(data map[string]interface{}, params map[string
 }
 
 // Update update an awx schedule.
-func (s *SchedulesService) Update(id int, data map[string]interface{}, params map[string]string) (*Schedule, error) {
+func (s *SchedulesService) Update(id int, data map[string]interface{}, params url.Values) (*Schedule, error) {
 	result := new(Schedule)
 	endpoint := fmt.Sprintf("%s%d", schedulesAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -0,0 +1,377 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Enum of the field error codes.
+const (
+	FieldRequired  = "required"
+	FieldReadOnly  = "read_only"
+	FieldType      = "type"
+	FieldChoice    = "choice"
+	FieldMaxLength = "max_length"
+	FieldMinValue  = "min_value"
+	FieldMaxValue  = "max_value"
+)
+
+// FieldError is a payload field violating the endpoint schema.
+type FieldError struct {
+	Field   string
+	Code    string
+	Message string
+}
+
+func (e FieldError) String() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationError is returned, before any request is sent, when a payload
+// violates the schema AWX publishes for the endpoint.
+type ValidationError struct {
+	Method   string
+	Endpoint string
+	Errors   []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	details := make([]string, 0, len(e.Errors))
+	for _, fieldError := range e.Errors {
+		details = append(details, fieldError.String())
+	}
+	return fmt.Sprintf("invalid %s %s payload: %s", e.Method, e.Endpoint, strings.Join(details, "; "))
+}
+
+// SetSchemaValidation enables or disables the validation of the POST, PUT and
+// PATCH payloads against the schema of the endpoint `OPTIONS` metadata. The
+// schema is fetched on the first request of an endpoint and cached for the life
+// of the client, endpoints without schema are not validated. A schema which
+// couldn't be fetched is fetched again on the next request of its endpoint.
+func (a *AWX) SetSchemaValidation(enabled bool) {
+	if a.client == nil {
+		return
+	}
+	if enabled {
+		a.client.Requester.schemas.CompareAndSwap(nil, &schemaCache{schemas: map[string]map[string]schema{}, fetches: map[string]*schemaFetch{}})
+	} else {
+		a.client.Requester.schemas.Store(nil)
+	}
+}
+
+// schemaField is the metadata of a field in an OPTIONS action.
+type schemaField struct {
+	Type      string          `json:"type"`
+	Required  bool            `json:"required"`
+	ReadOnly  bool            `json:"read_only"`
+	Choices   [][]interface{} `json:"choices"`
+	MaxLength *int            `json:"max_length"`
+	MinValue  *float64        `json:"min_value"`
+	MaxValue  *float64        `json:"max_value"`
+}
+
+// schema holds the fields of an OPTIONS action.
+type schema map[string]*schemaField
+
+// schemaCache caches the OPTIONS actions by endpoint.
+type schemaCache struct {
+	mu      sync.Mutex
+	schemas map[string]map[string]schema
+	fetches map[string]*schemaFetch
+}
+
+// schemaFetch is an OPTIONS request in flight, its actions are set once done is closed.
+type schemaFetch struct {
+	done    chan struct{}
+	actions map[string]schema
+}
+
+// maxLength returns the max length of a generated schema field.
+func maxLength(n int) *int {
+	return &n
+}
+
+// validateResource checks a payload against a schema generated from the
+// committed OPTIONS metadata, see types_generated.go: the required fields of
+// the POST payloads, and the max lengths.
+func validateResource(fields schema, method, endpoint string, data map[string]interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return err
+	}
+	errs := fields.validate(decoded, nil, method == http.MethodPost)
+	if len(errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Method: method, Endpoint: endpoint, Errors: errs}
+}
+
+// idSegment matches the resources ids in endpoints, the detail endpoints share their schema.
+var idSegment = regexp.MustCompile(`/\d+/`)
+
+// validatePayload checks a payload against the schema of its endpoint.
+func (r *Requester) validatePayload(schemas *schemaCache, ar *APIRequest, URL *url.URL, body []byte) error {
+	method := strings.ToUpper(ar.Method)
+	if method != http.MethodPost && method != http.MethodPut && method != http.MethodPatch {
+		return nil
+	}
+
+	var payload map[string]interface{}
+	if len(bytes.TrimSpace(body)) == 0 || json.Unmarshal(body, &payload) != nil {
+		return nil
+	}
+	// association requests on sub lists, e.g. `{"id": 3}`, don't follow the creation schema
+	if _, ok := payload["id"]; ok && method == http.MethodPost {
+		return nil
+	}
+
+	action := method
+	if method == http.MethodPatch {
+		action = http.MethodPut
+	}
+	actions := schemas.get(r, ar, URL)
+	fields := actions[action]
+	if fields == nil {
+		return nil
+	}
+
+	errs := fields.validate(payload, actions[http.MethodGet], method != http.MethodPatch)
+	if len(errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Method: method, Endpoint: URL.Path, Errors: errs}
+}
+
+// get returns the actions of the endpoint, fetched on first use. The requests
+// of an endpoint share its fetch, the lock is not held while fetching and the
+// failed fetches are not cached.
+func (c *schemaCache) get(r *Requester, ar *APIRequest, URL *url.URL) map[string]schema {
+	key := idSegment.ReplaceAllString(URL.Path, "/{id}/")
+	// the ids may follow each other, e.g. `/roles/1/users/2/`
+	key = idSegment.ReplaceAllString(key, "/{id}/")
+
+	c.mu.Lock()
+	if actions, ok := c.schemas[key]; ok {
+		c.mu.Unlock()
+		return actions
+	}
+	if fetch, ok := c.fetches[key]; ok {
+		c.mu.Unlock()
+		ctx := ar.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		select {
+		case <-fetch.done:
+			return fetch.actions
+		case <-ctx.Done():
+			return nil
+		}
+	}
+	fetch := &schemaFetch{done: make(chan struct{})}
+	c.fetches[key] = fetch
+	c.mu.Unlock()
+
+	actions, err := fetchSchema(r, ar, URL)
+	fetch.actions = actions
+
+	c.mu.Lock()
+	delete(c.fetches, key)
+	if err == nil {
+		c.schemas[key] = actions
+	}
+	c.mu.Unlock()
+	close(fetch.done)
+	return actions
+}
+
+// fetchSchema reads the OPTIONS actions of an endpoint, nil without error when
+// the endpoint has no metadata.
+func fetchSchema(r *Requester, ar *APIRequest, URL *url.URL) (map[string]schema, error) {
+	optionsURL := *URL
+	optionsURL.RawQuery = ""
+	options := &APIRequest{Method: http.MethodOptions, Endpoint: ar.Endpoint, Headers: http.Header{}, Context: ar.Context}
+
+	response, err := r.send(options, &optionsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	switch {
+	case response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusMethodNotAllowed:
+		return nil, nil
+	case response.StatusCode < 200 || response.StatusCode >= 300:
+		return nil, fmt.Errorf("OPTIONS %s: %s", optionsURL.Path, response.Status)
+	}
+
+	content, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	var metadata struct {
+		Actions map[string]schema `json:"actions"`
+	}
+	if err := json.Unmarshal(content, &metadata); err != nil {
+		return nil, err
+	}
+	return metadata.Actions, nil
+}
+
+// validate checks the payload fields, the required fields are checked when complete is set.
+// AWX leaves the read-only fields out of the write actions, the fields only
+// readable through the GET action are read-only.
+func (s schema) validate(payload map[string]interface{}, readable schema, complete bool) []FieldError {
+	var errs []FieldError
+
+	if complete {
+		for name, field := range s {
+			if !field.Required || field.ReadOnly {
+				continue
+			}
+			if value, ok := payload[name]; !ok || value == nil {
+				errs = append(errs, FieldError{Field: name, Code: FieldRequired, Message: "is required"})
+			}
+		}
+	}
+
+	for name, value := range payload {
+		field, ok := s[name]
+		if !ok {
+			if _, ok := readable[name]; ok {
+				errs = append(errs, FieldError{Field: name, Code: FieldReadOnly, Message: "is read-only"})
+			}
+			continue
+		}
+		if field.ReadOnly {
+			errs = append(errs, FieldError{Field: name, Code: FieldReadOnly, Message: "is read-only"})
+			continue
+		}
+		if value == nil {
+			continue
+		}
+		if fieldError, ok := field.check(value); !ok {
+			fieldError.Field = name
+			errs = append(errs, fieldError)
+		}
+	}
+
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Field < errs[j].Field })
+	return errs
+}
+
+// check validates a non null value against the field metadata.
+func (f *schemaField) check(value interface{}) (FieldError, bool) {
+	invalidType := FieldError{Code: FieldType, Message: fmt.Sprintf("expecting %s but got %s", f.Type, jsonType(value))}
+
+	switch f.Type {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return invalidType, false
+		}
+		if f.MaxLength != nil && utf8.RuneCountInString(s) > *f.MaxLength {
+			return FieldError{Code: FieldMaxLength, Message: fmt.Sprintf("is longer than %d characters", *f.MaxLength)}, false
+		}
+	case "integer", "id":
+		n, ok := value.(float64)
+		if !ok || n != math.Trunc(n) {
+			return invalidType, false
+		}
+		return f.checkRange(n)
+	case "float", "decimal":
+		n, ok := value.(float64)
+		if !ok {
+			return invalidType, false
+		}
+		return f.checkRange(n)
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return invalidType, false
+		}
+	case "datetime":
+		s, ok := value.(string)
+		if !ok {
+			return invalidType, false
+		}
+		if _, err := time.Parse(time.RFC3339, s); err != nil {
+			return FieldError{Code: FieldType, Message: fmt.Sprintf("is not a RFC 3339 datetime: %q", s)}, false
+		}
+	case "list":
+		if _, ok := value.([]interface{}); !ok {
+			return invalidType, false
+		}
+	case "choice":
+		return f.checkChoice(value)
+	case "multiple choice":
+		items, ok := value.([]interface{})
+		if !ok {
+			return invalidType, false
+		}
+		for _, item := range items {
+			if fieldError, ok := f.checkChoice(item); !ok {
+				return fieldError, false
+			}
+		}
+	}
+	return FieldError{}, true
+}
+
+func (f *schemaField) checkRange(n float64) (FieldError, bool) {
+	if f.MinValue != nil && n < *f.MinValue {
+		return FieldError{Code: FieldMinValue, Message: fmt.Sprintf("is lower than %v", *f.MinValue)}, false
+	}
+	if f.MaxValue != nil && n > *f.MaxValue {
+		return FieldError{Code: FieldMaxValue, Message: fmt.Sprintf("is greater than %v", *f.MaxValue)}, false
+	}
+	return FieldError{}, true
+}
+
+// checkChoice validates a choice, dynamic choices published empty are not checked.
+func (f *schemaField) checkChoice(value interface{}) (FieldError, bool) {
+	if len(f.Choices) == 0 {
+		return FieldError{}, true
+	}
+	values := make([]string, 0, len(f.Choices))
+	for _, choice := range f.Choices {
+		if len(choice) == 0 {
+			continue
+		}
+		if fmt.Sprint(choice[0]) == fmt.Sprint(value) {
+			return FieldError{}, true
+		}
+		values = append(values, fmt.Sprint(choice[0]))
+	}
+	return FieldError{Code: FieldChoice, Message: fmt.Sprintf("%v is not one of %s", value, strings.Join(values, ", "))}, false
+}
+
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
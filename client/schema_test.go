@@ -0,0 +1,261 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSchemaValidate(t *testing.T) {
+	content, err := os.ReadFile("../internal/gen/testdata/options/job_templates.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var metadata struct {
+		Actions map[string]schema `json:"actions"`
+	}
+	if err := json.Unmarshal(content, &metadata); err != nil {
+		t.Fatal(err)
+	}
+	post, get := metadata.Actions["POST"], metadata.Actions["GET"]
+
+	payload := map[string]interface{}{
+		"job_type":  "deploy",
+		"project":   "project_01",
+		"verbosity": float64(7),
+		"forks":     float64(-1),
+		"status":    "new",
+	}
+	expected := []FieldError{
+		{Field: "forks", Code: FieldMinValue},
+		{Field: "job_type", Code: FieldChoice},
+		{Field: "name", Code: FieldRequired},
+		{Field: "project", Code: FieldType},
+		{Field: "status", Code: FieldReadOnly},
+		{Field: "verbosity", Code: FieldChoice},
+	}
+
+	errs := post.validate(payload, get, true)
+	if len(errs) != len(expected) {
+		t.Fatalf("Expecting %d errors but got %v", len(expected), errs)
+	}
+	for i, fieldError := range errs {
+		if fieldError.Field != expected[i].Field || fieldError.Code != expected[i].Code {
+			t.Errorf("Expecting %s %s but got %s %s", expected[i].Field, expected[i].Code, fieldError.Field, fieldError.Code)
+		}
+	}
+
+	valid := map[string]interface{}{"name": "job_template_01", "job_type": "check", "inventory": float64(1), "verbosity": float64(2)}
+	if errs := post.validate(valid, get, true); len(errs) != 0 {
+		t.Errorf("Expecting no error but got %v", errs)
+	}
+}
+
+// optionsServer serves the job templates OPTIONS metadata, answers the other
+// OPTIONS requests with its status and accepts the other requests.
+type optionsServer struct {
+	mu       sync.Mutex
+	metadata []byte
+	status   int
+	release  chan struct{}
+	options  map[string]int
+	writes   int
+}
+
+func (s *optionsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions {
+		s.mu.Lock()
+		s.writes++
+		s.mu.Unlock()
+		io.WriteString(w, `{"id": 1}`)
+		return
+	}
+
+	s.mu.Lock()
+	s.options[r.URL.Path]++
+	status, release := s.status, s.release
+	s.mu.Unlock()
+	if r.URL.Path != jobTemplateAPIEndpoint {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if release != nil {
+		<-release
+	}
+	if status != http.StatusOK {
+		w.WriteHeader(status)
+		return
+	}
+	w.Write(s.metadata)
+}
+
+func (s *optionsServer) requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.options[path]
+}
+
+func newOptionsServer(t *testing.T) (*optionsServer, *httptest.Server, *AWX) {
+	metadata, err := os.ReadFile("../internal/gen/testdata/options/job_templates.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := &optionsServer{metadata: metadata, status: http.StatusOK, options: map[string]int{}}
+	server := httptest.NewServer(handler)
+	a := newAWX(newTestClient(server))
+	a.SetSchemaValidation(true)
+	return handler, server, a
+}
+
+func createTemplate(a *AWX, verbosity int) error {
+	_, err := Post[map[string]interface{}](context.Background(), a, jobTemplateAPIEndpoint, map[string]interface{}{"name": "deploy", "verbosity": verbosity}, nil)
+	return err
+}
+
+func TestValidateResource(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		io.WriteString(w, `{"id": 4, "name": "playbooks"}`)
+	}))
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+	long := strings.Repeat("x", 513)
+
+	tests := []struct {
+		name    string
+		call    func() error
+		invalid string
+	}{
+		{
+			name: "create",
+			call: func() error {
+				_, err := a.ProjectService.CreateProject(map[string]interface{}{"name": "playbooks", "organization": 1, "scm_type": "git"}, url.Values{})
+				return err
+			},
+		},
+		{
+			name: "create too long",
+			call: func() error {
+				_, err := a.ProjectService.CreateProject(map[string]interface{}{"name": long, "organization": 1, "scm_type": "git"}, url.Values{})
+				return err
+			},
+			invalid: "name",
+		},
+		{
+			name: "create null required",
+			call: func() error {
+				_, err := a.InventoriesService.CreateInventory(map[string]interface{}{"name": "prod", "organization": Null[int]()}, url.Values{})
+				return err
+			},
+			invalid: "organization",
+		},
+		{
+			name: "update too long",
+			call: func() error {
+				_, err := a.JobTemplateService.UpdateJobTemplate(5, map[string]interface{}{"job_tags": strings.Repeat("x", 1025)}, url.Values{})
+				return err
+			},
+			invalid: "job_tags",
+		},
+		{
+			name: "update without required",
+			call: func() error {
+				_, err := a.WorkflowJobTemplateNodeService.UpdateWorkflowJobTemplateNode(9, map[string]interface{}{"identifier": "approve"}, url.Values{})
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		requests = 0
+		err := tt.call()
+		if tt.invalid == "" {
+			if err != nil || requests != 1 {
+				t.Errorf("%s: %v, %d requests", tt.name, err, requests)
+			}
+			continue
+		}
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || len(validationErr.Errors) != 1 || validationErr.Errors[0].Field != tt.invalid {
+			t.Errorf("%s: expected a %s validation error, got %v", tt.name, tt.invalid, err)
+		}
+		if requests != 0 {
+			t.Errorf("%s: the invalid payload was sent", tt.name)
+		}
+	}
+}
+
+func TestSchemaCacheFailure(t *testing.T) {
+	handler, server, a := newOptionsServer(t)
+	defer server.Close()
+	path := jobTemplateAPIEndpoint
+
+	// the schema can't be read, the payload isn't validated and the failure isn't cached
+	handler.status = http.StatusBadGateway
+	if err := createTemplate(a, 7); err != nil {
+		t.Fatalf("creation without schema: %s", err)
+	}
+	handler.status = http.StatusOK
+	var validationErr *ValidationError
+	if err := createTemplate(a, 7); !errors.As(err, &validationErr) || validationErr.Errors[0].Field != "verbosity" {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if err := createTemplate(a, 2); err != nil {
+		t.Fatalf("valid creation: %s", err)
+	}
+	if got := handler.requests(path); got != 2 {
+		t.Errorf("OPTIONS requests: %d, want 2", got)
+	}
+	if handler.writes != 2 {
+		t.Errorf("writes: %d, want 2", handler.writes)
+	}
+
+	// an endpoint without metadata is cached
+	for i := 0; i < 2; i++ {
+		if _, err := Patch[map[string]interface{}](context.Background(), a, "projects/1/", map[string]interface{}{"name": "p"}, nil); err != nil {
+			t.Fatalf("patch: %s", err)
+		}
+	}
+	if got := handler.requests(projectsAPIEndpoint + "1/"); got != 1 {
+		t.Errorf("OPTIONS requests without metadata: %d, want 1", got)
+	}
+}
+
+func TestSchemaCacheConcurrentFetch(t *testing.T) {
+	handler, server, a := newOptionsServer(t)
+	defer server.Close()
+	handler.release = make(chan struct{})
+
+	errs := make(chan error, 5)
+	for i := 0; i < cap(errs); i++ {
+		go func() { errs <- createTemplate(a, 7) }()
+	}
+
+	// the other endpoints aren't blocked by the fetch in flight
+	for handler.requests(jobTemplateAPIEndpoint) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	if _, err := Patch[map[string]interface{}](context.Background(), a, "projects/1/", map[string]interface{}{"name": "p"}, nil); err != nil {
+		t.Fatalf("patch: %s", err)
+	}
+
+	close(handler.release)
+	for i := 0; i < cap(errs); i++ {
+		var validationErr *ValidationError
+		if err := <-errs; !errors.As(err, &validationErr) {
+			t.Errorf("expected a validation error, got %v", err)
+		}
+	}
+	if got := handler.requests(jobTemplateAPIEndpoint); got != 1 {
+		t.Errorf("OPTIONS requests: %d, want 1", got)
+	}
+}
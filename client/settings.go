@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // SettingService implements awx settings apis.
@@ -20,7 +21,7 @@ type ListSettingsResponse struct {
 const settingsAPIEndpoint = "/api/v2/settings/"
 
 // ListSettings shows list of awx settings.
-func (p *SettingService) ListSettings(params map[string]string) ([]*SettingSummary, *ListSettingsResponse, error) {
+func (p *SettingService) ListSettings(params url.Values) ([]*SettingSummary, *ListSettingsResponse, error) {
 	result := new(ListSettingsResponse)
 	resp, err := p.client.Requester.GetJSON(settingsAPIEndpoint, result, params)
 	if err != nil {
@@ -35,7 +36,7 @@ func (p *SettingService) ListSettings(params map[string]string) ([]*SettingSumma
 }
 
 // GetSettingById shows the details of a setting.
-func (p *SettingService) GetSettingsBySlug(slug string, params map[string]string) (*Setting, error) {
+func (p *SettingService) GetSettingsBySlug(slug string, params url.Values) (*Setting, error) {
 	result := new(Setting)
 	endpoint := fmt.Sprintf("%s%s/", settingsAPIEndpoint, slug)
 	resp, err := p.client.Requester.GetJSON(endpoint, result, params)
@@ -51,7 +52,7 @@ func (p *SettingService) GetSettingsBySlug(slug string, params map[string]string
 }
 
 // UpdateSetting update an awx Setting.
-func (p *SettingService) UpdateSettings(slug string, data map[string]interface{}, params map[string]string) (*Setting, error) {
+func (p *SettingService) UpdateSettings(slug string, data map[string]interface{}, params url.Values) (*Setting, error) {
 	result := new(Setting)
 	endpoint := fmt.Sprintf("%s%s", settingsAPIEndpoint, slug)
 	payload, err := json.Marshal(data)
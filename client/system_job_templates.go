@@ -0,0 +1,151 @@
+package awx
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Enum of the system job types, the maintenance jobs of AWX.
+const (
+	SystemJobCleanupJobs           = "cleanup_jobs"
+	SystemJobCleanupActivityStream = "cleanup_activitystream"
+	SystemJobCleanupSessions       = "cleanup_sessions"
+	SystemJobCleanupTokens         = "cleanup_tokens"
+)
+
+// SystemJobTemplatesService implements awx system job templates apis.
+type SystemJobTemplatesService struct {
+	client *Client
+}
+
+// ListSystemJobTemplatesResponse represents `ListSystemJobTemplates` endpoint response.
+type ListSystemJobTemplatesResponse struct {
+	Pagination
+	Results []*SystemJobTemplate `json:"results"`
+}
+
+const systemJobTemplatesAPIEndpoint = "/api/v2/system_job_templates/"
+
+// ListSystemJobTemplates shows list of awx system job templates.
+func (s *SystemJobTemplatesService) ListSystemJobTemplates(params url.Values) ([]*SystemJobTemplate, *ListSystemJobTemplatesResponse, error) {
+	result := new(ListSystemJobTemplatesResponse)
+	resp, err := s.client.Requester.GetJSON(systemJobTemplatesAPIEndpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// GetSystemJobTemplateByID shows the details of a system job template.
+func (s *SystemJobTemplatesService) GetSystemJobTemplateByID(id int, params url.Values) (*SystemJobTemplate, error) {
+	result := new(SystemJobTemplate)
+	endpoint := fmt.Sprintf("%s%d/", systemJobTemplatesAPIEndpoint, id)
+	resp, err := s.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Launch launches a system job with the system job template.
+func (s *SystemJobTemplatesService) Launch(id int, data map[string]interface{}, params url.Values) (*SystemJobLaunch, error) {
+	result := new(SystemJobLaunch)
+	endpoint := fmt.Sprintf("%s%d/launch/", systemJobTemplatesAPIEndpoint, id)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	// in case invalid job id return
+	if result.SystemJobID == 0 {
+		return nil, errors.New("invalid system job id 0")
+	}
+
+	return result, nil
+}
+
+// LaunchCleanup launches the system job template of a job type, e.g.
+// `SystemJobCleanupJobs`, keeping the data of the last `days` days. The
+// sessions and tokens cleanups take no days, pass 0.
+func (s *SystemJobTemplatesService) LaunchCleanup(jobType string, days int) (*SystemJobLaunch, error) {
+	templates, _, err := s.ListSystemJobTemplates(url.Values{"job_type": {jobType}})
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, &LookupError{Resource: "system job template", Name: jobType, Err: ErrNotFound}
+	}
+
+	data := map[string]interface{}{}
+	if days > 0 {
+		data["extra_vars"] = map[string]interface{}{"days": days}
+	}
+	return s.Launch(templates[0].ID, data, nil)
+}
+
+// ListSystemJobTemplateSchedules shows the schedules of a system job template.
+func (s *SystemJobTemplatesService) ListSystemJobTemplateSchedules(id int, params url.Values) ([]*Schedule, *ListSchedulesResponse, error) {
+	result := new(ListSchedulesResponse)
+	endpoint := fmt.Sprintf("%s%d/schedules/", systemJobTemplatesAPIEndpoint, id)
+	resp, err := s.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// CreateSystemJobTemplateSchedule creates a schedule for a system job
+// template, the cleanup days are given in the schedule `extra_data`.
+func (s *SystemJobTemplatesService) CreateSystemJobTemplateSchedule(id int, data map[string]interface{}, params url.Values) (*Schedule, error) {
+	mandatoryFields = []string{"name", "rrule"}
+	validate, status := ValidateParams(data, mandatoryFields)
+	if !status {
+		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
+		return nil, err
+	}
+
+	result := new(Schedule)
+	endpoint := fmt.Sprintf("%s%d/schedules/", systemJobTemplatesAPIEndpoint, id)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,72 @@
+package awx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestLaunchCleanup(t *testing.T) {
+	templates := map[string]int{SystemJobCleanupJobs: 1, SystemJobCleanupSessions: 3}
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.RequestURI()+" "+string(body))
+		if r.Method == http.MethodGet {
+			id, ok := templates[r.URL.Query().Get("job_type")]
+			if !ok {
+				fmt.Fprint(w, `{"count": 0, "results": []}`)
+				return
+			}
+			fmt.Fprintf(w, `{"count": 1, "results": [{"id": %d, "job_type": %q}]}`, id, r.URL.Query().Get("job_type"))
+			return
+		}
+		fmt.Fprint(w, `{"system_job": 42, "id": 42}`)
+	}))
+	defer server.Close()
+	s := &SystemJobTemplatesService{client: newTestClient(server)}
+
+	tests := []struct {
+		jobType  string
+		days     int
+		requests []string
+		notFound bool
+	}{{
+		jobType: SystemJobCleanupJobs,
+		days:    30,
+		requests: []string{
+			"GET /api/v2/system_job_templates/?job_type=cleanup_jobs ",
+			`POST /api/v2/system_job_templates/1/launch/ {"extra_vars":{"days":30}}`,
+		},
+	}, {
+		jobType: SystemJobCleanupSessions,
+		requests: []string{
+			"GET /api/v2/system_job_templates/?job_type=cleanup_sessions ",
+			"POST /api/v2/system_job_templates/3/launch/ {}",
+		},
+	}, {
+		jobType:  SystemJobCleanupTokens,
+		days:     7,
+		requests: []string{"GET /api/v2/system_job_templates/?job_type=cleanup_tokens "},
+		notFound: true,
+	}}
+	for _, tt := range tests {
+		requests = nil
+		launch, err := s.LaunchCleanup(tt.jobType, tt.days)
+		if tt.notFound {
+			var lookupErr *LookupError
+			if !errors.Is(err, ErrNotFound) || !errors.As(err, &lookupErr) || lookupErr.Name != tt.jobType {
+				t.Errorf("%s: expected a not found error, got %v", tt.jobType, err)
+			}
+		} else if err != nil || launch.SystemJobID != 42 {
+			t.Errorf("%s: %+v, %v", tt.jobType, launch, err)
+		}
+		if !reflect.DeepEqual(requests, tt.requests) {
+			t.Errorf("%s: requests %q, want %q", tt.jobType, requests, tt.requests)
+		}
+	}
+}
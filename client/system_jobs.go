@@ -0,0 +1,105 @@
+package awx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// SystemJobsService implements awx system jobs apis.
+type SystemJobsService struct {
+	client *Client
+}
+
+// ListSystemJobsResponse represents `ListSystemJobs` endpoint response.
+type ListSystemJobsResponse struct {
+	Pagination
+	Results []*SystemJob `json:"results"`
+}
+
+// SystemJobEventsResponse represents `GetSystemJobEvents` endpoint response.
+type SystemJobEventsResponse struct {
+	Pagination
+	Results []SystemJobEvent `json:"results"`
+}
+
+const systemJobsAPIEndpoint = "/api/v2/system_jobs/"
+
+// ListSystemJobs shows list of awx system jobs.
+func (s *SystemJobsService) ListSystemJobs(params url.Values) ([]*SystemJob, *ListSystemJobsResponse, error) {
+	result := new(ListSystemJobsResponse)
+	resp, err := s.client.Requester.GetJSON(systemJobsAPIEndpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// GetSystemJob shows the details of a system job.
+func (s *SystemJobsService) GetSystemJob(id int, params url.Values) (*SystemJob, error) {
+	result := new(SystemJob)
+	endpoint := fmt.Sprintf("%s%d/", systemJobsAPIEndpoint, id)
+	resp, err := s.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CancelSystemJob cancels a system job.
+func (s *SystemJobsService) CancelSystemJob(id int, data map[string]interface{}, params url.Values) (*CancelJobResponse, error) {
+	result := new(CancelJobResponse)
+	endpoint := fmt.Sprintf("%s%d/cancel/", systemJobsAPIEndpoint, id)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetSystemJobStdout returns the output of a system job. System jobs have no
+// stdout endpoint, the output is the `result_stdout` of the system job.
+func (s *SystemJobsService) GetSystemJobStdout(id int, params url.Values) (string, error) {
+	job, err := s.GetSystemJob(id, params)
+	if err != nil {
+		return "", err
+	}
+	return job.ResultStdout, nil
+}
+
+// GetSystemJobEvents get a list of system job events.
+func (s *SystemJobsService) GetSystemJobEvents(id int, params url.Values) ([]SystemJobEvent, *SystemJobEventsResponse, error) {
+	result := new(SystemJobEventsResponse)
+	endpoint := fmt.Sprintf("%s%d/events/", systemJobsAPIEndpoint, id)
+	resp, err := s.client.Requester.GetJSON(endpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
@@ -0,0 +1,36 @@
+package awx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestGetSystemJobStdout(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+		if r.URL.Path != "/api/v2/system_jobs/42/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"id": 42, "status": "successful", "result_stdout": "Removed 3 jobs\n"}`)
+	}))
+	defer server.Close()
+	s := &SystemJobsService{client: newTestClient(server)}
+
+	stdout, err := s.GetSystemJobStdout(42, url.Values{})
+	if err != nil || stdout != "Removed 3 jobs\n" {
+		t.Errorf("stdout: %q, %v", stdout, err)
+	}
+	if !reflect.DeepEqual(requests, []string{"/api/v2/system_jobs/42/"}) {
+		t.Errorf("requests: %v", requests)
+	}
+
+	if _, err := s.GetSystemJobStdout(7, url.Values{}); err == nil {
+		t.Errorf("expected a not found error")
+	}
+}
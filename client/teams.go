@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -36,7 +37,7 @@ type ListTeamUsersResponse struct {
 const teamsAPIEndpoint = "/api/v2/teams/"
 
 // ListTeams shows list of awx teams.
-func (t *TeamService) ListTeams(params map[string]string) ([]*Team, *ListTeamsResponse, error) {
+func (t *TeamService) ListTeams(params url.Values) ([]*Team, *ListTeamsResponse, error) {
 	result := new(ListTeamsResponse)
 	resp, err := t.client.Requester.GetJSON(teamsAPIEndpoint, result, params)
 	if err != nil {
@@ -50,7 +51,7 @@ func (t *TeamService) ListTeams(params map[string]string) ([]*Team, *ListTeamsRe
 	return result.Results, result, nil
 }
 
-func (t *TeamService) ListTeamRoleEntitlements(id int, params map[string]string) ([]*ApplyRole, *ListTeamRolesResponse, error) {
+func (t *TeamService) ListTeamRoleEntitlements(id int, params url.Values) ([]*ApplyRole, *ListTeamRolesResponse, error) {
 	result := new(ListTeamRolesResponse)
 	endpoint := fmt.Sprintf("%s%d/roles/", teamsAPIEndpoint, id)
 	resp, err := t.client.Requester.GetJSON(endpoint, result, params)
@@ -64,7 +65,7 @@ func (t *TeamService) ListTeamRoleEntitlements(id int, params map[string]string)
 	return result.Results, result, nil
 }
 
-func (t *TeamService) GetTeamObjectRoles(id int, params map[string]string, pagination *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error) {
+func (t *TeamService) GetTeamObjectRoles(id int, params url.Values, pagination *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error) {
 	result := new(ListTeamRolesResponse)
 	endpoint := fmt.Sprintf("%s%d/object_roles/", teamsAPIEndpoint, id)
 	resp, err := t.client.Requester.GetJSON(endpoint, result, params)
@@ -78,10 +79,10 @@ func (t *TeamService) GetTeamObjectRoles(id int, params map[string]string, pagin
 	return result.Results, result, nil
 }
 
-func (t *TeamService) GetTeamUsers(id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
+func (t *TeamService) GetTeamUsers(id int, params url.Values, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/users/", teamsAPIEndpoint, id)
 	if *pagination.AllPages {
-		users, err := t.getAllTeamUsersPages(endpoint, params)
+		users, err := listAll[User](context.Background(), t.client, endpoint, params)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -100,10 +101,10 @@ func (t *TeamService) GetTeamUsers(id int, params map[string]string, pagination
 	}
 }
 
-func (t *TeamService) GetTeamAccessList(id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
+func (t *TeamService) GetTeamAccessList(id int, params url.Values, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/access_list/", teamsAPIEndpoint, id)
 	if *pagination.AllPages {
-		users, err := t.getAllTeamUsersPages(endpoint, params)
+		users, err := listAll[User](context.Background(), t.client, endpoint, params)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -177,7 +178,7 @@ func (t *TeamService) RemoveTeamUser(id int, data map[string]interface{}) error
 }
 
 // GetTeamByID shows the details of a team.
-func (t *TeamService) GetTeamByID(id int, params map[string]string) (*Team, error) {
+func (t *TeamService) GetTeamByID(id int, params url.Values) (*Team, error) {
 	result := new(Team)
 	endpoint := fmt.Sprintf("%s%d/", teamsAPIEndpoint, id)
 	resp, err := t.client.Requester.GetJSON(endpoint, result, params)
@@ -192,8 +193,19 @@ func (t *TeamService) GetTeamByID(id int, params map[string]string) (*Team, erro
 	return result, nil
 }
 
+// GetByNamedURL shows the details of a team by its named url identifier, see `NamedURLTeam`.
+func (t *TeamService) GetByNamedURL(identifier string, params url.Values) (*Team, error) {
+	return getByNamedURL[Team](t.client, teamsAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only team named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (t *TeamService) FindByName(ctx context.Context, name string, scope Scope) (*Team, error) {
+	return findByName[Team](ctx, t.client, "team", teamsAPIEndpoint, "name", name, scope, organizationScope)
+}
+
 // CreateTeam creates an awx team.
-func (t *TeamService) CreateTeam(data map[string]interface{}, params map[string]string) (*Team, error) {
+func (t *TeamService) CreateTeam(data map[string]interface{}, params url.Values) (*Team, error) {
 	mandatoryFields = []string{"name", "organization"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -223,7 +235,7 @@ func (t *TeamService) CreateTeam(data map[string]interface{}, params map[string]
 }
 
 // UpdateTeam update an awx Team.
-func (t *TeamService) UpdateTeam(id int, data map[string]interface{}, params map[string]string) (*Team, error) {
+func (t *TeamService) UpdateTeam(id int, data map[string]interface{}, params url.Values) (*Team, error) {
 	result := new(Team)
 	endpoint := fmt.Sprintf("%s%d/", teamsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -242,7 +254,7 @@ func (t *TeamService) UpdateTeam(id int, data map[string]interface{}, params map
 	return result, nil
 }
 
-func (t *TeamService) UpdateTeamRoleEntitlement(id int, data map[string]interface{}, params map[string]string) (interface{}, error) {
+func (t *TeamService) UpdateTeamRoleEntitlement(id int, data map[string]interface{}, params url.Values) (interface{}, error) {
 	result := new(interface{})
 	endpoint := fmt.Sprintf("%s%d/roles/", teamsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -278,45 +290,3 @@ func (t *TeamService) DeleteTeam(id int) (*Team, error) {
 
 	return result, nil
 }
-
-// Must be replaced by a generic function
-// But upgrade to version go 1.18 before
-func (t *TeamService) getAllTeamUsersPages(firstURL string, params map[string]string) ([]*User, error) {
-	results := make([]*User, 0)
-	nextURL := firstURL
-	for {
-		nextURLParsed, err := url.Parse(nextURL)
-		if err != nil {
-			return nil, err
-		}
-
-		nextURLQueryParams := make(map[string]string)
-		for paramName, paramValues := range nextURLParsed.Query() {
-			if len(paramValues) > 0 {
-				nextURLQueryParams[paramName] = paramValues[0]
-			}
-		}
-
-		for paramName, paramValue := range params {
-			nextURLQueryParams[paramName] = paramValue
-		}
-
-		result := new(ListTeamUsersResponse)
-		resp, err := t.client.Requester.GetJSON(nextURLParsed.Path, result, nextURLQueryParams)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := CheckResponse(resp); err != nil {
-			return nil, err
-		}
-
-		results = append(results, result.Results...)
-
-		if result.Next == nil || result.Next.(string) == "" {
-			break
-		}
-		nextURL = result.Next.(string)
-	}
-	return results, nil
-}
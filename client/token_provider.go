@@ -0,0 +1,211 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenProvider provides an OAuth token which may change over time.
+type TokenProvider interface {
+	// Token returns the current token, cached results are allowed.
+	Token() (string, error)
+	// Refresh discards any cached token and fetches a new one.
+	Refresh() (string, error)
+}
+
+// refreshableAuthenticator is implemented by authenticators able to renew
+// their credential after a 401 response.
+type refreshableAuthenticator interface {
+	// refreshAuthentication renews the credential and reports whether it
+	// differs from the one used by the failed request.
+	refreshAuthentication(failed *http.Request) (bool, error)
+}
+
+// TokenProviderAuth represents token authentication with a token read from a provider.
+type TokenProviderAuth struct {
+	Provider TokenProvider
+}
+
+func (ta *TokenProviderAuth) addAuthenticationHeaders(r *http.Request) error {
+	token, err := ta.Provider.Token()
+	if err != nil {
+		return fmt.Errorf("get token: %w", err)
+	}
+
+	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return nil
+}
+
+func (ta *TokenProviderAuth) refreshAuthentication(failed *http.Request) (bool, error) {
+	token, err := ta.Provider.Refresh()
+	if err != nil {
+		return false, fmt.Errorf("refresh token: %w", err)
+	}
+
+	return failed.Header.Get("Authorization") != fmt.Sprintf("Bearer %s", token), nil
+}
+
+// NewAWXTokenProvider creates an AWX handler with a token read from the provider,
+// the token is refreshed and the request retried once on a 401 response.
+func NewAWXTokenProvider(baseURL string, provider TokenProvider, client *http.Client) (*AWX, error) {
+	return newAWXWithAuthenticator(baseURL, &TokenProviderAuth{Provider: provider}, client)
+}
+
+// FileTokenProvider reads the token from a file, the file is read again when it changes.
+type FileTokenProvider struct {
+	Path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// Token returns the token from the file, read again if the file changed since the last read.
+func (p *FileTokenProvider) Token() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	info, err := os.Stat(p.Path)
+	if err != nil {
+		return "", err
+	}
+	if p.token != "" && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return p.token, nil
+	}
+	return p.read()
+}
+
+// Refresh reads the token from the file.
+func (p *FileTokenProvider) Refresh() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.read()
+}
+
+func (p *FileTokenProvider) read() (string, error) {
+	info, err := os.Stat(p.Path)
+	if err != nil {
+		return "", err
+	}
+	content, err := os.ReadFile(p.Path)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("empty token file %s", p.Path)
+	}
+
+	p.token, p.modTime, p.size = token, info.ModTime(), info.Size()
+	return p.token, nil
+}
+
+// CommandTokenProvider runs an external command and uses its trimmed standard output as token.
+// The token is cached for TTL, forever when TTL is zero, and until the next refresh.
+type CommandTokenProvider struct {
+	Name    string
+	Args    []string
+	TTL     time.Duration
+	Timeout time.Duration
+
+	cache tokenCache
+}
+
+// Token returns the cached token, running the command if needed.
+func (p *CommandTokenProvider) Token() (string, error) {
+	return p.cache.get(p.TTL, p.run)
+}
+
+// Refresh runs the command again.
+func (p *CommandTokenProvider) Refresh() (string, error) {
+	return p.cache.refresh(p.run)
+}
+
+func (p *CommandTokenProvider) run() (string, error) {
+	ctx := context.Background()
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Name, p.Args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("run %s: %w: %s", p.Name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("command %s returned an empty token", p.Name)
+	}
+	return token, nil
+}
+
+// FuncTokenProvider calls a function to get the token.
+// The token is cached for TTL, forever when TTL is zero, and until the next refresh.
+type FuncTokenProvider struct {
+	Func func() (string, error)
+	TTL  time.Duration
+
+	cache tokenCache
+}
+
+// Token returns the cached token, calling the function if needed.
+func (p *FuncTokenProvider) Token() (string, error) {
+	return p.cache.get(p.TTL, p.Func)
+}
+
+// Refresh calls the function again.
+func (p *FuncTokenProvider) Refresh() (string, error) {
+	return p.cache.refresh(p.Func)
+}
+
+// tokenCache caches a token fetched by a function.
+type tokenCache struct {
+	mu        sync.Mutex
+	token     string
+	fetchedAt time.Time
+}
+
+func (c *tokenCache) get(ttl time.Duration, fetch func() (string, error)) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && (ttl == 0 || time.Since(c.fetchedAt) < ttl) {
+		return c.token, nil
+	}
+	return c.fetch(fetch)
+}
+
+func (c *tokenCache) refresh(fetch func() (string, error)) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.fetch(fetch)
+}
+
+func (c *tokenCache) fetch(fetch func() (string, error)) (string, error) {
+	if fetch == nil {
+		return "", errors.New("no token provider function")
+	}
+
+	token, err := fetch()
+	if err != nil {
+		return "", err
+	}
+
+	c.token, c.fetchedAt = token, time.Now()
+	return token, nil
+}
@@ -0,0 +1,164 @@
+package awx
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// tokenServer accepts the requests authenticated with its token only, and records the bodies it received.
+type tokenServer struct {
+	token  string
+	bodies []string
+	auths  []string
+}
+
+func (s *tokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	s.bodies = append(s.bodies, string(body))
+	s.auths = append(s.auths, r.Header.Get("Authorization"))
+	if r.Header.Get("Authorization") != "Bearer "+s.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, `{"detail": "Authentication credentials were not provided."}`)
+		return
+	}
+	io.WriteString(w, `{"id": 1}`)
+}
+
+func TestTokenProviderRefreshAndReplay(t *testing.T) {
+	tokens := []string{"old", "new"}
+	calls := 0
+	provider := &FuncTokenProvider{Func: func() (string, error) {
+		token := tokens[calls]
+		if calls < len(tokens)-1 {
+			calls++
+		}
+		return token, nil
+	}}
+
+	handler := &tokenServer{token: "new"}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	requester := &Requester{Base: server.URL, Authenticator: &TokenProviderAuth{Provider: provider}, Client: server.Client()}
+
+	result := map[string]int{}
+	payload := `{"name": "demo"}`
+	resp, err := requester.PostJSON("/api/v2/projects/", bytes.NewBufferString(payload), &result, nil)
+	if err != nil {
+		t.Fatalf("post: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK || result["id"] != 1 {
+		t.Errorf("response: %d %v", resp.StatusCode, result)
+	}
+	if len(handler.bodies) != 2 || handler.bodies[0] != payload || handler.bodies[1] != payload {
+		t.Errorf("the payload was not replayed: %q", handler.bodies)
+	}
+	if handler.auths[0] != "Bearer old" || handler.auths[1] != "Bearer new" {
+		t.Errorf("authorizations: %q", handler.auths)
+	}
+
+	// the refreshed token is cached for the next requests
+	if _, err := requester.GetJSON("/api/v2/projects/1/", &result, nil); err != nil {
+		t.Fatalf("get: %s", err)
+	}
+	if len(handler.auths) != 3 || handler.auths[2] != "Bearer new" {
+		t.Errorf("cached token: %q", handler.auths)
+	}
+}
+
+func TestTokenProviderNoReplay(t *testing.T) {
+	handler := &tokenServer{token: "valid"}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// an unchanged token is not retried
+	provider := &FuncTokenProvider{Func: func() (string, error) { return "revoked", nil }}
+	requester := &Requester{Base: server.URL, Authenticator: &TokenProviderAuth{Provider: provider}, Client: server.Client()}
+	resp, err := requester.GetJSON("/api/v2/me/", &map[string]interface{}{}, nil)
+	if err != nil {
+		t.Fatalf("get: %s", err)
+	}
+	if err := CheckResponse(resp); err == nil {
+		t.Errorf("expected a 401 error, got %d", resp.StatusCode)
+	}
+	if len(handler.auths) != 1 {
+		t.Errorf("requests: %d, want 1", len(handler.auths))
+	}
+
+	// a refresh failure is returned
+	refreshErr := errors.New("vault sealed")
+	calls := 0
+	provider = &FuncTokenProvider{Func: func() (string, error) {
+		calls++
+		if calls > 1 {
+			return "", refreshErr
+		}
+		return "revoked", nil
+	}}
+	requester.Authenticator = &TokenProviderAuth{Provider: provider}
+	if _, err := requester.GetJSON("/api/v2/me/", &map[string]interface{}{}, nil); !errors.Is(err, refreshErr) {
+		t.Errorf("expected the refresh error, got %v", err)
+	}
+}
+
+func TestFuncTokenProviderTTL(t *testing.T) {
+	calls := 0
+	provider := &FuncTokenProvider{TTL: time.Hour, Func: func() (string, error) {
+		calls++
+		return "token", nil
+	}}
+	for i := 0; i < 3; i++ {
+		if token, err := provider.Token(); err != nil || token != "token" {
+			t.Fatalf("token: %q, %v", token, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("calls: %d, want 1", calls)
+	}
+	if _, err := provider.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("calls after refresh: %d, want 2", calls)
+	}
+
+	provider.cache.fetchedAt = time.Now().Add(-2 * time.Hour)
+	if _, err := provider.Token(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("calls after expiry: %d, want 3", calls)
+	}
+}
+
+func TestFileTokenProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("first\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	provider := &FileTokenProvider{Path: path}
+	if token, err := provider.Token(); err != nil || token != "first" {
+		t.Fatalf("token: %q, %v", token, err)
+	}
+
+	// a rotated file is read again
+	if err := os.WriteFile(path, []byte("second-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if token, err := provider.Token(); err != nil || token != "second-token" {
+		t.Errorf("rotated token: %q, %v", token, err)
+	}
+
+	if err := os.WriteFile(path, []byte("\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := provider.Refresh(); err == nil {
+		t.Errorf("expected an error for an empty token file")
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 )
 
+//go:generate go run ../internal/gen -fixtures ../internal/gen/testdata/options -out types_generated.go
+
 // Common types definition here
 // For common usage, we made `Related` and `Summary` as two common field,
 // it maybe happened that some structs don't have some fields in `Related` or `Summary`.
@@ -149,6 +151,25 @@ type ApplyRole struct {
 	Description string `json:"description"`
 }
 
+// Role represents the awx api role.
+type Role struct {
+	ID            int          `json:"id"`
+	Type          string       `json:"type"`
+	URL           string       `json:"url"`
+	Related       *Related     `json:"related"`
+	SummaryFields *RoleSummary `json:"summary_fields"`
+	Name          string       `json:"name"`
+	Description   string       `json:"description"`
+}
+
+// RoleSummary represents the awx api role summary fields, the resource the role applies to.
+type RoleSummary struct {
+	ResourceName            string `json:"resource_name"`
+	ResourceType            string `json:"resource_type"`
+	ResourceTypeDisplayName string `json:"resource_type_display_name"`
+	ResourceID              int    `json:"resource_id"`
+}
+
 // ObjectRoles represents the awx api object roles.
 type ObjectRoles struct {
 	AdhocRole                    *ApplyRole `json:"adhoc_role"`
@@ -185,6 +206,19 @@ type Labels struct {
 	Results []interface{} `json:"results"`
 }
 
+// Label represents the awx api label.
+type Label struct {
+	ID            int       `json:"id"`
+	Type          string    `json:"type"`
+	URL           string    `json:"url"`
+	Related       *Related  `json:"related"`
+	SummaryFields *Summary  `json:"summary_fields"`
+	Created       time.Time `json:"created"`
+	Modified      time.Time `json:"modified"`
+	Name          string    `json:"name"`
+	Organization  int       `json:"organization"`
+}
+
 // Summary represents the awx api summary fields.
 type Summary struct {
 	InstanceGroup               *InstanceGroupSummary        `json:"instance_group"`
@@ -212,72 +246,134 @@ type Summary struct {
 
 // ProjectUpdate represents the awx api project update.
 type ProjectUpdate struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Status      string `json:"status"`
-	Failed      bool   `json:"failed"`
-}
-
-// Project represents the awx api project.
-type Project struct {
-	ID                    int       `json:"id"`
-	Type                  string    `json:"type"`
-	URL                   string    `json:"url"`
-	Related               *Related  `json:"related"`
-	SummaryFields         *Summary  `json:"summary_fields"`
-	Created               time.Time `json:"created"`
-	Modified              time.Time `json:"modified"`
-	Name                  string    `json:"name"`
-	Description           string    `json:"description"`
-	LocalPath             string    `json:"local_path"`
-	ScmType               string    `json:"scm_type"`
-	ScmURL                string    `json:"scm_url"`
-	ScmBranch             string    `json:"scm_branch"`
-	ScmClean              bool      `json:"scm_clean"`
-	ScmDeleteOnUpdate     bool      `json:"scm_delete_on_update"`
-	Credential            string    `json:"credential"`
-	Timeout               int       `json:"timeout"`
-	LastJobRun            time.Time `json:"last_job_run"`
-	LastJobFailed         bool      `json:"last_job_failed"`
-	NextJobRun            time.Time `json:"next_job_run"`
-	Status                string    `json:"status"`
-	Organization          int       `json:"organization"`
-	ScmDeleteOnNextUpdate bool      `json:"scm_delete_on_next_update"`
-	ScmUpdateOnLaunch     bool      `json:"scm_update_on_launch"`
-	ScmUpdateCacheTimeout int       `json:"scm_update_cache_timeout"`
-	AllowOverride         bool      `json:"allow_override"`
-	ScmRevision           string    `json:"scm_revision"`
-	LastUpdateFailed      bool      `json:"last_update_failed"`
-	LastUpdated           time.Time `json:"last_updated"`
-}
-
-// Inventory represents the awx api inventory.
-type Inventory struct {
-	ID                           int         `json:"id"`
-	Type                         string      `json:"type"`
-	URL                          string      `json:"url"`
-	Related                      *Related    `json:"related"`
-	SummaryFields                *Summary    `json:"summary_fields"`
-	Created                      time.Time   `json:"created"`
-	Modified                     time.Time   `json:"modified"`
-	Name                         string      `json:"name"`
-	Description                  string      `json:"description"`
-	Organization                 int         `json:"organization"`
-	OrganizationID               int         `json:"organization_id"`
-	Kind                         string      `json:"kind"`
-	HostFilter                   interface{} `json:"host_filter"`
-	Variables                    string      `json:"variables"`
-	HasActiveFailures            bool        `json:"has_active_failures"`
-	TotalHosts                   int         `json:"total_hosts"`
-	HostsWithActiveFailures      int         `json:"hosts_with_active_failures"`
-	TotalGroups                  int         `json:"total_groups"`
-	GroupsWithActiveFailures     int         `json:"groups_with_active_failures"`
-	HasInventorySources          bool        `json:"has_inventory_sources"`
-	TotalInventorySources        int         `json:"total_inventory_sources"`
-	InventorySourcesWithFailures int         `json:"inventory_sources_with_failures"`
-	InsightsCredential           interface{} `json:"insights_credential"`
-	PendingDeletion              bool        `json:"pending_deletion"`
+	ID                 int                 `json:"id"`
+	Type               string              `json:"type"`
+	URL                string              `json:"url"`
+	Related            *Related            `json:"related"`
+	SummaryFields      *Summary            `json:"summary_fields"`
+	Created            time.Time           `json:"created"`
+	Modified           time.Time           `json:"modified"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description"`
+	UnifiedJobTemplate int                 `json:"unified_job_template"`
+	LaunchType         string              `json:"launch_type"`
+	Status             string              `json:"status"`
+	Failed             bool                `json:"failed"`
+	Started            Nullable[time.Time] `json:"started,omitzero"`
+	Finished           Nullable[time.Time] `json:"finished,omitzero"`
+	CanceledOn         Nullable[time.Time] `json:"canceled_on,omitzero"`
+	Elapsed            float64             `json:"elapsed"`
+	JobExplanation     string              `json:"job_explanation"`
+	ExecutionNode      string              `json:"execution_node"`
+	ResultTraceback    string              `json:"result_traceback"`
+	Project            int                 `json:"project"`
+	JobType            string              `json:"job_type"`
+	ScmType            string              `json:"scm_type"`
+	ScmURL             string              `json:"scm_url"`
+	ScmBranch          string              `json:"scm_branch"`
+	ScmRevision        string              `json:"scm_revision"`
+	ScmClean           bool                `json:"scm_clean"`
+	ScmDeleteOnUpdate  bool                `json:"scm_delete_on_update"`
+	Credential         Nullable[int]       `json:"credential,omitzero"`
+	Timeout            int                 `json:"timeout"`
+}
+
+// InventoryUpdate represents the awx api inventory update.
+type InventoryUpdate struct {
+	ID                  int                 `json:"id"`
+	Type                string              `json:"type"`
+	URL                 string              `json:"url"`
+	Related             *Related            `json:"related"`
+	SummaryFields       *Summary            `json:"summary_fields"`
+	Created             time.Time           `json:"created"`
+	Modified            time.Time           `json:"modified"`
+	Name                string              `json:"name"`
+	Description         string              `json:"description"`
+	UnifiedJobTemplate  int                 `json:"unified_job_template"`
+	LaunchType          string              `json:"launch_type"`
+	Status              string              `json:"status"`
+	Failed              bool                `json:"failed"`
+	Started             Nullable[time.Time] `json:"started,omitzero"`
+	Finished            Nullable[time.Time] `json:"finished,omitzero"`
+	CanceledOn          Nullable[time.Time] `json:"canceled_on,omitzero"`
+	Elapsed             float64             `json:"elapsed"`
+	JobExplanation      string              `json:"job_explanation"`
+	ExecutionNode       string              `json:"execution_node"`
+	ResultTraceback     string              `json:"result_traceback"`
+	Inventory           int                 `json:"inventory"`
+	InventorySource     int                 `json:"inventory_source"`
+	Source              string              `json:"source"`
+	SourcePath          string              `json:"source_path"`
+	SourceVars          string              `json:"source_vars"`
+	SourceProjectUpdate Nullable[int]       `json:"source_project_update,omitzero"`
+	Credential          Nullable[int]       `json:"credential,omitzero"`
+	EnabledVar          string              `json:"enabled_var"`
+	EnabledValue        string              `json:"enabled_value"`
+	HostFilter          string              `json:"host_filter"`
+	Overwrite           bool                `json:"overwrite"`
+	OverwriteVars       bool                `json:"overwrite_vars"`
+	Timeout             int                 `json:"timeout"`
+	Verbosity           int                 `json:"verbosity"`
+	LicenseError        bool                `json:"license_error"`
+	OrgHostLimitError   bool                `json:"org_host_limit_error"`
+}
+
+// AdHocCommand represents the awx api ad hoc command.
+type AdHocCommand struct {
+	ID                   int                 `json:"id"`
+	Type                 string              `json:"type"`
+	URL                  string              `json:"url"`
+	Related              *Related            `json:"related"`
+	SummaryFields        *Summary            `json:"summary_fields"`
+	Created              time.Time           `json:"created"`
+	Modified             time.Time           `json:"modified"`
+	Name                 string              `json:"name"`
+	Description          string              `json:"description"`
+	LaunchType           string              `json:"launch_type"`
+	Status               string              `json:"status"`
+	Failed               bool                `json:"failed"`
+	Started              Nullable[time.Time] `json:"started,omitzero"`
+	Finished             Nullable[time.Time] `json:"finished,omitzero"`
+	CanceledOn           Nullable[time.Time] `json:"canceled_on,omitzero"`
+	Elapsed              float64             `json:"elapsed"`
+	JobExplanation       string              `json:"job_explanation"`
+	ExecutionNode        string              `json:"execution_node"`
+	ResultTraceback      string              `json:"result_traceback"`
+	JobType              string              `json:"job_type"`
+	Inventory            Nullable[int]       `json:"inventory,omitzero"`
+	Limit                string              `json:"limit"`
+	Credential           Nullable[int]       `json:"credential,omitzero"`
+	ModuleName           string              `json:"module_name"`
+	ModuleArgs           string              `json:"module_args"`
+	Forks                int                 `json:"forks"`
+	Verbosity            int                 `json:"verbosity"`
+	ExtraVars            string              `json:"extra_vars"`
+	BecomeEnabled        bool                `json:"become_enabled"`
+	DiffMode             bool                `json:"diff_mode"`
+	ExecutionEnvironment Nullable[int]       `json:"execution_environment,omitzero"`
+}
+
+// UnifiedJob represents the awx api unified job, the fields shared by every kind of job.
+type UnifiedJob struct {
+	ID                 int                 `json:"id"`
+	Type               string              `json:"type"`
+	URL                string              `json:"url"`
+	Related            *Related            `json:"related"`
+	SummaryFields      *Summary            `json:"summary_fields"`
+	Created            time.Time           `json:"created"`
+	Modified           time.Time           `json:"modified"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description"`
+	UnifiedJobTemplate int                 `json:"unified_job_template"`
+	LaunchType         string              `json:"launch_type"`
+	Status             string              `json:"status"`
+	Failed             bool                `json:"failed"`
+	Started            Nullable[time.Time] `json:"started,omitzero"`
+	Finished           Nullable[time.Time] `json:"finished,omitzero"`
+	CanceledOn         Nullable[time.Time] `json:"canceled_on,omitzero"`
+	Elapsed            float64             `json:"elapsed"`
+	JobExplanation     string              `json:"job_explanation"`
+	ExecutionNode      string              `json:"execution_node"`
 }
 
 // Credential represents the awx api credential.
@@ -286,7 +382,7 @@ type Credential struct {
 	ID               int                    `json:"id"`
 	Kind             string                 `json:"kind"`
 	Name             string                 `json:"name"`
-	OrganizationID   int                    `json:"organization"`
+	OrganizationID   Nullable[int]          `json:"organization,omitzero"`
 	CredentialTypeID int                    `json:"credential_type"`
 	Inputs           map[string]interface{} `json:"inputs"`
 	SummaryFields    *Summary               `json:"summary_fields"`
@@ -311,23 +407,34 @@ type CredentialInputSource struct {
 	Metadata         map[string]interface{} `json:"metadata"`
 }
 
-// UnifiedJobTemplate represents the awx api unified job template.
+// UnifiedJobTemplate represents the awx api unified job template, the fields
+// shared by every kind of template.
 type UnifiedJobTemplate struct {
-	ID             int    `json:"id"`
-	Name           string `json:"name"`
-	Description    string `json:"description"`
-	UnifiedJobType string `json:"unified_job_type"`
+	ID             int                 `json:"id"`
+	Type           string              `json:"type"`
+	URL            string              `json:"url"`
+	Related        *Related            `json:"related"`
+	SummaryFields  *Summary            `json:"summary_fields"`
+	Created        time.Time           `json:"created"`
+	Modified       time.Time           `json:"modified"`
+	Name           string              `json:"name"`
+	Description    string              `json:"description"`
+	LastJobRun     Nullable[time.Time] `json:"last_job_run,omitzero"`
+	LastJobFailed  bool                `json:"last_job_failed"`
+	NextJobRun     Nullable[time.Time] `json:"next_job_run,omitzero"`
+	Status         string              `json:"status"`
+	UnifiedJobType string              `json:"unified_job_type"`
 }
 
 // InstanceGroup represents the awx api instance group.
 type InstanceGroup struct {
-	ID               int      `json:"id"`
-	Instances        []string `json:"instances"`
-	Capacity         int      `json:"capacity"`
-	CredentialId     int      `json:"credential_id"`
-	Name             string   `json:"name"`
-	IsContainerGroup bool     `json:"is_container_group"`
-	PodSpecOverride  string   `json:"pod_spec_override"`
+	ID               int           `json:"id"`
+	Instances        int           `json:"instances"`
+	Capacity         int           `json:"capacity"`
+	CredentialId     Nullable[int] `json:"credential_id,omitzero"`
+	Name             string        `json:"name"`
+	IsContainerGroup bool          `json:"is_container_group"`
+	PodSpecOverride  string        `json:"pod_spec_override"`
 }
 
 // Result data type
@@ -350,191 +457,161 @@ type Instance struct {
 	Capacity  int       `json:"capacity"`
 }
 
+// PingInstanceGroup represents the awx api ping instance group.
+type PingInstanceGroup struct {
+	Name      string   `json:"name"`
+	Capacity  int      `json:"capacity"`
+	Instances []string `json:"instances"`
+}
+
 // Ping represents the awx api ping.
 type Ping struct {
-	Instances      []Instance      `json:"instances"`
-	InstanceGroups []InstanceGroup `json:"instance_groups"`
-	Ha             bool            `json:"ha"`
-	Version        string          `json:"version"`
-	ActiveNode     string          `json:"active_node"`
-}
-
-// JobTemplate represents the awx api job template.
-type JobTemplate struct {
-	ID                              int         `json:"id"`
-	Type                            string      `json:"type"`
-	URL                             string      `json:"url"`
-	Related                         *Related    `json:"related"`
-	SummaryFields                   *Summary    `json:"summary_fields"`
-	Created                         time.Time   `json:"created"`
-	Modified                        time.Time   `json:"modified"`
-	Name                            string      `json:"name"`
-	Description                     string      `json:"description"`
-	JobType                         string      `json:"job_type"`
-	Inventory                       int         `json:"inventory"`
-	Project                         int         `json:"project"`
-	Playbook                        string      `json:"playbook"`
-	ScmBranch                       string      `json:"scm_branch"`
-	Forks                           int         `json:"forks"`
-	Limit                           string      `json:"limit"`
-	Verbosity                       int         `json:"verbosity"`
-	ExtraVars                       string      `json:"extra_vars"`
-	JobTags                         string      `json:"job_tags"`
-	ForceHandlers                   bool        `json:"force_handlers"`
-	SkipTags                        string      `json:"skip_tags"`
-	StartAtTask                     string      `json:"start_at_task"`
-	Timeout                         int         `json:"timeout"`
-	UseFactCache                    bool        `json:"use_fact_cache"`
-	LastJobRun                      interface{} `json:"last_job_run"`
-	LastJobFailed                   bool        `json:"last_job_failed"`
-	NextJobRun                      interface{} `json:"next_job_run"`
-	Status                          string      `json:"status"`
-	ExecutionEnvironment            string      `json:"execution_environment"`
-	HostConfigKey                   string      `json:"host_config_key"`
-	AskScmBranchOnLaunch            bool        `json:"ask_scm_branch_on_launch"`
-	AskDiffModeOnLaunch             bool        `json:"ask_diff_mode_on_launch"`
-	AskVariablesOnLaunch            bool        `json:"ask_variables_on_launch"`
-	AskLimitOnLaunch                bool        `json:"ask_limit_on_launch"`
-	AskTagsOnLaunch                 bool        `json:"ask_tags_on_launch"`
-	AskSkipTagsOnLaunch             bool        `json:"ask_skip_tags_on_launch"`
-	AskJobTypeOnLaunch              bool        `json:"ask_job_type_on_launch"`
-	AskVerbosityOnLaunch            bool        `json:"ask_verbosity_on_launch"`
-	AskInventoryOnLaunch            bool        `json:"ask_inventory_on_launch"`
-	AskCredentialOnLaunch           bool        `json:"ask_credential_on_launch"`
-	AskExecutionEnvironmentOnLaunch bool        `json:"ask_execution_environment_on_launch"`
-	AskLabelsOnLaunch               bool        `json:"ask_labels_on_launch"`
-	AskForksOnLaunch                bool        `json:"ask_forks_on_launch"`
-	AskJobSliceCountOnLaunch        bool        `json:"ask_job_slice_count_on_launch"`
-	AskTimeoutOnLaunch              bool        `json:"ask_timeout_on_launch"`
-	AskInstanceGroupsOnLaunch       bool        `json:"ask_instance_groups_on_launch"`
-	SurveyEnabled                   bool        `json:"survey_enabled"`
-	BecomeEnabled                   bool        `json:"become_enabled"`
-	DiffMode                        bool        `json:"diff_mode"`
-	AllowSimultaneous               bool        `json:"allow_simultaneous"`
-	CustomVirtualenv                interface{} `json:"custom_virtualenv"`
-	JobSliceCount                   int         `json:"job_slice_count"`
-	Credential                      int         `json:"credential"`
-	VaultCredential                 interface{} `json:"vault_credential"`
+	Instances      []Instance          `json:"instances"`
+	InstanceGroups []PingInstanceGroup `json:"instance_groups"`
+	Ha             bool                `json:"ha"`
+	Version        string              `json:"version"`
+	ActiveNode     string              `json:"active_node"`
+}
+
+// SurveySpec represents the awx api survey of a template.
+type SurveySpec struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Spec        []*SurveyQuestion `json:"spec"`
+}
+
+// SurveyQuestion represents a question of a survey, its answer is the extra variable `Variable`.
+type SurveyQuestion struct {
+	QuestionName        string      `json:"question_name"`
+	QuestionDescription string      `json:"question_description"`
+	Variable            string      `json:"variable"`
+	Type                string      `json:"type"`
+	Required            bool        `json:"required"`
+	Default             interface{} `json:"default"`
+	Choices             interface{} `json:"choices"`
+	Min                 *float64    `json:"min"`
+	Max                 *float64    `json:"max"`
 }
 
 // JobLaunch represents the awx api job launch.
 type JobLaunch struct {
-	Job                     int               `json:"job"`
-	IgnoredFields           map[string]string `json:"ignored_fields"`
-	ID                      int               `json:"id"`
-	Type                    string            `json:"type"`
-	URL                     string            `json:"url"`
-	Related                 *Related          `json:"related"`
-	SummaryFields           *Summary          `json:"summary_fields"`
-	Created                 time.Time         `json:"created"`
-	Modified                time.Time         `json:"modified"`
-	Name                    string            `json:"name"`
-	Description             string            `json:"description"`
-	JobType                 string            `json:"job_type"`
-	Inventory               int               `json:"inventory"`
-	Project                 int               `json:"project"`
-	Playbook                string            `json:"playbook"`
-	Forks                   int               `json:"forks"`
-	Limit                   string            `json:"limit"`
-	Verbosity               int               `json:"verbosity"`
-	ExtraVars               string            `json:"extra_vars"`
-	JobTags                 string            `json:"job_tags"`
-	ForceHandlers           bool              `json:"force_handlers"`
-	SkipTags                string            `json:"skip_tags"`
-	StartAtTask             string            `json:"start_at_task"`
-	Timeout                 int               `json:"timeout"`
-	UseFactCache            bool              `json:"use_fact_cache"`
-	UnifiedJobTemplate      int               `json:"unified_job_template"`
-	LaunchType              string            `json:"launch_type"`
-	Status                  string            `json:"status"`
-	Failed                  bool              `json:"failed"`
-	Started                 interface{}       `json:"started"`
-	Finished                interface{}       `json:"finished"`
-	Elapsed                 int               `json:"elapsed"`
-	JobArgs                 string            `json:"job_args"`
-	JobCwd                  string            `json:"job_cwd"`
-	JobEnv                  map[string]string `json:"job_env"`
-	JobExplanation          string            `json:"job_explanation"`
-	ExecutionNode           string            `json:"execution_node"`
-	ResultTraceback         string            `json:"result_traceback"`
-	EventProcessingFinished bool              `json:"event_processing_finished"`
-	JobTemplate             int               `json:"job_template"`
-	PasswordsNeededToStart  []interface{}     `json:"passwords_needed_to_start"`
-	AskDiffModeOnLaunch     bool              `json:"ask_diff_mode_on_launch"`
-	AskVariablesOnLaunch    bool              `json:"ask_variables_on_launch"`
-	AskLimitOnLaunch        bool              `json:"ask_limit_on_launch"`
-	AskTagsOnLaunch         bool              `json:"ask_tags_on_launch"`
-	AskSkipTagsOnLaunch     bool              `json:"ask_skip_tags_on_launch"`
-	AskJobTypeOnLaunch      bool              `json:"ask_job_type_on_launch"`
-	AskVerbosityOnLaunch    bool              `json:"ask_verbosity_on_launch"`
-	AskInventoryOnLaunch    bool              `json:"ask_inventory_on_launch"`
-	AskCredentialOnLaunch   bool              `json:"ask_credential_on_launch"`
-	AllowSimultaneous       bool              `json:"allow_simultaneous"`
-	Artifacts               map[string]string `json:"artifacts"`
-	ScmRevision             string            `json:"scm_revision"`
-	InstanceGroup           interface{}       `json:"instance_group"`
-	DiffMode                bool              `json:"diff_mode"`
-	Credential              int               `json:"credential"`
-	VaultCredential         interface{}       `json:"vault_credential"`
+	Job                     int                    `json:"job"`
+	IgnoredFields           map[string]interface{} `json:"ignored_fields"`
+	ID                      int                    `json:"id"`
+	Type                    string                 `json:"type"`
+	URL                     string                 `json:"url"`
+	Related                 *Related               `json:"related"`
+	SummaryFields           *Summary               `json:"summary_fields"`
+	Created                 time.Time              `json:"created"`
+	Modified                time.Time              `json:"modified"`
+	Name                    string                 `json:"name"`
+	Description             string                 `json:"description"`
+	JobType                 string                 `json:"job_type"`
+	Inventory               Nullable[int]          `json:"inventory,omitzero"`
+	Project                 Nullable[int]          `json:"project,omitzero"`
+	Playbook                string                 `json:"playbook"`
+	Forks                   int                    `json:"forks"`
+	Limit                   string                 `json:"limit"`
+	Verbosity               int                    `json:"verbosity"`
+	ExtraVars               ExtraVars              `json:"extra_vars"`
+	JobTags                 string                 `json:"job_tags"`
+	ForceHandlers           bool                   `json:"force_handlers"`
+	SkipTags                string                 `json:"skip_tags"`
+	StartAtTask             string                 `json:"start_at_task"`
+	Timeout                 int                    `json:"timeout"`
+	UseFactCache            bool                   `json:"use_fact_cache"`
+	UnifiedJobTemplate      int                    `json:"unified_job_template"`
+	LaunchType              string                 `json:"launch_type"`
+	Status                  string                 `json:"status"`
+	Failed                  bool                   `json:"failed"`
+	Started                 Nullable[time.Time]    `json:"started,omitzero"`
+	Finished                Nullable[time.Time]    `json:"finished,omitzero"`
+	Elapsed                 float64                `json:"elapsed"`
+	JobArgs                 string                 `json:"job_args"`
+	JobCwd                  string                 `json:"job_cwd"`
+	JobEnv                  map[string]string      `json:"job_env"`
+	JobExplanation          string                 `json:"job_explanation"`
+	ExecutionNode           string                 `json:"execution_node"`
+	ResultTraceback         string                 `json:"result_traceback"`
+	EventProcessingFinished bool                   `json:"event_processing_finished"`
+	JobTemplate             Nullable[int]          `json:"job_template,omitzero"`
+	PasswordsNeededToStart  []interface{}          `json:"passwords_needed_to_start"`
+	AskDiffModeOnLaunch     bool                   `json:"ask_diff_mode_on_launch"`
+	AskVariablesOnLaunch    bool                   `json:"ask_variables_on_launch"`
+	AskLimitOnLaunch        bool                   `json:"ask_limit_on_launch"`
+	AskTagsOnLaunch         bool                   `json:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch     bool                   `json:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch      bool                   `json:"ask_job_type_on_launch"`
+	AskVerbosityOnLaunch    bool                   `json:"ask_verbosity_on_launch"`
+	AskInventoryOnLaunch    bool                   `json:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch   bool                   `json:"ask_credential_on_launch"`
+	AllowSimultaneous       bool                   `json:"allow_simultaneous"`
+	Artifacts               map[string]interface{} `json:"artifacts"`
+	ScmRevision             string                 `json:"scm_revision"`
+	InstanceGroup           Nullable[int]          `json:"instance_group,omitzero"`
+	DiffMode                bool                   `json:"diff_mode"`
+	Credential              int                    `json:"credential"`
+	VaultCredential         Nullable[int]          `json:"vault_credential,omitzero"`
 }
 
 // Job represents the awx api job.
 type Job struct {
-	ID                      int               `json:"id"`
-	Type                    string            `json:"type"`
-	URL                     string            `json:"url"`
-	Related                 *Related          `json:"related"`
-	SummaryFields           *Summary          `json:"summary_fields"`
-	Created                 time.Time         `json:"created"`
-	Modified                time.Time         `json:"modified"`
-	Name                    string            `json:"name"`
-	Description             string            `json:"description"`
-	JobType                 string            `json:"job_type"`
-	Inventory               int               `json:"inventory"`
-	Project                 int               `json:"project"`
-	Playbook                string            `json:"playbook"`
-	Forks                   int               `json:"forks"`
-	Limit                   string            `json:"limit"`
-	Verbosity               int               `json:"verbosity"`
-	ExtraVars               string            `json:"extra_vars"`
-	JobTags                 string            `json:"job_tags"`
-	ForceHandlers           bool              `json:"force_handlers"`
-	SkipTags                string            `json:"skip_tags"`
-	StartAtTask             string            `json:"start_at_task"`
-	Timeout                 int               `json:"timeout"`
-	UseFactCache            bool              `json:"use_fact_cache"`
-	UnifiedJobTemplate      int               `json:"unified_job_template"`
-	LaunchType              string            `json:"launch_type"`
-	Status                  string            `json:"status"`
-	Failed                  bool              `json:"failed"`
-	Started                 time.Time         `json:"started"`
-	Finished                time.Time         `json:"finished"`
-	Elapsed                 float64           `json:"elapsed"`
-	JobArgs                 string            `json:"job_args"`
-	JobCwd                  string            `json:"job_cwd"`
-	JobEnv                  map[string]string `json:"job_env"`
-	JobExplanation          string            `json:"job_explanation"`
-	ExecutionNode           string            `json:"execution_node"`
-	ResultTraceback         string            `json:"result_traceback"`
-	EventProcessingFinished bool              `json:"event_processing_finished"`
-	JobTemplate             int               `json:"job_template"`
-	PasswordsNeededToStart  []interface{}     `json:"passwords_needed_to_start"`
-	AskDiffModeOnLaunch     bool              `json:"ask_diff_mode_on_launch"`
-	AskVariablesOnLaunch    bool              `json:"ask_variables_on_launch"`
-	AskLimitOnLaunch        bool              `json:"ask_limit_on_launch"`
-	AskTagsOnLaunch         bool              `json:"ask_tags_on_launch"`
-	AskSkipTagsOnLaunch     bool              `json:"ask_skip_tags_on_launch"`
-	AskJobTypeOnLaunch      bool              `json:"ask_job_type_on_launch"`
-	AskVerbosityOnLaunch    bool              `json:"ask_verbosity_on_launch"`
-	AskInventoryOnLaunch    bool              `json:"ask_inventory_on_launch"`
-	AskCredentialOnLaunch   bool              `json:"ask_credential_on_launch"`
-	AllowSimultaneous       bool              `json:"allow_simultaneous"`
-	Artifacts               map[string]string `json:"artifacts"`
-	ScmRevision             string            `json:"scm_revision"`
-	InstanceGroup           int               `json:"instance_group"`
-	DiffMode                bool              `json:"diff_mode"`
-	Credential              *Credential       `json:"credential"`
-	VaultCredential         interface{}       `json:"vault_credential"`
+	ID                      int                    `json:"id"`
+	Type                    string                 `json:"type"`
+	URL                     string                 `json:"url"`
+	Related                 *Related               `json:"related"`
+	SummaryFields           *Summary               `json:"summary_fields"`
+	Created                 time.Time              `json:"created"`
+	Modified                time.Time              `json:"modified"`
+	Name                    string                 `json:"name"`
+	Description             string                 `json:"description"`
+	JobType                 string                 `json:"job_type"`
+	Inventory               Nullable[int]          `json:"inventory,omitzero"`
+	Project                 Nullable[int]          `json:"project,omitzero"`
+	Playbook                string                 `json:"playbook"`
+	Forks                   int                    `json:"forks"`
+	Limit                   string                 `json:"limit"`
+	Verbosity               int                    `json:"verbosity"`
+	ExtraVars               ExtraVars              `json:"extra_vars"`
+	JobTags                 string                 `json:"job_tags"`
+	ForceHandlers           bool                   `json:"force_handlers"`
+	SkipTags                string                 `json:"skip_tags"`
+	StartAtTask             string                 `json:"start_at_task"`
+	Timeout                 int                    `json:"timeout"`
+	UseFactCache            bool                   `json:"use_fact_cache"`
+	UnifiedJobTemplate      int                    `json:"unified_job_template"`
+	LaunchType              string                 `json:"launch_type"`
+	Status                  string                 `json:"status"`
+	Failed                  bool                   `json:"failed"`
+	Started                 Nullable[time.Time]    `json:"started,omitzero"`
+	Finished                Nullable[time.Time]    `json:"finished,omitzero"`
+	CanceledOn              Nullable[time.Time]    `json:"canceled_on,omitzero"`
+	Elapsed                 float64                `json:"elapsed"`
+	JobArgs                 string                 `json:"job_args"`
+	JobCwd                  string                 `json:"job_cwd"`
+	JobEnv                  map[string]string      `json:"job_env"`
+	JobExplanation          string                 `json:"job_explanation"`
+	ExecutionNode           string                 `json:"execution_node"`
+	ResultTraceback         string                 `json:"result_traceback"`
+	EventProcessingFinished bool                   `json:"event_processing_finished"`
+	JobTemplate             Nullable[int]          `json:"job_template,omitzero"`
+	PasswordsNeededToStart  []interface{}          `json:"passwords_needed_to_start"`
+	AskDiffModeOnLaunch     bool                   `json:"ask_diff_mode_on_launch"`
+	AskVariablesOnLaunch    bool                   `json:"ask_variables_on_launch"`
+	AskLimitOnLaunch        bool                   `json:"ask_limit_on_launch"`
+	AskTagsOnLaunch         bool                   `json:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch     bool                   `json:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch      bool                   `json:"ask_job_type_on_launch"`
+	AskVerbosityOnLaunch    bool                   `json:"ask_verbosity_on_launch"`
+	AskInventoryOnLaunch    bool                   `json:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch   bool                   `json:"ask_credential_on_launch"`
+	AllowSimultaneous       bool                   `json:"allow_simultaneous"`
+	Artifacts               map[string]interface{} `json:"artifacts"`
+	ScmRevision             string                 `json:"scm_revision"`
+	InstanceGroup           Nullable[int]          `json:"instance_group,omitzero"`
+	DiffMode                bool                   `json:"diff_mode"`
+	Credential              *Credential            `json:"credential"`
+	VaultCredential         Nullable[int]          `json:"vault_credential,omitzero"`
 }
 
 // HostSummaryHost represents the awx api host summary host fields.
@@ -611,7 +688,7 @@ type EventRes struct {
 	End           string           `json:"end"`
 	AnsibleNoLog  bool             `json:"_ansible_no_log"`
 	Stdout        string           `json:"stdout"`
-	Cmd           string           `json:"cmd"`
+	Cmd           interface{}      `json:"cmd"`
 	Start         string           `json:"start"`
 	Delta         string           `json:"delta"`
 	Stderr        string           `json:"stderr"`
@@ -660,45 +737,42 @@ type JobEvent struct {
 	Changed       bool               `json:"changed"`
 	UUID          string             `json:"uuid"`
 	ParentUUID    string             `json:"parent_uuid"`
-
-	// FIXME: inconsistent value type from tower API, int, null
-	Host interface{} `json:"host"`
-
-	HostName  string      `json:"host_name"`
-	Parent    interface{} `json:"parent"`
-	Playbook  string      `json:"playbook"`
-	Play      string      `json:"play"`
-	Task      string      `json:"task"`
-	Role      string      `json:"role"`
-	Stdout    string      `json:"stdout"`
-	StartLine int         `json:"start_line"`
-	EndLine   int         `json:"end_line"`
-	Verbosity int         `json:"verbosity"`
+	Host          Nullable[int]      `json:"host,omitzero"`
+	HostName      string             `json:"host_name"`
+	Parent        Nullable[int]      `json:"parent,omitzero"`
+	Playbook      string             `json:"playbook"`
+	Play          string             `json:"play"`
+	Task          string             `json:"task"`
+	Role          string             `json:"role"`
+	Stdout        string             `json:"stdout"`
+	StartLine     int                `json:"start_line"`
+	EndLine       int                `json:"end_line"`
+	Verbosity     int                `json:"verbosity"`
 }
 
 // User represents an user
 type User struct {
-	ID              int         `json:"id"`
-	Type            int         `json:"type"`
-	URL             string      `json:"url"`
-	Related         *Related    `json:"related"`
-	SummaryFields   *Summary    `json:"summary_fields"`
-	Created         time.Time   `json:"created"`
-	Username        string      `json:"username"`
-	FirstName       string      `json:"first_name"`
-	LastName        string      `json:"last_name"`
-	Email           string      `json:"email"`
-	IsSuperUser     bool        `json:"is_superuser"`
-	IsSystemAuditor bool        `json:"is_system_auditor"`
-	Password        string      `json:"password"`
-	LdapDn          string      `json:"ldap_dn"`
-	ExternalAccount interface{} `json:"external_account"`
+	ID              int              `json:"id"`
+	Type            string           `json:"type"`
+	URL             string           `json:"url"`
+	Related         *Related         `json:"related"`
+	SummaryFields   *Summary         `json:"summary_fields"`
+	Created         time.Time        `json:"created"`
+	Username        string           `json:"username"`
+	FirstName       string           `json:"first_name"`
+	LastName        string           `json:"last_name"`
+	Email           string           `json:"email"`
+	IsSuperUser     bool             `json:"is_superuser"`
+	IsSystemAuditor bool             `json:"is_system_auditor"`
+	Password        string           `json:"password"`
+	LdapDn          string           `json:"ldap_dn"`
+	ExternalAccount Nullable[string] `json:"external_account,omitzero"`
 }
 
 // Group represents a group
 type Group struct {
 	ID                       int       `json:"id"`
-	Type                     int       `json:"type"`
+	Type                     string    `json:"type"`
 	URL                      string    `json:"url"`
 	Related                  *Related  `json:"related"`
 	SummaryFields            *Summary  `json:"summary_fields"`
@@ -718,39 +792,25 @@ type Group struct {
 
 // Host represents a host
 type Host struct {
-	ID                   int          `json:"id"`
-	Type                 string       `json:"type"`
-	URL                  string       `json:"url"`
-	Related              *Related     `json:"related"`
-	SummaryFields        *Summary     `json:"summary_fields"`
-	Created              time.Time    `json:"created"`
-	Modified             time.Time    `json:"modified"`
-	Name                 string       `json:"name"`
-	Description          string       `json:"description"`
-	Inventory            int          `json:"inventory"`
-	Enabled              bool         `json:"enabled"`
-	InstanceID           string       `json:"instance_id"`
-	Variables            string       `json:"variables"`
-	HasActiveFailures    bool         `json:"has_active_failures"`
-	HasInventorySources  bool         `json:"has_inventory_sources"`
-	LastJob              *Job         `json:"last_job"`
-	LastJobHostSummary   *HostSummary `json:"last_job_host_summary"`
-	InsightsSystemID     interface{}  `json:"insights_system_id"`
-	AnsibleFactsModified interface{}  `json:"ansible_facts_modified"`
-}
-
-type Organization struct {
-	Created          time.Time `json:"created"`
-	CustomVirtualenv string    `json:"custom_virtualenv"`
-	Description      string    `json:"description"`
-	ID               int       `json:"id"`
-	MaxHosts         int       `json:"max_hosts"`
-	Modified         time.Time `json:"modified"`
-	Name             string    `json:"name"`
-	Related          *Related  `json:"related"`
-	SummaryFields    *Summary  `json:"summary_fields"`
-	Type             string    `json:"type"`
-	URL              string    `json:"url"`
+	ID                   int                 `json:"id"`
+	Type                 string              `json:"type"`
+	URL                  string              `json:"url"`
+	Related              *Related            `json:"related"`
+	SummaryFields        *Summary            `json:"summary_fields"`
+	Created              time.Time           `json:"created"`
+	Modified             time.Time           `json:"modified"`
+	Name                 string              `json:"name"`
+	Description          string              `json:"description"`
+	Inventory            int                 `json:"inventory"`
+	Enabled              bool                `json:"enabled"`
+	InstanceID           string              `json:"instance_id"`
+	Variables            string              `json:"variables"`
+	HasActiveFailures    bool                `json:"has_active_failures"`
+	HasInventorySources  bool                `json:"has_inventory_sources"`
+	LastJob              Nullable[int]       `json:"last_job,omitzero"`
+	LastJobHostSummary   Nullable[int]       `json:"last_job_host_summary,omitzero"`
+	InsightsSystemID     Nullable[string]    `json:"insights_system_id,omitzero"`
+	AnsibleFactsModified Nullable[time.Time] `json:"ansible_facts_modified,omitzero"`
 }
 
 type SettingSummary struct {
@@ -775,211 +835,419 @@ type Team struct {
 }
 
 type InventorySource struct {
-	Created               time.Time   `json:"created"`
-	Credential            interface{} `json:"credential"`
-	CustomVirtualenv      interface{} `json:"custom_virtualenv"`
-	Description           string      `json:"description"`
-	GroupBy               string      `json:"group_by"`
-	ID                    int         `json:"id"`
-	EnabledVar            string      `json:"enabled_var"`
-	EnabledValue          string      `json:"enabled_value"`
-	ExecutionEnvironment  int         `json:"execution_environment"`
-	InstanceFilters       string      `json:"instance_filters"`
-	HostFilter            string      `json:"host_filter"`
-	Inventory             int         `json:"inventory"`
-	LastJobFailed         bool        `json:"last_job_failed"`
-	LastJobRun            interface{} `json:"last_job_run"`
-	LastUpdateFailed      bool        `json:"last_update_failed"`
-	LastUpdated           interface{} `json:"last_updated"`
-	Modified              time.Time   `json:"modified"`
-	Name                  string      `json:"name"`
-	NextJobRun            interface{} `json:"next_job_run"`
-	Overwrite             bool        `json:"overwrite"`
-	OverwriteVars         bool        `json:"overwrite_vars"`
-	Related               *Related    `json:"related"`
-	Source                string      `json:"source"`
-	SourcePath            string      `json:"source_path"`
-	SourceProject         int         `json:"source_project"`
-	SourceRegions         string      `json:"source_regions"`
-	SourceScript          interface{} `json:"source_script"`
-	SourceVars            string      `json:"source_vars"`
-	Status                string      `json:"status"`
-	SummaryFields         *Summary    `json:"summary_fields"`
-	Timeout               int         `json:"timeout"`
-	Type                  string      `json:"type"`
-	UpdateCacheTimeout    int         `json:"update_cache_timeout"`
-	UpdateOnLaunch        bool        `json:"update_on_launch"`
-	UpdateOnProjectUpdate bool        `json:"update_on_project_update"`
-	URL                   string      `json:"url"`
-	Verbosity             int         `json:"verbosity"`
+	Created               time.Time           `json:"created"`
+	Credential            Nullable[int]       `json:"credential,omitzero"`
+	CustomVirtualenv      Nullable[string]    `json:"custom_virtualenv,omitzero"`
+	Description           string              `json:"description"`
+	GroupBy               string              `json:"group_by"`
+	ID                    int                 `json:"id"`
+	EnabledVar            string              `json:"enabled_var"`
+	EnabledValue          string              `json:"enabled_value"`
+	ExecutionEnvironment  Nullable[int]       `json:"execution_environment,omitzero"`
+	InstanceFilters       string              `json:"instance_filters"`
+	HostFilter            string              `json:"host_filter"`
+	Inventory             int                 `json:"inventory"`
+	LastJobFailed         bool                `json:"last_job_failed"`
+	LastJobRun            Nullable[time.Time] `json:"last_job_run,omitzero"`
+	LastUpdateFailed      bool                `json:"last_update_failed"`
+	LastUpdated           Nullable[time.Time] `json:"last_updated,omitzero"`
+	Modified              time.Time           `json:"modified"`
+	Name                  string              `json:"name"`
+	NextJobRun            Nullable[time.Time] `json:"next_job_run,omitzero"`
+	Overwrite             bool                `json:"overwrite"`
+	OverwriteVars         bool                `json:"overwrite_vars"`
+	Related               *Related            `json:"related"`
+	Source                string              `json:"source"`
+	SourcePath            string              `json:"source_path"`
+	SourceProject         Nullable[int]       `json:"source_project,omitzero"`
+	SourceRegions         string              `json:"source_regions"`
+	SourceScript          Nullable[int]       `json:"source_script,omitzero"`
+	SourceVars            string              `json:"source_vars"`
+	Status                string              `json:"status"`
+	SummaryFields         *Summary            `json:"summary_fields"`
+	Timeout               int                 `json:"timeout"`
+	Type                  string              `json:"type"`
+	UpdateCacheTimeout    int                 `json:"update_cache_timeout"`
+	UpdateOnLaunch        bool                `json:"update_on_launch"`
+	UpdateOnProjectUpdate bool                `json:"update_on_project_update"`
+	URL                   string              `json:"url"`
+	Verbosity             int                 `json:"verbosity"`
 }
 
 type WorkflowJobTemplate struct {
-	ID                   int         `json:"id"`
-	Type                 string      `json:"type"`
-	URL                  string      `json:"url"`
-	Related              *Related    `json:"related"`
-	SummaryFields        *Summary    `json:"summary_fields"`
-	Created              time.Time   `json:"created"`
-	Modified             time.Time   `json:"modified"`
-	Name                 string      `json:"name"`
-	Description          string      `json:"description"`
-	LastJobRun           interface{} `json:"last_job_run"`
-	LastJobFailed        bool        `json:"last_job_failed"`
-	NextJobRun           interface{} `json:"next_job_run"`
-	Status               string      `json:"status"`
-	ExtraVars            string      `json:"extra_vars"`
-	Organization         int         `json:"organization"`
-	SurveyEnabled        bool        `json:"survey_enabled"`
-	AllowSimultaneous    bool        `json:"allow_simultaneous"`
-	AskVariablesOnLaunch bool        `json:"ask_variables_on_launch"`
-	Inventory            int         `json:"inventory"`
-	Limit                interface{} `json:"limit"`
-	ScmBranch            interface{} `json:"scm_branch"`
-	AskInventoryOnLaunch bool        `json:"ask_inventory_on_launch"`
-	AskScmBranchOnLaunch bool        `json:"ask_scm_branch_on_launch"`
-	AskLimitOnLaunch     bool        `json:"ask_limit_on_launch"`
-	WebhookService       string      `json:"webhook_service"`
-	WebhookCredential    interface{} `json:"webhook_credential"`
+	ID                   int                 `json:"id"`
+	Type                 string              `json:"type"`
+	URL                  string              `json:"url"`
+	Related              *Related            `json:"related"`
+	SummaryFields        *Summary            `json:"summary_fields"`
+	Created              time.Time           `json:"created"`
+	Modified             time.Time           `json:"modified"`
+	Name                 string              `json:"name"`
+	Description          string              `json:"description"`
+	LastJobRun           Nullable[time.Time] `json:"last_job_run,omitzero"`
+	LastJobFailed        bool                `json:"last_job_failed"`
+	NextJobRun           Nullable[time.Time] `json:"next_job_run,omitzero"`
+	Status               string              `json:"status"`
+	ExtraVars            ExtraVars           `json:"extra_vars"`
+	Organization         Nullable[int]       `json:"organization,omitzero"`
+	SurveyEnabled        bool                `json:"survey_enabled"`
+	AllowSimultaneous    bool                `json:"allow_simultaneous"`
+	AskVariablesOnLaunch bool                `json:"ask_variables_on_launch"`
+	Inventory            Nullable[int]       `json:"inventory,omitzero"`
+	Limit                Nullable[string]    `json:"limit,omitzero"`
+	ScmBranch            Nullable[string]    `json:"scm_branch,omitzero"`
+	AskInventoryOnLaunch bool                `json:"ask_inventory_on_launch"`
+	AskScmBranchOnLaunch bool                `json:"ask_scm_branch_on_launch"`
+	AskLimitOnLaunch     bool                `json:"ask_limit_on_launch"`
+	AskLabelsOnLaunch    bool                `json:"ask_labels_on_launch"`
+	WebhookService       string              `json:"webhook_service"`
+	WebhookCredential    Nullable[int]       `json:"webhook_credential,omitzero"`
 }
 
 // WorkflowJobLaunch represents the awx api workflow job launch.
 type WorkflowJobLaunch struct {
-	ID                  int               `json:"id"`
-	Type                string            `json:"type"`
-	URL                 string            `json:"url"`
-	Related             *Related          `json:"related"`
-	SummaryFields       *Summary          `json:"summary_fields"`
-	Created             time.Time         `json:"created"`
-	Modified            time.Time         `json:"modified"`
-	Name                string            `json:"name"`
-	Description         string            `json:"description"`
-	UnifiedJobTemplate  int               `json:"unified_job_templates"`
-	LaunchType          string            `json:"launch_type"`
-	Status              string            `json:"status"`
-	Failed              bool              `json:"failed"`
-	Started             time.Time         `json:"started"`
-	Finished            time.Time         `json:"finished"`
-	CanceledOn          time.Time         `json:"canceled_on"`
-	Elapsed             float64           `json:"elapsed"`
-	JobArgs             string            `json:"job_args"`
-	JobCwd              string            `json:"job_cwd"`
-	JobEnv              map[string]string `json:"job_env"`
-	JobExplanation      string            `json:"job_explanation"`
-	ResultTraceback     string            `json:"result_traceback"`
-	WorkUnitID          string            `json:"work_unit_id"`
-	WorkflowJobTemplate int               `json:"workflow_job_template"`
-	ExtraVars           string            `json:"extra_vars"`
-	AllowSimultaneous   bool              `json:"allow_simultaneous"`
-	JobTemplate         int               `json:"job_template"`
-	IsSlicedJob         bool              `json:"is_sliced_job"`
-	Inventory           int               `json:"inventory"`
-	Limit               interface{}       `json:"limit"`
-	ScmBranch           interface{}       `json:"scm_branch"`
-	WebhookService      string            `json:"webhook_service"`
-	WebhookCredential   interface{}       `json:"webhook_credential"`
-	JobTags             string            `json:"job_tags"`
-	SkipTags            string            `json:"skip_tags"`
+	ID                  int                 `json:"id"`
+	Type                string              `json:"type"`
+	URL                 string              `json:"url"`
+	Related             *Related            `json:"related"`
+	SummaryFields       *Summary            `json:"summary_fields"`
+	Created             time.Time           `json:"created"`
+	Modified            time.Time           `json:"modified"`
+	Name                string              `json:"name"`
+	Description         string              `json:"description"`
+	UnifiedJobTemplate  int                 `json:"unified_job_template"`
+	LaunchType          string              `json:"launch_type"`
+	Status              string              `json:"status"`
+	Failed              bool                `json:"failed"`
+	Started             Nullable[time.Time] `json:"started,omitzero"`
+	Finished            Nullable[time.Time] `json:"finished,omitzero"`
+	CanceledOn          Nullable[time.Time] `json:"canceled_on,omitzero"`
+	Elapsed             float64             `json:"elapsed"`
+	JobArgs             string              `json:"job_args"`
+	JobCwd              string              `json:"job_cwd"`
+	JobEnv              map[string]string   `json:"job_env"`
+	JobExplanation      string              `json:"job_explanation"`
+	ResultTraceback     string              `json:"result_traceback"`
+	WorkUnitID          string              `json:"work_unit_id"`
+	WorkflowJobTemplate Nullable[int]       `json:"workflow_job_template,omitzero"`
+	ExtraVars           ExtraVars           `json:"extra_vars"`
+	AllowSimultaneous   bool                `json:"allow_simultaneous"`
+	JobTemplate         Nullable[int]       `json:"job_template,omitzero"`
+	IsSlicedJob         bool                `json:"is_sliced_job"`
+	Inventory           Nullable[int]       `json:"inventory,omitzero"`
+	Limit               Nullable[string]    `json:"limit,omitzero"`
+	ScmBranch           Nullable[string]    `json:"scm_branch,omitzero"`
+	WebhookService      string              `json:"webhook_service"`
+	WebhookCredential   Nullable[int]       `json:"webhook_credential,omitzero"`
+	JobTags             string              `json:"job_tags"`
+	SkipTags            string              `json:"skip_tags"`
 }
 
 // WorkflowJob represents the awx api workflow job.
 type WorkflowJob struct {
-	ID                  int               `json:"id"`
-	Type                string            `json:"type"`
-	URL                 string            `json:"url"`
-	Related             *Related          `json:"related"`
-	SummaryFields       *Summary          `json:"summary_fields"`
-	Created             time.Time         `json:"created"`
-	Modified            time.Time         `json:"modified"`
-	Name                string            `json:"name"`
-	Description         string            `json:"description"`
-	UnifiedJobTemplate  int               `json:"unified_job_templates"`
-	LaunchType          string            `json:"launch_type"`
-	Status              string            `json:"status"`
-	Failed              bool              `json:"failed"`
-	Started             time.Time         `json:"started"`
-	Finished            time.Time         `json:"finished"`
-	CanceledOn          time.Time         `json:"canceled_on"`
-	Elapsed             float64           `json:"elapsed"`
-	JobArgs             string            `json:"job_args"`
-	JobCwd              string            `json:"job_cwd"`
-	JobEnv              map[string]string `json:"job_env"`
-	JobExplanation      string            `json:"job_explanation"`
-	ResultTraceback     string            `json:"result_traceback"`
-	WorkUnitID          string            `json:"work_unit_id"`
-	WorkflowJobTemplate int               `json:"workflow_job_template"`
-	ExtraVars           string            `json:"extra_vars"`
-	AllowSimultaneous   bool              `json:"allow_simultaneous"`
-	JobTemplate         int               `json:"job_template"`
-	IsSlicedJob         bool              `json:"is_sliced_job"`
-	Inventory           int               `json:"inventory"`
-	Limit               interface{}       `json:"limit"`
-	ScmBranch           interface{}       `json:"scm_branch"`
-	WebhookService      string            `json:"webhook_service"`
-	WebhookCredential   interface{}       `json:"webhook_credential"`
-	JobTags             string            `json:"job_tags"`
-	SkipTags            string            `json:"skip_tags"`
-}
-
-type WorkflowJobTemplateNode struct {
-	ID                     int       `json:"id"`
-	Type                   string    `json:"type"`
-	URL                    string    `json:"url"`
-	Related                *Related  `json:"related"`
-	SummaryFields          *Summary  `json:"summary_fields"`
-	Created                time.Time `json:"created"`
-	Modified               time.Time `json:"modified"`
-	ExtraData              string    `json:"extra_data"`
-	Inventory              int       `json:"inventory"`
-	ScmBranch              string    `json:"scm_branch"`
-	JobType                string    `json:"job_type"`
-	JobTags                string    `json:"job_tags"`
-	SkipTags               string    `json:"skip_tags"`
-	Limit                  string    `json:"limit"`
-	DiffMode               string    `json:"diff_mode"`
-	Verbosity              int       `json:"verbosity"`
-	WorkflowJobTemplate    int       `json:"workflow_job_template"`
-	UnifiedJobTemplate     int       `json:"unified_job_template"`
-	SuccessNodes           []int     `json:"success_nodes"`
-	FailureNodes           []int     `json:"failure_nodes"`
-	AlwaysNodes            []int     `json:"always_nodes"`
-	AllParentsMustConverge bool      `json:"all_parents_must_converge"`
-	Identifier             string    `json:"identifier"`
+	ID                  int                 `json:"id"`
+	Type                string              `json:"type"`
+	URL                 string              `json:"url"`
+	Related             *Related            `json:"related"`
+	SummaryFields       *Summary            `json:"summary_fields"`
+	Created             time.Time           `json:"created"`
+	Modified            time.Time           `json:"modified"`
+	Name                string              `json:"name"`
+	Description         string              `json:"description"`
+	UnifiedJobTemplate  int                 `json:"unified_job_template"`
+	LaunchType          string              `json:"launch_type"`
+	Status              string              `json:"status"`
+	Failed              bool                `json:"failed"`
+	Started             Nullable[time.Time] `json:"started,omitzero"`
+	Finished            Nullable[time.Time] `json:"finished,omitzero"`
+	CanceledOn          Nullable[time.Time] `json:"canceled_on,omitzero"`
+	Elapsed             float64             `json:"elapsed"`
+	JobArgs             string              `json:"job_args"`
+	JobCwd              string              `json:"job_cwd"`
+	JobEnv              map[string]string   `json:"job_env"`
+	JobExplanation      string              `json:"job_explanation"`
+	ResultTraceback     string              `json:"result_traceback"`
+	WorkUnitID          string              `json:"work_unit_id"`
+	WorkflowJobTemplate Nullable[int]       `json:"workflow_job_template,omitzero"`
+	ExtraVars           ExtraVars           `json:"extra_vars"`
+	AllowSimultaneous   bool                `json:"allow_simultaneous"`
+	JobTemplate         Nullable[int]       `json:"job_template,omitzero"`
+	IsSlicedJob         bool                `json:"is_sliced_job"`
+	Inventory           Nullable[int]       `json:"inventory,omitzero"`
+	Limit               Nullable[string]    `json:"limit,omitzero"`
+	ScmBranch           Nullable[string]    `json:"scm_branch,omitzero"`
+	WebhookService      string              `json:"webhook_service"`
+	WebhookCredential   Nullable[int]       `json:"webhook_credential,omitzero"`
+	JobTags             string              `json:"job_tags"`
+	SkipTags            string              `json:"skip_tags"`
 }
 
 type Schedule struct {
 	ID                 int                    `json:"id"`
+	Related            *Related               `json:"related"`
 	Name               string                 `json:"name"`
 	Description        string                 `json:"description"`
 	Rrule              string                 `json:"rrule"`
 	Enabled            bool                   `json:"enabled"`
 	UnifiedJobTemplate int                    `json:"unified_job_template"`
-	Inventory          int                    `json:"inventory"`
+	Inventory          Nullable[int]          `json:"inventory,omitzero"`
 	ExtraData          map[string]interface{} `json:"extra_data"`
 }
 
-type NotificationTemplate struct {
-	ID                        int                    `json:"id"`
-	Name                      string                 `json:"name"`
-	Description               string                 `json:"description"`
-	Organization              string                 `json:"organization"`
-	NotificationType          string                 `json:"notification_type"`
-	NotificationConfiguration map[string]interface{} `json:"notification_configuration"`
+// Notification represents the awx api notification, a notification sent, or
+// being sent, through a notification template.
+type Notification struct {
+	ID                   int         `json:"id"`
+	Type                 string      `json:"type"`
+	URL                  string      `json:"url"`
+	Related              *Related    `json:"related"`
+	SummaryFields        *Summary    `json:"summary_fields"`
+	Created              time.Time   `json:"created"`
+	Modified             time.Time   `json:"modified"`
+	NotificationTemplate int         `json:"notification_template"`
+	Error                string      `json:"error"`
+	Status               string      `json:"status"`
+	NotificationsSent    int         `json:"notifications_sent"`
+	NotificationType     string      `json:"notification_type"`
+	Recipients           string      `json:"recipients"`
+	Subject              string      `json:"subject"`
+	Body                 interface{} `json:"body"`
+}
+
+// ActivityStream represents the awx api activity stream entry, a change of a
+// resource, or of an association between two resources, made by an actor.
+type ActivityStream struct {
+	ID                int                             `json:"id"`
+	Type              string                          `json:"type"`
+	URL               string                          `json:"url"`
+	Timestamp         time.Time                       `json:"timestamp"`
+	Operation         string                          `json:"operation"`
+	Changes           map[string]ActivityStreamChange `json:"changes"`
+	Object1           string                          `json:"object1"`
+	Object2           string                          `json:"object2"`
+	ObjectAssociation string                          `json:"object_association"`
+	ActionNode        string                          `json:"action_node"`
+	ObjectType        string                          `json:"object_type"`
+	// Actor is the user who made the change, nil for changes made by the system.
+	Actor *ByUserSummary `json:"actor,omitempty"`
+	// Objects1 and Objects2 are the resources of the types Object1 and Object2.
+	Objects1 []*ActivityStreamObject `json:"objects1,omitempty"`
+	Objects2 []*ActivityStreamObject `json:"objects2,omitempty"`
+}
+
+// ActivityStreamChange is the change of a field, Old is nil for a created
+// resource and New is nil for a deleted one.
+type ActivityStreamChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// ActivityStreamObject is the summary of a resource of an activity stream entry.
+type ActivityStreamObject struct {
+	ID int `json:"id"`
+	// Name is the resource name, the username for users.
+	Name   string                 `json:"name"`
+	Fields map[string]interface{} `json:"fields"`
 }
 
 type ExecutionEnvironment struct {
-	ID            int       `json:"id"`
-	Type          string    `json:"type"`
-	URL           string    `json:"url"`
-	Related       *Related  `json:"related"`
-	SummaryFields *Summary  `json:"summary_fields"`
-	Created       time.Time `json:"created"`
-	Modified      time.Time `json:"modified"`
-	Name          string    `json:"name"`
-	Description   string    `json:"description"`
-	Organization  int       `json:"organization"`
-	Image         string    `json:"image"`
-	Managed       bool      `json:"managed"`
-	Credential    int       `json:"credential"`
-	Pull          string    `json:"pull"`
+	ID            int           `json:"id"`
+	Type          string        `json:"type"`
+	URL           string        `json:"url"`
+	Related       *Related      `json:"related"`
+	SummaryFields *Summary      `json:"summary_fields"`
+	Created       time.Time     `json:"created"`
+	Modified      time.Time     `json:"modified"`
+	Name          string        `json:"name"`
+	Description   string        `json:"description"`
+	Organization  Nullable[int] `json:"organization,omitzero"`
+	Image         string        `json:"image"`
+	Managed       bool          `json:"managed"`
+	Credential    Nullable[int] `json:"credential,omitzero"`
+	Pull          string        `json:"pull"`
+}
+
+// SystemJobTemplate represents the awx api system job template.
+type SystemJobTemplate struct {
+	ID                   int                 `json:"id"`
+	Type                 string              `json:"type"`
+	URL                  string              `json:"url"`
+	Related              *Related            `json:"related"`
+	SummaryFields        *Summary            `json:"summary_fields"`
+	Created              time.Time           `json:"created"`
+	Modified             time.Time           `json:"modified"`
+	Name                 string              `json:"name"`
+	Description          string              `json:"description"`
+	LastJobRun           Nullable[time.Time] `json:"last_job_run,omitzero"`
+	LastJobFailed        bool                `json:"last_job_failed"`
+	NextJobRun           Nullable[time.Time] `json:"next_job_run,omitzero"`
+	Status               string              `json:"status"`
+	ExecutionEnvironment Nullable[int]       `json:"execution_environment,omitzero"`
+	JobType              string              `json:"job_type"`
+}
+
+// SystemJob represents the awx api system job.
+type SystemJob struct {
+	ID                      int                 `json:"id"`
+	Type                    string              `json:"type"`
+	URL                     string              `json:"url"`
+	Related                 *Related            `json:"related"`
+	SummaryFields           *Summary            `json:"summary_fields"`
+	Created                 time.Time           `json:"created"`
+	Modified                time.Time           `json:"modified"`
+	Name                    string              `json:"name"`
+	Description             string              `json:"description"`
+	UnifiedJobTemplate      int                 `json:"unified_job_template"`
+	LaunchType              string              `json:"launch_type"`
+	Status                  string              `json:"status"`
+	ExecutionEnvironment    Nullable[int]       `json:"execution_environment,omitzero"`
+	Failed                  bool                `json:"failed"`
+	Started                 Nullable[time.Time] `json:"started,omitzero"`
+	Finished                Nullable[time.Time] `json:"finished,omitzero"`
+	CanceledOn              Nullable[time.Time] `json:"canceled_on,omitzero"`
+	Elapsed                 float64             `json:"elapsed"`
+	JobArgs                 string              `json:"job_args"`
+	JobCwd                  string              `json:"job_cwd"`
+	JobEnv                  map[string]string   `json:"job_env"`
+	JobExplanation          string              `json:"job_explanation"`
+	ExecutionNode           string              `json:"execution_node"`
+	ResultTraceback         string              `json:"result_traceback"`
+	EventProcessingFinished bool                `json:"event_processing_finished"`
+	SystemJobTemplate       Nullable[int]       `json:"system_job_template,omitzero"`
+	JobType                 string              `json:"job_type"`
+	ExtraVars               string              `json:"extra_vars"`
+	ResultStdout            string              `json:"result_stdout"`
+}
+
+// SystemJobLaunch represents the awx api system job launch.
+type SystemJobLaunch struct {
+	SystemJob
+	SystemJobID   int                    `json:"system_job"`
+	IgnoredFields map[string]interface{} `json:"ignored_fields"`
+}
+
+// SystemJobEvent represents the awx api system job event.
+type SystemJobEvent struct {
+	ID           int       `json:"id"`
+	Type         string    `json:"type"`
+	URL          string    `json:"url"`
+	Related      *Related  `json:"related"`
+	Created      time.Time `json:"created"`
+	Modified     time.Time `json:"modified"`
+	SystemJob    int       `json:"system_job"`
+	Event        string    `json:"event"`
+	Counter      int       `json:"counter"`
+	EventDisplay string    `json:"event_display"`
+	Failed       bool      `json:"failed"`
+	Changed      bool      `json:"changed"`
+	UUID         string    `json:"uuid"`
+	Stdout       string    `json:"stdout"`
+	StartLine    int       `json:"start_line"`
+	EndLine      int       `json:"end_line"`
+	Verbosity    int       `json:"verbosity"`
+}
+
+// Config represents the awx api config, the settings of the AWX installation.
+type Config struct {
+	TimeZone          string       `json:"time_zone"`
+	LicenseInfo       *LicenseInfo `json:"license_info"`
+	Version           string       `json:"version"`
+	Eula              string       `json:"eula"`
+	AnalyticsStatus   string       `json:"analytics_status"`
+	BecomeMethods     [][]string   `json:"become_methods"`
+	UINext            bool         `json:"ui_next"`
+	ProjectBaseDir    string       `json:"project_base_dir"`
+	ProjectLocalPaths []string     `json:"project_local_paths"`
+	CustomVirtualenvs []string     `json:"custom_virtualenvs"`
+}
+
+// LicenseInfo represents the awx api license info, the dates are unix timestamps.
+type LicenseInfo struct {
+	LicenseType          string `json:"license_type"`
+	ValidKey             bool   `json:"valid_key"`
+	SubscriptionName     string `json:"subscription_name"`
+	ProductName          string `json:"product_name"`
+	SKU                  string `json:"sku"`
+	SupportLevel         string `json:"support_level"`
+	PoolID               string `json:"pool_id"`
+	InstanceCount        int    `json:"instance_count"`
+	CurrentInstances     int    `json:"current_instances"`
+	FreeInstances        int    `json:"free_instances"`
+	AutomatedInstances   int    `json:"automated_instances"`
+	AutomatedSince       int64  `json:"automated_since"`
+	LicenseDate          int64  `json:"license_date"`
+	TimeRemaining        int64  `json:"time_remaining"`
+	GracePeriodRemaining int64  `json:"grace_period_remaining"`
+	Trial                bool   `json:"trial"`
+	Compliant            bool   `json:"compliant"`
+	DateWarning          bool   `json:"date_warning"`
+	DateExpired          bool   `json:"date_expired"`
+}
+
+// Dashboard represents the awx api dashboard, the counts of the resources.
+type Dashboard struct {
+	Related          map[string]string          `json:"related"`
+	Inventories      *DashboardCount            `json:"inventories"`
+	InventorySources map[string]*DashboardCount `json:"inventory_sources"`
+	Groups           *DashboardCount            `json:"groups"`
+	Hosts            *DashboardCount            `json:"hosts"`
+	Projects         *DashboardCount            `json:"projects"`
+	ScmTypes         map[string]*DashboardCount `json:"scm_types"`
+	Users            *DashboardCount            `json:"users"`
+	Organizations    *DashboardCount            `json:"organizations"`
+	Teams            *DashboardCount            `json:"teams"`
+	Credentials      *DashboardCount            `json:"credentials"`
+	JobTemplates     *DashboardCount            `json:"job_templates"`
+}
+
+// DashboardCount represents the awx api dashboard count of a resource type,
+// the failure counts are only set for the resource types which can fail.
+type DashboardCount struct {
+	URL                      string `json:"url"`
+	FailuresURL              string `json:"failures_url"`
+	Label                    string `json:"label"`
+	Total                    int    `json:"total"`
+	Failed                   int    `json:"failed"`
+	TotalWithInventorySource int    `json:"total_with_inventory_source"`
+	JobFailed                int    `json:"job_failed"`
+	InventoryFailed          int    `json:"inventory_failed"`
+}
+
+// DashboardJobsGraph represents the awx api dashboard jobs graph, the number
+// of successful and failed jobs per day.
+type DashboardJobsGraph struct {
+	Successful []DashboardGraphPoint `json:"successful"`
+	Failed     []DashboardGraphPoint `json:"failed"`
+}
+
+// DashboardGraphPoint is the number of jobs of a day of a dashboard graph.
+type DashboardGraphPoint struct {
+	Time  time.Time
+	Count int
+}
+
+// HostMetric represents the awx api host metric, the automation history of a
+// host name, whichever inventories it belongs to.
+type HostMetric struct {
+	ID                int                 `json:"id"`
+	URL               string              `json:"url"`
+	Hostname          string              `json:"hostname"`
+	FirstAutomation   time.Time           `json:"first_automation"`
+	LastAutomation    time.Time           `json:"last_automation"`
+	LastDeleted       Nullable[time.Time] `json:"last_deleted,omitzero"`
+	AutomatedCounter  int                 `json:"automated_counter"`
+	DeletedCounter    int                 `json:"deleted_counter"`
+	Deleted           bool                `json:"deleted"`
+	UsedInInventories Nullable[int]       `json:"used_in_inventories,omitzero"`
+}
+
+// HostMetricSummaryMonthly represents the awx api monthly host metric summary.
+type HostMetricSummaryMonthly struct {
+	ID                     int    `json:"id"`
+	Date                   string `json:"date"`
+	LicenseConsumed        int    `json:"license_consumed"`
+	LicenseCapacity        int    `json:"license_capacity"`
+	HostsAdded             int    `json:"hosts_added"`
+	HostsDeleted           int    `json:"hosts_deleted"`
+	IndirectlyManagedHosts int    `json:"indirectly_managed_hosts"`
 }
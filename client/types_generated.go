@@ -0,0 +1,241 @@
+// Code generated by internal/gen from the AWX OPTIONS metadata. DO NOT EDIT.
+
+package awx
+
+import "time"
+
+// Inventory represents the awx api inventory.
+type Inventory struct {
+	ID                           int              `json:"id"`
+	Type                         string           `json:"type"`
+	URL                          string           `json:"url"`
+	Related                      *Related         `json:"related"`
+	SummaryFields                *Summary         `json:"summary_fields"`
+	Created                      time.Time        `json:"created"`
+	Modified                     time.Time        `json:"modified"`
+	Name                         string           `json:"name"`
+	Description                  string           `json:"description"`
+	Organization                 int              `json:"organization"`
+	Kind                         string           `json:"kind"` // one of "", "smart", "constructed"
+	HostFilter                   Nullable[string] `json:"host_filter,omitzero"`
+	Variables                    string           `json:"variables"`
+	HasActiveFailures            bool             `json:"has_active_failures"`
+	TotalHosts                   int              `json:"total_hosts"`
+	HostsWithActiveFailures      int              `json:"hosts_with_active_failures"`
+	TotalGroups                  int              `json:"total_groups"`
+	HasInventorySources          bool             `json:"has_inventory_sources"`
+	TotalInventorySources        int              `json:"total_inventory_sources"`
+	InventorySourcesWithFailures int              `json:"inventory_sources_with_failures"`
+	PendingDeletion              bool             `json:"pending_deletion"`
+	PreventInstanceGroupFallback bool             `json:"prevent_instance_group_fallback"`
+	OrganizationID               int              `json:"organization_id"`
+	GroupsWithActiveFailures     int              `json:"groups_with_active_failures"`
+	InsightsCredential           Nullable[int]    `json:"insights_credential,omitzero"`
+}
+
+// inventorySchema holds the required fields and the max lengths of the inventory payloads.
+var inventorySchema = schema{
+	"name":         {Type: "string", MaxLength: maxLength(512), Required: true},
+	"organization": {Required: true},
+}
+
+// JobTemplate represents the awx api job template.
+type JobTemplate struct {
+	ID                              int                 `json:"id"`
+	Type                            string              `json:"type"`
+	URL                             string              `json:"url"`
+	Related                         *Related            `json:"related"`
+	SummaryFields                   *Summary            `json:"summary_fields"`
+	Created                         time.Time           `json:"created"`
+	Modified                        time.Time           `json:"modified"`
+	Name                            string              `json:"name"`
+	Description                     string              `json:"description"`
+	JobType                         string              `json:"job_type"` // one of "run", "check"
+	Inventory                       Nullable[int]       `json:"inventory,omitzero"`
+	Project                         Nullable[int]       `json:"project,omitzero"`
+	Playbook                        string              `json:"playbook"`
+	ScmBranch                       string              `json:"scm_branch"`
+	Forks                           int                 `json:"forks"`
+	Limit                           string              `json:"limit"`
+	Verbosity                       int                 `json:"verbosity"` // one of 0, 1, 2, 3, 4, 5
+	ExtraVars                       ExtraVars           `json:"extra_vars"`
+	JobTags                         string              `json:"job_tags"`
+	ForceHandlers                   bool                `json:"force_handlers"`
+	SkipTags                        string              `json:"skip_tags"`
+	StartAtTask                     string              `json:"start_at_task"`
+	Timeout                         int                 `json:"timeout"`
+	UseFactCache                    bool                `json:"use_fact_cache"`
+	Organization                    Nullable[int]       `json:"organization,omitzero"`
+	LastJobRun                      Nullable[time.Time] `json:"last_job_run,omitzero"`
+	LastJobFailed                   bool                `json:"last_job_failed"`
+	NextJobRun                      Nullable[time.Time] `json:"next_job_run,omitzero"`
+	Status                          string              `json:"status"` // one of "new", "pending", "waiting", "running", "successful", "failed", "error", "canceled", "never updated"
+	ExecutionEnvironment            Nullable[int]       `json:"execution_environment,omitzero"`
+	HostConfigKey                   string              `json:"host_config_key"`
+	AskScmBranchOnLaunch            bool                `json:"ask_scm_branch_on_launch"`
+	AskDiffModeOnLaunch             bool                `json:"ask_diff_mode_on_launch"`
+	AskVariablesOnLaunch            bool                `json:"ask_variables_on_launch"`
+	AskLimitOnLaunch                bool                `json:"ask_limit_on_launch"`
+	AskTagsOnLaunch                 bool                `json:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch             bool                `json:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch              bool                `json:"ask_job_type_on_launch"`
+	AskVerbosityOnLaunch            bool                `json:"ask_verbosity_on_launch"`
+	AskInventoryOnLaunch            bool                `json:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch           bool                `json:"ask_credential_on_launch"`
+	AskExecutionEnvironmentOnLaunch bool                `json:"ask_execution_environment_on_launch"`
+	AskLabelsOnLaunch               bool                `json:"ask_labels_on_launch"`
+	AskForksOnLaunch                bool                `json:"ask_forks_on_launch"`
+	AskJobSliceCountOnLaunch        bool                `json:"ask_job_slice_count_on_launch"`
+	AskTimeoutOnLaunch              bool                `json:"ask_timeout_on_launch"`
+	AskInstanceGroupsOnLaunch       bool                `json:"ask_instance_groups_on_launch"`
+	SurveyEnabled                   bool                `json:"survey_enabled"`
+	BecomeEnabled                   bool                `json:"become_enabled"`
+	DiffMode                        bool                `json:"diff_mode"`
+	AllowSimultaneous               bool                `json:"allow_simultaneous"`
+	CustomVirtualenv                Nullable[string]    `json:"custom_virtualenv,omitzero"`
+	JobSliceCount                   int                 `json:"job_slice_count"`
+	WebhookService                  string              `json:"webhook_service"` // one of "github", "gitlab", "bitbucket_dc"
+	WebhookCredential               Nullable[int]       `json:"webhook_credential,omitzero"`
+	PreventInstanceGroupFallback    bool                `json:"prevent_instance_group_fallback"`
+	Credential                      int                 `json:"credential"`
+	VaultCredential                 Nullable[int]       `json:"vault_credential,omitzero"`
+}
+
+// jobTemplateSchema holds the required fields and the max lengths of the job template payloads.
+var jobTemplateSchema = schema{
+	"name":            {Type: "string", MaxLength: maxLength(512), Required: true},
+	"playbook":        {Type: "string", MaxLength: maxLength(1024)},
+	"scm_branch":      {Type: "string", MaxLength: maxLength(1024)},
+	"job_tags":        {Type: "string", MaxLength: maxLength(1024)},
+	"skip_tags":       {Type: "string", MaxLength: maxLength(1024)},
+	"start_at_task":   {Type: "string", MaxLength: maxLength(1024)},
+	"host_config_key": {Type: "string", MaxLength: maxLength(1024)},
+}
+
+// NotificationTemplate represents the awx api notification template.
+type NotificationTemplate struct {
+	ID                        int                    `json:"id"`
+	Type                      string                 `json:"type"`
+	URL                       string                 `json:"url"`
+	Related                   *Related               `json:"related"`
+	SummaryFields             *Summary               `json:"summary_fields"`
+	Created                   time.Time              `json:"created"`
+	Modified                  time.Time              `json:"modified"`
+	Name                      string                 `json:"name"`
+	Description               string                 `json:"description"`
+	Organization              int                    `json:"organization"`
+	NotificationType          string                 `json:"notification_type"` // one of "email", "grafana", "irc", "mattermost", "pagerduty", "rocketchat", "slack", "twilio", "webhook", "awssns"
+	NotificationConfiguration map[string]interface{} `json:"notification_configuration"`
+	Messages                  *NotificationMessages  `json:"messages"`
+}
+
+// notificationTemplateSchema holds the required fields and the max lengths of the notification template payloads.
+var notificationTemplateSchema = schema{
+	"name":              {Type: "string", MaxLength: maxLength(512), Required: true},
+	"organization":      {Required: true},
+	"notification_type": {Required: true},
+}
+
+// Organization represents the awx api organization.
+type Organization struct {
+	ID                 int           `json:"id"`
+	Type               string        `json:"type"`
+	URL                string        `json:"url"`
+	Related            *Related      `json:"related"`
+	SummaryFields      *Summary      `json:"summary_fields"`
+	Created            time.Time     `json:"created"`
+	Modified           time.Time     `json:"modified"`
+	Name               string        `json:"name"`
+	Description        string        `json:"description"`
+	MaxHosts           int           `json:"max_hosts"`
+	CustomVirtualenv   string        `json:"custom_virtualenv"`
+	DefaultEnvironment Nullable[int] `json:"default_environment,omitzero"`
+}
+
+// organizationSchema holds the required fields and the max lengths of the organization payloads.
+var organizationSchema = schema{
+	"name": {Type: "string", MaxLength: maxLength(512), Required: true},
+}
+
+// Project represents the awx api project.
+type Project struct {
+	ID                            int                 `json:"id"`
+	Type                          string              `json:"type"`
+	URL                           string              `json:"url"`
+	Related                       *Related            `json:"related"`
+	SummaryFields                 *Summary            `json:"summary_fields"`
+	Created                       time.Time           `json:"created"`
+	Modified                      time.Time           `json:"modified"`
+	Name                          string              `json:"name"`
+	Description                   string              `json:"description"`
+	LocalPath                     string              `json:"local_path"`
+	ScmType                       string              `json:"scm_type"` // one of "", "git", "svn", "insights", "archive"
+	ScmURL                        string              `json:"scm_url"`
+	ScmBranch                     string              `json:"scm_branch"`
+	ScmRefspec                    string              `json:"scm_refspec"`
+	ScmClean                      bool                `json:"scm_clean"`
+	ScmTrackSubmodules            bool                `json:"scm_track_submodules"`
+	ScmDeleteOnUpdate             bool                `json:"scm_delete_on_update"`
+	Credential                    Nullable[int]       `json:"credential,omitzero"`
+	Timeout                       int                 `json:"timeout"`
+	ScmRevision                   string              `json:"scm_revision"`
+	LastJobRun                    Nullable[time.Time] `json:"last_job_run,omitzero"`
+	LastJobFailed                 bool                `json:"last_job_failed"`
+	NextJobRun                    Nullable[time.Time] `json:"next_job_run,omitzero"`
+	Status                        string              `json:"status"` // one of "new", "pending", "waiting", "running", "successful", "failed", "error", "canceled", "never updated", "ok", "missing"
+	Organization                  Nullable[int]       `json:"organization,omitzero"`
+	ScmUpdateOnLaunch             bool                `json:"scm_update_on_launch"`
+	ScmUpdateCacheTimeout         int                 `json:"scm_update_cache_timeout"`
+	AllowOverride                 bool                `json:"allow_override"`
+	CustomVirtualenv              string              `json:"custom_virtualenv"`
+	DefaultEnvironment            Nullable[int]       `json:"default_environment,omitzero"`
+	SignatureValidationCredential Nullable[int]       `json:"signature_validation_credential,omitzero"`
+	LastUpdateFailed              bool                `json:"last_update_failed"`
+	LastUpdated                   Nullable[time.Time] `json:"last_updated,omitzero"`
+	ScmDeleteOnNextUpdate         bool                `json:"scm_delete_on_next_update"`
+}
+
+// projectSchema holds the required fields and the max lengths of the project payloads.
+var projectSchema = schema{
+	"name":        {Type: "string", MaxLength: maxLength(512), Required: true},
+	"scm_url":     {Type: "string", MaxLength: maxLength(1024)},
+	"scm_branch":  {Type: "string", MaxLength: maxLength(256)},
+	"scm_refspec": {Type: "string", MaxLength: maxLength(1024)},
+}
+
+// WorkflowJobTemplateNode represents the awx api workflow job template node.
+type WorkflowJobTemplateNode struct {
+	ID                     int              `json:"id"`
+	Type                   string           `json:"type"`
+	URL                    string           `json:"url"`
+	Related                *Related         `json:"related"`
+	SummaryFields          *Summary         `json:"summary_fields"`
+	Created                time.Time        `json:"created"`
+	Modified               time.Time        `json:"modified"`
+	ExtraData              ExtraVars        `json:"extra_data"`
+	Inventory              Nullable[int]    `json:"inventory,omitzero"`
+	ScmBranch              Nullable[string] `json:"scm_branch,omitzero"`
+	JobType                Nullable[string] `json:"job_type,omitzero"` // one of "run", "check"
+	JobTags                Nullable[string] `json:"job_tags,omitzero"`
+	SkipTags               Nullable[string] `json:"skip_tags,omitzero"`
+	Limit                  Nullable[string] `json:"limit,omitzero"`
+	DiffMode               Nullable[bool]   `json:"diff_mode,omitzero"`
+	Verbosity              Nullable[int]    `json:"verbosity,omitzero"` // one of 0, 1, 2, 3, 4, 5
+	ExecutionEnvironment   Nullable[int]    `json:"execution_environment,omitzero"`
+	Forks                  Nullable[int]    `json:"forks,omitzero"`
+	JobSliceCount          Nullable[int]    `json:"job_slice_count,omitzero"`
+	Timeout                Nullable[int]    `json:"timeout,omitzero"`
+	WorkflowJobTemplate    int              `json:"workflow_job_template"`
+	UnifiedJobTemplate     Nullable[int]    `json:"unified_job_template,omitzero"`
+	SuccessNodes           []int            `json:"success_nodes"`
+	FailureNodes           []int            `json:"failure_nodes"`
+	AlwaysNodes            []int            `json:"always_nodes"`
+	AllParentsMustConverge bool             `json:"all_parents_must_converge"`
+	Identifier             string           `json:"identifier"`
+}
+
+// workflowJobTemplateNodeSchema holds the required fields and the max lengths of the workflow job template node payloads.
+var workflowJobTemplateNodeSchema = schema{
+	"workflow_job_template": {Required: true},
+	"identifier":            {Type: "string", MaxLength: maxLength(512)},
+}
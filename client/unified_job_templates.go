@@ -0,0 +1,212 @@
+package awx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+)
+
+// UnifiedJobTemplatesService implements awx unified job templates apis.
+type UnifiedJobTemplatesService struct {
+	client *Client
+}
+
+// ListUnifiedJobTemplatesResponse represents `ListUnifiedJobTemplates` endpoint response.
+type ListUnifiedJobTemplatesResponse struct {
+	Pagination
+	Results AnyJobTemplates `json:"results"`
+}
+
+const unifiedJobTemplatesAPIEndpoint = "/api/v2/unified_job_templates/"
+
+// ListUnifiedJobTemplates shows list of awx templates of every kind, job
+// templates, workflow job templates, projects, inventory sources and system
+// job templates, filtered and ordered by the common fields.
+func (u *UnifiedJobTemplatesService) ListUnifiedJobTemplates(params url.Values) ([]AnyJobTemplate, *ListUnifiedJobTemplatesResponse, error) {
+	result := new(ListUnifiedJobTemplatesResponse)
+	resp, err := u.client.Requester.GetJSON(unifiedJobTemplatesAPIEndpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// AnyJobTemplate is a template of any kind, e.g. `*JobTemplate`, `*Project`
+// or `*InventorySource`, as listed by the unified job templates endpoint.
+type AnyJobTemplate interface {
+	// Unified returns the fields shared by every kind of template.
+	Unified() UnifiedJobTemplate
+}
+
+// AnyJobTemplates is a list of templates of any kind, each one decoded according
+// to its `type`. A template of an unknown type is decoded as a `*UnifiedJobTemplate`.
+type AnyJobTemplates []AnyJobTemplate
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AnyJobTemplates) UnmarshalJSON(data []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+
+	templates := make(AnyJobTemplates, 0, len(raws))
+	for _, raw := range raws {
+		template, err := decodeAnyJobTemplate(raw)
+		if err != nil {
+			return err
+		}
+		templates = append(templates, template)
+	}
+	*a = templates
+	return nil
+}
+
+func decodeAnyJobTemplate(raw json.RawMessage) (AnyJobTemplate, error) {
+	var header struct {
+		ID   int    `json:"id"`
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, err
+	}
+
+	template := newAnyJobTemplate(header.Type)
+	if err := json.Unmarshal(raw, template); err != nil {
+		return nil, fmt.Errorf("%s %d: %w", header.Type, header.ID, err)
+	}
+	return template, nil
+}
+
+// newAnyJobTemplate returns an empty template of a kind, a `*UnifiedJobTemplate`
+// for the unknown kinds.
+func newAnyJobTemplate(kind string) AnyJobTemplate {
+	switch kind {
+	case "job_template":
+		return new(JobTemplate)
+	case "workflow_job_template":
+		return new(WorkflowJobTemplate)
+	case "project":
+		return new(Project)
+	case "inventory_source":
+		return new(InventorySource)
+	case "system_job_template":
+		return new(SystemJobTemplate)
+	}
+	return new(UnifiedJobTemplate)
+}
+
+// kindType implements kindedList, the strict decoding checks each template against its kind.
+func (AnyJobTemplates) kindType(kind string) reflect.Type {
+	return reflect.TypeOf(newAnyJobTemplate(kind))
+}
+
+// Unified implements AnyJobTemplate.
+func (t *UnifiedJobTemplate) Unified() UnifiedJobTemplate {
+	return *t
+}
+
+// Unified implements AnyJobTemplate.
+func (t *JobTemplate) Unified() UnifiedJobTemplate {
+	return UnifiedJobTemplate{
+		ID:             t.ID,
+		Type:           t.Type,
+		URL:            t.URL,
+		Related:        t.Related,
+		SummaryFields:  t.SummaryFields,
+		Created:        t.Created,
+		Modified:       t.Modified,
+		Name:           t.Name,
+		Description:    t.Description,
+		LastJobRun:     t.LastJobRun,
+		LastJobFailed:  t.LastJobFailed,
+		NextJobRun:     t.NextJobRun,
+		Status:         t.Status,
+		UnifiedJobType: "job",
+	}
+}
+
+// Unified implements AnyJobTemplate.
+func (t *WorkflowJobTemplate) Unified() UnifiedJobTemplate {
+	return UnifiedJobTemplate{
+		ID:             t.ID,
+		Type:           t.Type,
+		URL:            t.URL,
+		Related:        t.Related,
+		SummaryFields:  t.SummaryFields,
+		Created:        t.Created,
+		Modified:       t.Modified,
+		Name:           t.Name,
+		Description:    t.Description,
+		LastJobRun:     t.LastJobRun,
+		LastJobFailed:  t.LastJobFailed,
+		NextJobRun:     t.NextJobRun,
+		Status:         t.Status,
+		UnifiedJobType: "workflow_job",
+	}
+}
+
+// Unified implements AnyJobTemplate.
+func (t *Project) Unified() UnifiedJobTemplate {
+	return UnifiedJobTemplate{
+		ID:             t.ID,
+		Type:           t.Type,
+		URL:            t.URL,
+		Related:        t.Related,
+		SummaryFields:  t.SummaryFields,
+		Created:        t.Created,
+		Modified:       t.Modified,
+		Name:           t.Name,
+		Description:    t.Description,
+		LastJobRun:     t.LastJobRun,
+		LastJobFailed:  t.LastJobFailed,
+		NextJobRun:     t.NextJobRun,
+		Status:         t.Status,
+		UnifiedJobType: "project_update",
+	}
+}
+
+// Unified implements AnyJobTemplate.
+func (t *InventorySource) Unified() UnifiedJobTemplate {
+	return UnifiedJobTemplate{
+		ID:             t.ID,
+		Type:           t.Type,
+		URL:            t.URL,
+		Related:        t.Related,
+		SummaryFields:  t.SummaryFields,
+		Created:        t.Created,
+		Modified:       t.Modified,
+		Name:           t.Name,
+		Description:    t.Description,
+		LastJobRun:     t.LastJobRun,
+		LastJobFailed:  t.LastJobFailed,
+		NextJobRun:     t.NextJobRun,
+		Status:         t.Status,
+		UnifiedJobType: "inventory_update",
+	}
+}
+
+// Unified implements AnyJobTemplate.
+func (t *SystemJobTemplate) Unified() UnifiedJobTemplate {
+	return UnifiedJobTemplate{
+		ID:             t.ID,
+		Type:           t.Type,
+		URL:            t.URL,
+		Related:        t.Related,
+		SummaryFields:  t.SummaryFields,
+		Created:        t.Created,
+		Modified:       t.Modified,
+		Name:           t.Name,
+		Description:    t.Description,
+		LastJobRun:     t.LastJobRun,
+		LastJobFailed:  t.LastJobFailed,
+		NextJobRun:     t.NextJobRun,
+		Status:         t.Status,
+		UnifiedJobType: "system_job",
+	}
+}
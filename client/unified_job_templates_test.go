@@ -0,0 +1,54 @@
+package awx
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestAnyJobTemplatesUnmarshal(t *testing.T) {
+	tests := []struct {
+		entry   string
+		want    string
+		invalid bool
+	}{
+		{`{"id": 1, "type": "job_template", "forks": 5}`, "*awx.JobTemplate", false},
+		{`{"id": 2, "type": "workflow_job_template"}`, "*awx.WorkflowJobTemplate", false},
+		{`{"id": 3, "type": "project"}`, "*awx.Project", false},
+		{`{"id": 4, "type": "inventory_source"}`, "*awx.InventorySource", false},
+		{`{"id": 5, "type": "system_job_template"}`, "*awx.SystemJobTemplate", false},
+		{`{"id": 6, "type": "workflow_approval_template"}`, "*awx.UnifiedJobTemplate", false},
+		{`{"id": 7, "type": "job_template", "forks": "five"}`, "", true},
+	}
+	for _, tt := range tests {
+		var templates AnyJobTemplates
+		err := json.Unmarshal([]byte("["+tt.entry+"]"), &templates)
+		if tt.invalid {
+			if err == nil || !strings.Contains(err.Error(), "job_template 7: ") {
+				t.Errorf("%s: expected a decode error, got %v", tt.entry, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", tt.entry, err)
+			continue
+		}
+		if len(templates) != 1 || fmt.Sprintf("%T", templates[0]) != tt.want {
+			t.Errorf("%s: %T, want %s", tt.entry, templates[0], tt.want)
+			continue
+		}
+		var header struct {
+			ID int `json:"id"`
+		}
+		json.Unmarshal([]byte(tt.entry), &header)
+		if templates[0].Unified().ID != header.ID {
+			t.Errorf("%s: unified %+v", tt.entry, templates[0].Unified())
+		}
+	}
+
+	var templates AnyJobTemplates
+	if err := json.Unmarshal([]byte(`[{"id": "x", "type": "project"}]`), &templates); err == nil {
+		t.Errorf("expected an error for a template without valid shared fields, got %v", templates)
+	}
+}
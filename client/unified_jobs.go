@@ -0,0 +1,321 @@
+package awx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+// UnifiedJobsService implements awx unified jobs apis.
+type UnifiedJobsService struct {
+	client *Client
+}
+
+// ListUnifiedJobsResponse represents `ListUnifiedJobs` endpoint response.
+type ListUnifiedJobsResponse struct {
+	Pagination
+	Results AnyJobs `json:"results"`
+}
+
+const unifiedJobsAPIEndpoint = "/api/v2/unified_jobs/"
+
+// ListUnifiedJobs shows list of awx jobs of every kind, filtered and ordered
+// by the common fields, e.g. `status` or `-finished`.
+func (u *UnifiedJobsService) ListUnifiedJobs(params url.Values) ([]AnyJob, *ListUnifiedJobsResponse, error) {
+	result := new(ListUnifiedJobsResponse)
+	resp, err := u.client.Requester.GetJSON(unifiedJobsAPIEndpoint, result, params)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// AnyJob is a job of any kind, e.g. `*Job`, `*WorkflowJob` or `*ProjectUpdate`,
+// as listed by the unified jobs endpoint. Use a type switch to reach the
+// fields of a kind of job.
+type AnyJob interface {
+	// Unified returns the fields shared by every kind of job.
+	Unified() UnifiedJob
+	// JobID returns the ID of the job.
+	JobID() int
+	// JobStatus returns the status of the job, e.g. `running` or `successful`.
+	JobStatus() string
+	// StartedAt, FinishedAt and CanceledAt return when the job started,
+	// finished and was canceled, they are unset until then.
+	StartedAt() Nullable[time.Time]
+	FinishedAt() Nullable[time.Time]
+	CanceledAt() Nullable[time.Time]
+	// IsFailed reports whether the job failed.
+	IsFailed() bool
+}
+
+// AnyJobs is a list of jobs of any kind, each one decoded according to its `type`.
+// A job of an unknown type, e.g. a workflow approval, is decoded as a `*UnifiedJob`.
+type AnyJobs []AnyJob
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AnyJobs) UnmarshalJSON(data []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+
+	jobs := make(AnyJobs, 0, len(raws))
+	for _, raw := range raws {
+		job, err := decodeAnyJob(raw)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+	}
+	*a = jobs
+	return nil
+}
+
+func decodeAnyJob(raw json.RawMessage) (AnyJob, error) {
+	var header struct {
+		ID   int    `json:"id"`
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, err
+	}
+
+	job := newAnyJob(header.Type)
+	if err := json.Unmarshal(raw, job); err != nil {
+		return nil, fmt.Errorf("%s %d: %w", header.Type, header.ID, err)
+	}
+	return job, nil
+}
+
+// newAnyJob returns an empty job of a kind, a `*UnifiedJob` for the unknown kinds.
+func newAnyJob(kind string) AnyJob {
+	switch kind {
+	case "job":
+		return new(Job)
+	case "workflow_job":
+		return new(WorkflowJob)
+	case "project_update":
+		return new(ProjectUpdate)
+	case "inventory_update":
+		return new(InventoryUpdate)
+	case "system_job":
+		return new(SystemJob)
+	case "ad_hoc_command":
+		return new(AdHocCommand)
+	}
+	return new(UnifiedJob)
+}
+
+// kindType implements kindedList, the strict decoding checks each job against its kind.
+func (AnyJobs) kindType(kind string) reflect.Type {
+	return reflect.TypeOf(newAnyJob(kind))
+}
+
+// Unified implements AnyJob.
+func (j *UnifiedJob) Unified() UnifiedJob {
+	return *j
+}
+
+// Unified implements AnyJob.
+func (j *Job) Unified() UnifiedJob {
+	return UnifiedJob{
+		ID:                 j.ID,
+		Type:               j.Type,
+		URL:                j.URL,
+		Related:            j.Related,
+		SummaryFields:      j.SummaryFields,
+		Created:            j.Created,
+		Modified:           j.Modified,
+		Name:               j.Name,
+		Description:        j.Description,
+		UnifiedJobTemplate: j.UnifiedJobTemplate,
+		LaunchType:         j.LaunchType,
+		Status:             j.Status,
+		Failed:             j.Failed,
+		Started:            j.Started,
+		Finished:           j.Finished,
+		CanceledOn:         j.CanceledOn,
+		Elapsed:            j.Elapsed,
+		JobExplanation:     j.JobExplanation,
+		ExecutionNode:      j.ExecutionNode,
+	}
+}
+
+// Unified implements AnyJob.
+func (j *WorkflowJob) Unified() UnifiedJob {
+	return UnifiedJob{
+		ID:                 j.ID,
+		Type:               j.Type,
+		URL:                j.URL,
+		Related:            j.Related,
+		SummaryFields:      j.SummaryFields,
+		Created:            j.Created,
+		Modified:           j.Modified,
+		Name:               j.Name,
+		Description:        j.Description,
+		UnifiedJobTemplate: j.UnifiedJobTemplate,
+		LaunchType:         j.LaunchType,
+		Status:             j.Status,
+		Failed:             j.Failed,
+		Started:            j.Started,
+		Finished:           j.Finished,
+		CanceledOn:         j.CanceledOn,
+		Elapsed:            j.Elapsed,
+		JobExplanation:     j.JobExplanation,
+	}
+}
+
+// Unified implements AnyJob.
+func (j *ProjectUpdate) Unified() UnifiedJob {
+	return UnifiedJob{
+		ID:                 j.ID,
+		Type:               j.Type,
+		URL:                j.URL,
+		Related:            j.Related,
+		SummaryFields:      j.SummaryFields,
+		Created:            j.Created,
+		Modified:           j.Modified,
+		Name:               j.Name,
+		Description:        j.Description,
+		UnifiedJobTemplate: j.UnifiedJobTemplate,
+		LaunchType:         j.LaunchType,
+		Status:             j.Status,
+		Failed:             j.Failed,
+		Started:            j.Started,
+		Finished:           j.Finished,
+		CanceledOn:         j.CanceledOn,
+		Elapsed:            j.Elapsed,
+		JobExplanation:     j.JobExplanation,
+		ExecutionNode:      j.ExecutionNode,
+	}
+}
+
+// Unified implements AnyJob.
+func (j *InventoryUpdate) Unified() UnifiedJob {
+	return UnifiedJob{
+		ID:                 j.ID,
+		Type:               j.Type,
+		URL:                j.URL,
+		Related:            j.Related,
+		SummaryFields:      j.SummaryFields,
+		Created:            j.Created,
+		Modified:           j.Modified,
+		Name:               j.Name,
+		Description:        j.Description,
+		UnifiedJobTemplate: j.UnifiedJobTemplate,
+		LaunchType:         j.LaunchType,
+		Status:             j.Status,
+		Failed:             j.Failed,
+		Started:            j.Started,
+		Finished:           j.Finished,
+		CanceledOn:         j.CanceledOn,
+		Elapsed:            j.Elapsed,
+		JobExplanation:     j.JobExplanation,
+		ExecutionNode:      j.ExecutionNode,
+	}
+}
+
+// Unified implements AnyJob.
+func (j *SystemJob) Unified() UnifiedJob {
+	return UnifiedJob{
+		ID:                 j.ID,
+		Type:               j.Type,
+		URL:                j.URL,
+		Related:            j.Related,
+		SummaryFields:      j.SummaryFields,
+		Created:            j.Created,
+		Modified:           j.Modified,
+		Name:               j.Name,
+		Description:        j.Description,
+		UnifiedJobTemplate: j.UnifiedJobTemplate,
+		LaunchType:         j.LaunchType,
+		Status:             j.Status,
+		Failed:             j.Failed,
+		Started:            j.Started,
+		Finished:           j.Finished,
+		CanceledOn:         j.CanceledOn,
+		Elapsed:            j.Elapsed,
+		JobExplanation:     j.JobExplanation,
+		ExecutionNode:      j.ExecutionNode,
+	}
+}
+
+// Unified implements AnyJob.
+func (j *AdHocCommand) Unified() UnifiedJob {
+	return UnifiedJob{
+		ID:             j.ID,
+		Type:           j.Type,
+		URL:            j.URL,
+		Related:        j.Related,
+		SummaryFields:  j.SummaryFields,
+		Created:        j.Created,
+		Modified:       j.Modified,
+		Name:           j.Name,
+		Description:    j.Description,
+		LaunchType:     j.LaunchType,
+		Status:         j.Status,
+		Failed:         j.Failed,
+		Started:        j.Started,
+		Finished:       j.Finished,
+		CanceledOn:     j.CanceledOn,
+		Elapsed:        j.Elapsed,
+		JobExplanation: j.JobExplanation,
+		ExecutionNode:  j.ExecutionNode,
+	}
+}
+
+func (j *UnifiedJob) JobID() int      { return j.ID }
+func (j *Job) JobID() int             { return j.ID }
+func (j *WorkflowJob) JobID() int     { return j.ID }
+func (j *ProjectUpdate) JobID() int   { return j.ID }
+func (j *InventoryUpdate) JobID() int { return j.ID }
+func (j *SystemJob) JobID() int       { return j.ID }
+func (j *AdHocCommand) JobID() int    { return j.ID }
+
+func (j *UnifiedJob) JobStatus() string      { return j.Status }
+func (j *Job) JobStatus() string             { return j.Status }
+func (j *WorkflowJob) JobStatus() string     { return j.Status }
+func (j *ProjectUpdate) JobStatus() string   { return j.Status }
+func (j *InventoryUpdate) JobStatus() string { return j.Status }
+func (j *SystemJob) JobStatus() string       { return j.Status }
+func (j *AdHocCommand) JobStatus() string    { return j.Status }
+
+func (j *UnifiedJob) StartedAt() Nullable[time.Time]      { return j.Started }
+func (j *Job) StartedAt() Nullable[time.Time]             { return j.Started }
+func (j *WorkflowJob) StartedAt() Nullable[time.Time]     { return j.Started }
+func (j *ProjectUpdate) StartedAt() Nullable[time.Time]   { return j.Started }
+func (j *InventoryUpdate) StartedAt() Nullable[time.Time] { return j.Started }
+func (j *SystemJob) StartedAt() Nullable[time.Time]       { return j.Started }
+func (j *AdHocCommand) StartedAt() Nullable[time.Time]    { return j.Started }
+
+func (j *UnifiedJob) FinishedAt() Nullable[time.Time]      { return j.Finished }
+func (j *Job) FinishedAt() Nullable[time.Time]             { return j.Finished }
+func (j *WorkflowJob) FinishedAt() Nullable[time.Time]     { return j.Finished }
+func (j *ProjectUpdate) FinishedAt() Nullable[time.Time]   { return j.Finished }
+func (j *InventoryUpdate) FinishedAt() Nullable[time.Time] { return j.Finished }
+func (j *SystemJob) FinishedAt() Nullable[time.Time]       { return j.Finished }
+func (j *AdHocCommand) FinishedAt() Nullable[time.Time]    { return j.Finished }
+
+func (j *UnifiedJob) CanceledAt() Nullable[time.Time]      { return j.CanceledOn }
+func (j *Job) CanceledAt() Nullable[time.Time]             { return j.CanceledOn }
+func (j *WorkflowJob) CanceledAt() Nullable[time.Time]     { return j.CanceledOn }
+func (j *ProjectUpdate) CanceledAt() Nullable[time.Time]   { return j.CanceledOn }
+func (j *InventoryUpdate) CanceledAt() Nullable[time.Time] { return j.CanceledOn }
+func (j *SystemJob) CanceledAt() Nullable[time.Time]       { return j.CanceledOn }
+func (j *AdHocCommand) CanceledAt() Nullable[time.Time]    { return j.CanceledOn }
+
+func (j *UnifiedJob) IsFailed() bool      { return j.Failed }
+func (j *Job) IsFailed() bool             { return j.Failed }
+func (j *WorkflowJob) IsFailed() bool     { return j.Failed }
+func (j *ProjectUpdate) IsFailed() bool   { return j.Failed }
+func (j *InventoryUpdate) IsFailed() bool { return j.Failed }
+func (j *SystemJob) IsFailed() bool       { return j.Failed }
+func (j *AdHocCommand) IsFailed() bool    { return j.Failed }
@@ -0,0 +1,113 @@
+package awx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAnyJobsUnmarshal(t *testing.T) {
+	tests := []struct {
+		entry   string
+		want    string
+		invalid bool
+	}{
+		{`{"id": 1, "type": "job", "forks": 5}`, "*awx.Job", false},
+		{`{"id": 2, "type": "workflow_job"}`, "*awx.WorkflowJob", false},
+		{`{"id": 3, "type": "project_update"}`, "*awx.ProjectUpdate", false},
+		{`{"id": 4, "type": "inventory_update"}`, "*awx.InventoryUpdate", false},
+		{`{"id": 5, "type": "system_job"}`, "*awx.SystemJob", false},
+		{`{"id": 6, "type": "ad_hoc_command"}`, "*awx.AdHocCommand", false},
+		{`{"id": 7, "type": "workflow_approval"}`, "*awx.UnifiedJob", false},
+		{`{"id": 8, "type": "job", "forks": "five"}`, "", true},
+	}
+	for _, tt := range tests {
+		var jobs AnyJobs
+		err := json.Unmarshal([]byte("["+tt.entry+"]"), &jobs)
+		if tt.invalid {
+			if err == nil || !strings.Contains(err.Error(), "job 8: ") {
+				t.Errorf("%s: expected a decode error, got %v", tt.entry, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", tt.entry, err)
+			continue
+		}
+		if len(jobs) != 1 || fmt.Sprintf("%T", jobs[0]) != tt.want {
+			t.Errorf("%s: %T, want %s", tt.entry, jobs[0], tt.want)
+			continue
+		}
+		var header struct {
+			ID int `json:"id"`
+		}
+		json.Unmarshal([]byte(tt.entry), &header)
+		if jobs[0].Unified().ID != header.ID || jobs[0].JobID() != header.ID {
+			t.Errorf("%s: unified %+v", tt.entry, jobs[0].Unified())
+		}
+	}
+
+	var jobs AnyJobs
+	if err := json.Unmarshal([]byte(`[{"id": "x", "type": "job"}]`), &jobs); err == nil {
+		t.Errorf("expected an error for a job without valid shared fields, got %v", jobs)
+	}
+}
+
+func TestAnyJobAccessors(t *testing.T) {
+	var jobs AnyJobs
+	content := `[
+		{"id": 1, "type": "job", "status": "canceled", "failed": true, "started": "2024-05-01T10:00:00Z", "canceled_on": "2024-05-01T10:05:00Z"},
+		{"id": 2, "type": "project_update", "status": "successful", "started": "2024-05-01T10:00:00Z", "finished": "2024-05-01T10:01:00Z"},
+		{"id": 3, "type": "workflow_approval", "status": "pending"}
+	]`
+	if err := json.Unmarshal([]byte(content), &jobs); err != nil {
+		t.Fatal(err)
+	}
+	started := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		status   string
+		failed   bool
+		started  Nullable[time.Time]
+		finished Nullable[time.Time]
+		canceled Nullable[time.Time]
+	}{
+		{status: "canceled", failed: true, started: NewNullable(started), canceled: NewNullable(started.Add(5 * time.Minute))},
+		{status: "successful", started: NewNullable(started), finished: NewNullable(started.Add(time.Minute))},
+		{status: "pending"},
+	}
+	for i, tt := range tests {
+		job := jobs[i]
+		if job.JobID() != i+1 || job.JobStatus() != tt.status || job.IsFailed() != tt.failed {
+			t.Errorf("job %d: %d %s %t", i+1, job.JobID(), job.JobStatus(), job.IsFailed())
+		}
+		if !reflect.DeepEqual(job.StartedAt(), tt.started) || !reflect.DeepEqual(job.FinishedAt(), tt.finished) || !reflect.DeepEqual(job.CanceledAt(), tt.canceled) {
+			t.Errorf("job %d: started %v, finished %v, canceled %v", i+1, job.StartedAt(), job.FinishedAt(), job.CanceledAt())
+		}
+		if unified := job.Unified(); !reflect.DeepEqual(unified.CanceledOn, tt.canceled) {
+			t.Errorf("job %d: unified canceled %v", i+1, unified.CanceledOn)
+		}
+	}
+}
+
+func TestListUnifiedJobsStrict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 2, "results": [{"id": 1, "type": "job", "forks": 5}, {"id": 2, "type": "project_update", "forks": 5}]}`)
+	}))
+	defer server.Close()
+	a := newAWX(newTestClient(server))
+	a.SetStrictDecoding(true)
+
+	_, _, err := a.UnifiedJobsService.ListUnifiedJobs(url.Values{})
+	var unknown *UnknownFieldsError
+	if !errors.As(err, &unknown) || !reflect.DeepEqual(unknown.Fields, map[string][]string{"awx.ProjectUpdate": {"forks"}}) {
+		t.Errorf("expected the project update forks to be unknown, got %v", err)
+	}
+}
@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // UserService implements awx Users apis.
@@ -25,7 +27,7 @@ type ListUsersEntitlementsResponse struct {
 const usersAPIEndpoint = "/api/v2/users/"
 
 // ListUsers shows list of awx Users.
-func (u *UserService) ListUsers(params map[string]string) ([]*User, *ListUsersResponse, error) {
+func (u *UserService) ListUsers(params url.Values) ([]*User, *ListUsersResponse, error) {
 	result := new(ListUsersResponse)
 	resp, err := u.client.Requester.GetJSON(usersAPIEndpoint, result, params)
 	if err != nil {
@@ -40,7 +42,7 @@ func (u *UserService) ListUsers(params map[string]string) ([]*User, *ListUsersRe
 }
 
 // CreateUser creates an awx User.
-func (u *UserService) CreateUser(data map[string]interface{}, params map[string]string) (*User, error) {
+func (u *UserService) CreateUser(data map[string]interface{}, params url.Values) (*User, error) {
 	mandatoryFields = []string{"username", "password", "first_name", "last_name", "email"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -70,7 +72,7 @@ func (u *UserService) CreateUser(data map[string]interface{}, params map[string]
 }
 
 // UpdateUser update an awx user.
-func (u *UserService) UpdateUser(id int, data map[string]interface{}, params map[string]string) (*User, error) {
+func (u *UserService) UpdateUser(id int, data map[string]interface{}, params url.Values) (*User, error) {
 	result := new(User)
 	endpoint := fmt.Sprintf("%s%d", usersAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -108,7 +110,7 @@ func (u *UserService) DeleteUser(id int) (*User, error) {
 }
 
 // GetUser read an awx User.
-func (u *UserService) GetUserByID(id int, params map[string]string) (*User, error) {
+func (u *UserService) GetUserByID(id int, params url.Values) (*User, error) {
 	result := new(User)
 	endpoint := fmt.Sprintf("%s%d", usersAPIEndpoint, id)
 	resp, err := u.client.Requester.GetJSON(endpoint, result, params)
@@ -124,7 +126,18 @@ func (u *UserService) GetUserByID(id int, params map[string]string) (*User, erro
 	return result, nil
 }
 
-func (u *UserService) ListUserRoleEntitlements(id int, params map[string]string) ([]*ApplyRole, *ListUsersEntitlementsResponse, error) {
+// GetByNamedURL shows the details of a user by its named url identifier, see `NamedURLUser`.
+func (u *UserService) GetByNamedURL(identifier string, params url.Values) (*User, error) {
+	return getByNamedURL[User](u.client, usersAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only user named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (u *UserService) FindByName(ctx context.Context, name string, scope Scope) (*User, error) {
+	return findByName[User](ctx, u.client, "user", usersAPIEndpoint, "username", name, scope, noScope)
+}
+
+func (u *UserService) ListUserRoleEntitlements(id int, params url.Values) ([]*ApplyRole, *ListUsersEntitlementsResponse, error) {
 	result := new(ListUsersEntitlementsResponse)
 	endpoint := fmt.Sprintf("%s%d/roles/", usersAPIEndpoint, id)
 	resp, err := u.client.Requester.GetJSON(endpoint, result, params)
@@ -138,7 +151,7 @@ func (u *UserService) ListUserRoleEntitlements(id int, params map[string]string)
 	return result.Results, result, nil
 }
 
-func (u *UserService) UpdateUserRoleEntitlement(id int, data map[string]interface{}, params map[string]string) (interface{}, error) {
+func (u *UserService) UpdateUserRoleEntitlement(id int, data map[string]interface{}, params url.Values) (interface{}, error) {
 	result := new(interface{})
 	endpoint := fmt.Sprintf("%s%d/roles/", usersAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -0,0 +1,207 @@
+package awx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Version represents an AWX server version as reported by the ping api.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+	Raw   string
+}
+
+// ParseVersion parses a version string such as `21.14.0` or `23.5.1.dev12+g1a2b3c4`.
+// Anything after the numeric `major.minor.patch` prefix is ignored.
+func ParseVersion(s string) (Version, error) {
+	v := Version{Raw: s}
+	parts := strings.SplitN(strings.TrimPrefix(strings.TrimSpace(s), "v"), ".", 4)
+	if len(parts) < 2 {
+		return v, fmt.Errorf("invalid awx version %q", s)
+	}
+
+	numbers := make([]int, 3)
+	for i := 0; i < len(parts) && i < 3; i++ {
+		digits := parts[i]
+		if end := strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }); end >= 0 {
+			digits = digits[:end]
+		}
+		n, err := strconv.Atoi(digits)
+		if err != nil {
+			if i < 2 {
+				return v, fmt.Errorf("invalid awx version %q", s)
+			}
+			break
+		}
+		numbers[i] = n
+	}
+
+	v.Major, v.Minor, v.Patch = numbers[0], numbers[1], numbers[2]
+	return v, nil
+}
+
+// IsZero reports whether the version is unknown.
+func (v Version) IsZero() bool {
+	return v.Major == 0 && v.Minor == 0 && v.Patch == 0
+}
+
+// Compare returns -1, 0 or 1 when v is lower than, equal to or greater than o.
+func (v Version) Compare(o Version) int {
+	switch {
+	case v.Major != o.Major:
+		return compareInt(v.Major, o.Major)
+	case v.Minor != o.Minor:
+		return compareInt(v.Minor, o.Minor)
+	default:
+		return compareInt(v.Patch, o.Patch)
+	}
+}
+
+// AtLeast reports whether v is greater than or equal to o.
+func (v Version) AtLeast(o Version) bool {
+	return v.Compare(o) >= 0
+}
+
+// String returns the short `major.minor` form used in error messages.
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+func compareInt(a, b int) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// awxEquivalent maps Ansible Tower (3.x) and Automation Controller (4.x)
+// version numbers onto the AWX release they were built from, so every
+// feature only needs an AWX minimum version.
+func (v Version) awxEquivalent() Version {
+	switch v.Major {
+	case 3:
+		// Tower 3.8 ships AWX 15, no gated feature predates it.
+		return Version{Major: 15}
+	case 4:
+		switch {
+		case v.Minor >= 5:
+			return Version{Major: 23}
+		case v.Minor == 4:
+			return Version{Major: 22}
+		case v.Minor == 3:
+			return Version{Major: 21, Minor: 11}
+		case v.Minor == 2:
+			return Version{Major: 21, Minor: 3}
+		case v.Minor == 1:
+			return Version{Major: 19, Minor: 5}
+		default:
+			return Version{Major: 19}
+		}
+	}
+	return v
+}
+
+// Feature represents an AWX capability which is only available from a given release.
+type Feature string
+
+// Enum of gated features.
+const (
+	FeatureExecutionEnvironments Feature = "execution_environments"
+	FeatureAskLabelsOnLaunch     Feature = "ask_labels_on_launch"
+	FeatureAskInstanceGroups     Feature = "ask_instance_groups_on_launch"
+	FeatureBulkAPI               Feature = "bulk_api"
+	FeatureHostMetrics           Feature = "host_metrics"
+)
+
+// featureMinimumVersions holds the first AWX release supporting each feature.
+var featureMinimumVersions = map[Feature]Version{
+	FeatureExecutionEnvironments: {Major: 18},
+	FeatureAskLabelsOnLaunch:     {Major: 21, Minor: 11},
+	FeatureAskInstanceGroups:     {Major: 21, Minor: 11},
+	FeatureBulkAPI:               {Major: 21, Minor: 14},
+	FeatureHostMetrics:           {Major: 22},
+}
+
+// featureEndpoints maps api endpoints prefixes to the feature they belong to.
+var featureEndpoints = map[string]Feature{
+	executionEnvironmentsAPIEndpoint: FeatureExecutionEnvironments,
+	"/api/v2/bulk/":                  FeatureBulkAPI,
+	hostMetricsAPIEndpoint:           FeatureHostMetrics,
+	hostMetricSummaryAPIEndpoint:     FeatureHostMetrics,
+}
+
+// UnsupportedFeatureError is returned when calling an endpoint the connected AWX does not provide.
+type UnsupportedFeatureError struct {
+	Feature Feature
+	Version Version
+}
+
+func (e *UnsupportedFeatureError) Error() string {
+	return fmt.Sprintf("%s unsupported by AWX %s", e.Feature, e.Version)
+}
+
+// serverVersion caches the version of the connected AWX server.
+type serverVersion struct {
+	mu      sync.RWMutex
+	version Version
+}
+
+func (s *serverVersion) get() Version {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.version
+}
+
+func (s *serverVersion) set(v Version) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.version = v
+}
+
+// supports reports whether the feature is available, an unknown version supports everything.
+func (s *serverVersion) supports(f Feature) bool {
+	v := s.get()
+	if v.IsZero() {
+		return true
+	}
+	minimum, ok := featureMinimumVersions[f]
+	if !ok {
+		return true
+	}
+	return v.awxEquivalent().AtLeast(minimum)
+}
+
+// checkEndpoint returns an UnsupportedFeatureError if the endpoint is not available.
+func (s *serverVersion) checkEndpoint(endpoint string) error {
+	for prefix, f := range featureEndpoints {
+		if strings.HasPrefix(endpoint, prefix) && !s.supports(f) {
+			return &UnsupportedFeatureError{Feature: f, Version: s.get()}
+		}
+	}
+	return nil
+}
+
+// ServerVersion returns the cached version of the connected AWX server,
+// refreshed every time `PingService.Ping` is called.
+func (a *AWX) ServerVersion() Version {
+	if a.client == nil {
+		return Version{}
+	}
+	return a.client.Requester.version.get()
+}
+
+// SupportsFeature reports whether the connected AWX server supports the feature.
+// It returns true when the server version could not be determined.
+func (a *AWX) SupportsFeature(f Feature) bool {
+	if a.client == nil {
+		return true
+	}
+	return a.client.Requester.version.supports(f)
+}
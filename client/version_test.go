@@ -0,0 +1,200 @@
+package awx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Version
+		invalid bool
+	}{
+		{in: "21.14.0", want: Version{Major: 21, Minor: 14}},
+		{in: "23.5.1.dev12+g1a2b3c4", want: Version{Major: 23, Minor: 5, Patch: 1}},
+		{in: "v22.0.0", want: Version{Major: 22}},
+		{in: " 4.5.3 ", want: Version{Major: 4, Minor: 5, Patch: 3}},
+		{in: "3.8", want: Version{Major: 3, Minor: 8}},
+		{in: "24.1.0b1", want: Version{Major: 24, Minor: 1}},
+		{in: "22.3.dev", want: Version{Major: 22, Minor: 3}},
+		{in: "", invalid: true},
+		{in: "devel", invalid: true},
+		{in: "21", invalid: true},
+		{in: "x.1.0", invalid: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseVersion(tt.in)
+		if tt.invalid {
+			if err == nil {
+				t.Errorf("%q: expected an error, got %+v", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %s", tt.in, err)
+			continue
+		}
+		tt.want.Raw = tt.in
+		if got != tt.want {
+			t.Errorf("%q: got %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	tests := []struct {
+		a, b Version
+		want int
+	}{
+		{a: Version{Major: 21, Minor: 14}, b: Version{Major: 21, Minor: 11}, want: 1},
+		{a: Version{Major: 21, Minor: 14}, b: Version{Major: 22}, want: -1},
+		{a: Version{Major: 21, Minor: 14, Patch: 1}, b: Version{Major: 21, Minor: 14}, want: 1},
+		{a: Version{Major: 21, Minor: 14, Raw: "21.14.0"}, b: Version{Major: 21, Minor: 14}, want: 0},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("%+v compare %+v: got %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestVersionAWXEquivalent(t *testing.T) {
+	tests := []struct {
+		in   Version
+		want Version
+	}{
+		{in: Version{Major: 3, Minor: 8, Patch: 6}, want: Version{Major: 15}},
+		{in: Version{Major: 4, Minor: 0}, want: Version{Major: 19}},
+		{in: Version{Major: 4, Minor: 1}, want: Version{Major: 19, Minor: 5}},
+		{in: Version{Major: 4, Minor: 2}, want: Version{Major: 21, Minor: 3}},
+		{in: Version{Major: 4, Minor: 3}, want: Version{Major: 21, Minor: 11}},
+		{in: Version{Major: 4, Minor: 4}, want: Version{Major: 22}},
+		{in: Version{Major: 4, Minor: 5}, want: Version{Major: 23}},
+		{in: Version{Major: 4, Minor: 6}, want: Version{Major: 23}},
+		{in: Version{Major: 21, Minor: 14}, want: Version{Major: 21, Minor: 14}},
+	}
+	for _, tt := range tests {
+		if got := tt.in.awxEquivalent(); got != tt.want {
+			t.Errorf("%+v: got %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestServerVersionSupports(t *testing.T) {
+	tests := []struct {
+		version Version
+		feature Feature
+		want    bool
+	}{
+		{version: Version{}, feature: FeatureBulkAPI, want: true},
+		{version: Version{Major: 21, Minor: 13}, feature: FeatureBulkAPI, want: false},
+		{version: Version{Major: 21, Minor: 14}, feature: FeatureBulkAPI, want: true},
+		{version: Version{Major: 17}, feature: FeatureExecutionEnvironments, want: false},
+		{version: Version{Major: 18}, feature: FeatureExecutionEnvironments, want: true},
+		{version: Version{Major: 3, Minor: 8}, feature: FeatureExecutionEnvironments, want: false},
+		{version: Version{Major: 4, Minor: 3}, feature: FeatureAskLabelsOnLaunch, want: true},
+		{version: Version{Major: 4, Minor: 2}, feature: FeatureAskLabelsOnLaunch, want: false},
+		{version: Version{Major: 4, Minor: 3}, feature: FeatureHostMetrics, want: false},
+		{version: Version{Major: 4, Minor: 4}, feature: FeatureHostMetrics, want: true},
+		{version: Version{Major: 1}, feature: Feature("unknown"), want: true},
+	}
+	for _, tt := range tests {
+		s := &serverVersion{}
+		s.set(tt.version)
+		if got := s.supports(tt.feature); got != tt.want {
+			t.Errorf("%+v supports %s: got %t, want %t", tt.version, tt.feature, got, tt.want)
+		}
+	}
+}
+
+func TestEndpointGating(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case pingAPIEndpoint:
+			fmt.Fprint(w, `{"version": "17.1.0"}`)
+		default:
+			fmt.Fprint(w, `{"count": 0, "results": []}`)
+		}
+	}))
+	defer server.Close()
+
+	awx := newAWX(newTestClient(server))
+	if _, err := awx.PingService.Ping(); err != nil {
+		t.Fatalf("ping: %s", err)
+	}
+	if got := awx.ServerVersion(); got.Major != 17 || got.Minor != 1 {
+		t.Errorf("server version: %+v", got)
+	}
+	if awx.SupportsFeature(FeatureExecutionEnvironments) {
+		t.Errorf("execution environments supported by AWX 17.1")
+	}
+
+	_, _, err := awx.ExecutionEnvironmentsService.ListExecutionEnvironments(url.Values{})
+	var unsupported *UnsupportedFeatureError
+	if !errors.As(err, &unsupported) || unsupported.Feature != FeatureExecutionEnvironments || unsupported.Version.Major != 17 {
+		t.Fatalf("expected an UnsupportedFeatureError, got %v", err)
+	}
+	if _, _, err := awx.HostService.ListHosts(url.Values{}); err != nil {
+		t.Errorf("ungated endpoint: %s", err)
+	}
+	for _, path := range paths {
+		if path == executionEnvironmentsAPIEndpoint {
+			t.Errorf("the gated endpoint was requested")
+		}
+	}
+}
+
+func TestLaunchInstanceGroupsGating(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		fmt.Fprint(w, `{"job": 12, "id": 12}`)
+	}))
+	defer server.Close()
+	awx := newAWX(newTestClient(server))
+
+	tests := []struct {
+		name        string
+		version     Version
+		data        map[string]interface{}
+		unsupported bool
+	}{
+		{name: "supported", version: Version{Major: 21, Minor: 11}, data: map[string]interface{}{"instance_groups": []int{2}}},
+		{name: "unknown version", data: map[string]interface{}{"instance_groups": []int{2}}},
+		{name: "unsupported", version: Version{Major: 21, Minor: 10}, data: map[string]interface{}{"instance_groups": []int{2}}, unsupported: true},
+		{name: "unsupported tower", version: Version{Major: 4, Minor: 2}, data: map[string]interface{}{"instance_groups": []int{2}}, unsupported: true},
+		{name: "no instance groups", version: Version{Major: 21, Minor: 10}, data: map[string]interface{}{"limit": "web"}},
+	}
+	for _, tt := range tests {
+		paths = nil
+		awx.client.Requester.version.set(tt.version)
+		_, err := awx.JobTemplateService.Launch(5, tt.data, url.Values{})
+		if !tt.unsupported {
+			if err != nil || len(paths) != 1 {
+				t.Errorf("%s: %v, requests %v", tt.name, err, paths)
+			}
+			continue
+		}
+		var unsupported *UnsupportedFeatureError
+		if !errors.As(err, &unsupported) || unsupported.Feature != FeatureAskInstanceGroups {
+			t.Errorf("%s: expected an UnsupportedFeatureError, got %v", tt.name, err)
+		}
+		if len(paths) != 0 {
+			t.Errorf("%s: the launch was requested: %v", tt.name, paths)
+		}
+	}
+}
+
+func newTestClient(server *httptest.Server) *Client {
+	return &Client{
+		BaseURL:   server.URL,
+		Requester: &Requester{Base: server.URL, Authenticator: &TokenAuth{Token: "token"}, Client: server.Client()},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // Enum of job statuses.
@@ -31,7 +32,7 @@ type CancelWorkflowJobResponse struct {
 const WorkflowJobAPIEndpoint = "/api/v2/workflow_jobs/"
 
 // GetWorkflowJob shows the details of a workflow job.
-func (j *WorkflowJobService) GetWorkflowJob(id int, params map[string]string) (*WorkflowJob, error) {
+func (j *WorkflowJobService) GetWorkflowJob(id int, params url.Values) (*WorkflowJob, error) {
 	result := new(WorkflowJob)
 	endpoint := fmt.Sprintf("%s%d/", WorkflowJobAPIEndpoint, id)
 	resp, err := j.client.Requester.GetJSON(endpoint, result, params)
@@ -47,7 +48,7 @@ func (j *WorkflowJobService) GetWorkflowJob(id int, params map[string]string) (*
 }
 
 // CancelWorkflowJob cancels a workflow job.
-func (j *WorkflowJobService) CancelWorkflowJob(id int, data map[string]interface{}, params map[string]string) (*CancelWorkflowJobResponse, error) {
+func (j *WorkflowJobService) CancelWorkflowJob(id int, data map[string]interface{}, params url.Values) (*CancelWorkflowJobResponse, error) {
 	result := new(CancelWorkflowJobResponse)
 	endpoint := fmt.Sprintf("%s%d/cancel/", WorkflowJobAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -68,7 +69,7 @@ func (j *WorkflowJobService) CancelWorkflowJob(id int, data map[string]interface
 }
 
 // RelaunchWorkflowJob relaunch a workflow job.
-func (j *WorkflowJobService) RelaunchWorkflowJob(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobLaunch, error) {
+func (j *WorkflowJobService) RelaunchWorkflowJob(id int, data map[string]interface{}, params url.Values) (*WorkflowJobLaunch, error) {
 	result := new(WorkflowJobLaunch)
 	endpoint := fmt.Sprintf("%s%d/relaunch/", WorkflowJobAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -2,8 +2,10 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // WorkflowJobTemplateService implements awx workflow job template apis.
@@ -20,7 +22,7 @@ type ListWorkflowJobTemplatesResponse struct {
 const workflowJobTemplateAPIEndpoint = "/api/v2/workflow_job_templates/"
 
 // GetWorkflowJobTemplateByID shows the details of a workflow job template.
-func (jt *WorkflowJobTemplateService) GetWorkflowJobTemplateByID(id int, params map[string]string) (*WorkflowJobTemplate, error) {
+func (jt *WorkflowJobTemplateService) GetWorkflowJobTemplateByID(id int, params url.Values) (*WorkflowJobTemplate, error) {
 	result := new(WorkflowJobTemplate)
 	endpoint := fmt.Sprintf("%s%d/", workflowJobTemplateAPIEndpoint, id)
 	resp, err := jt.client.Requester.GetJSON(endpoint, result, params)
@@ -35,8 +37,35 @@ func (jt *WorkflowJobTemplateService) GetWorkflowJobTemplateByID(id int, params
 	return result, nil
 }
 
+// GetByNamedURL shows the details of a workflow job template by its named url identifier, see `NamedURLWorkflowJobTemplate`.
+func (jt *WorkflowJobTemplateService) GetByNamedURL(identifier string, params url.Values) (*WorkflowJobTemplate, error) {
+	return getByNamedURL[WorkflowJobTemplate](jt.client, workflowJobTemplateAPIEndpoint, identifier, params)
+}
+
+// GetSurveySpec returns the survey of a workflow job template, AWX returns an empty survey when none was created.
+func (jt *WorkflowJobTemplateService) GetSurveySpec(id int) (*SurveySpec, error) {
+	result := new(SurveySpec)
+	endpoint := fmt.Sprintf("%s%d/survey_spec/", workflowJobTemplateAPIEndpoint, id)
+	resp, err := jt.client.Requester.GetJSON(endpoint, result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// FindByName returns the only workflow job template named `name` in the scope, or a `*LookupError`
+// wrapping `ErrNotFound` or `ErrAmbiguous`.
+func (jt *WorkflowJobTemplateService) FindByName(ctx context.Context, name string, scope Scope) (*WorkflowJobTemplate, error) {
+	return findByName[WorkflowJobTemplate](ctx, jt.client, "workflow job template", workflowJobTemplateAPIEndpoint, "name", name, scope, templateScope)
+}
+
 // ListWorkflowJobTemplates shows a list of workflow job templates.
-func (jt *WorkflowJobTemplateService) ListWorkflowJobTemplates(params map[string]string) ([]*WorkflowJobTemplate, *ListWorkflowJobTemplatesResponse, error) {
+func (jt *WorkflowJobTemplateService) ListWorkflowJobTemplates(params url.Values) ([]*WorkflowJobTemplate, *ListWorkflowJobTemplatesResponse, error) {
 	result := new(ListWorkflowJobTemplatesResponse)
 	resp, err := jt.client.Requester.GetJSON(workflowJobTemplateAPIEndpoint, result, params)
 	if err != nil {
@@ -51,7 +80,7 @@ func (jt *WorkflowJobTemplateService) ListWorkflowJobTemplates(params map[string
 }
 
 // CreateWorkflowJobTemplate creates a workflow job template
-func (jt *WorkflowJobTemplateService) CreateWorkflowJobTemplate(data map[string]interface{}, params map[string]string) (*WorkflowJobTemplate, error) {
+func (jt *WorkflowJobTemplateService) CreateWorkflowJobTemplate(data map[string]interface{}, params url.Values) (*WorkflowJobTemplate, error) {
 	result := new(WorkflowJobTemplate)
 	mandatoryFields = []string{"name"}
 	validate, status := ValidateParams(data, mandatoryFields)
@@ -75,7 +104,7 @@ func (jt *WorkflowJobTemplateService) CreateWorkflowJobTemplate(data map[string]
 }
 
 // UpdateWorkflowJobTemplate updates a workflow job template.
-func (jt *WorkflowJobTemplateService) UpdateWorkflowJobTemplate(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplate, error) {
+func (jt *WorkflowJobTemplateService) UpdateWorkflowJobTemplate(id int, data map[string]interface{}, params url.Values) (*WorkflowJobTemplate, error) {
 	result := new(WorkflowJobTemplate)
 	endpoint := fmt.Sprintf("%s%d", workflowJobTemplateAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -111,9 +140,14 @@ func (jt *WorkflowJobTemplateService) DeleteWorkflowJobTemplate(id int) (*Workfl
 }
 
 // Launch a job with the workflow job template.
-func (jt *WorkflowJobTemplateService) Launch(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
+// Labels given on launch, e.g. `data["labels"] = []int{3}`, need the
+// template `AskLabelsOnLaunch`, checked before launching, and AWX 21.11 or later.
+func (jt *WorkflowJobTemplateService) Launch(id int, data map[string]interface{}, params url.Values) (*JobLaunch, error) {
 	result := new(JobLaunch)
 	endpoint := fmt.Sprintf("%s%d/launch/", workflowJobTemplateAPIEndpoint, id)
+	if err := checkLaunchLabels(jt.client, fmt.Sprintf("%s%d/", workflowJobTemplateAPIEndpoint, id), data); err != nil {
+		return nil, err
+	}
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -2,8 +2,11 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 )
 
 // WorkflowJobTemplateNodeService implements awx job template node apis.
@@ -20,7 +23,7 @@ type ListWorkflowJobTemplateNodesResponse struct {
 const workflowJobTemplateNodeAPIEndpoint = "/api/v2/workflow_job_template_nodes/"
 
 // GetWorkflowJobTemplateNodeByID shows the details of a job template node.
-func (jt *WorkflowJobTemplateNodeService) GetWorkflowJobTemplateNodeByID(id int, params map[string]string) (*WorkflowJobTemplateNode, error) {
+func (jt *WorkflowJobTemplateNodeService) GetWorkflowJobTemplateNodeByID(id int, params url.Values) (*WorkflowJobTemplateNode, error) {
 	result := new(WorkflowJobTemplateNode)
 	endpoint := fmt.Sprintf("%s%d/", workflowJobTemplateNodeAPIEndpoint, id)
 	resp, err := jt.client.Requester.GetJSON(endpoint, result, params)
@@ -35,8 +38,20 @@ func (jt *WorkflowJobTemplateNodeService) GetWorkflowJobTemplateNodeByID(id int,
 	return result, nil
 }
 
+// GetByNamedURL shows the details of a workflow job template node by its named url identifier, see `NamedURLWorkflowJobTemplateNode`.
+func (jt *WorkflowJobTemplateNodeService) GetByNamedURL(identifier string, params url.Values) (*WorkflowJobTemplateNode, error) {
+	return getByNamedURL[WorkflowJobTemplateNode](jt.client, workflowJobTemplateNodeAPIEndpoint, identifier, params)
+}
+
+// FindByName returns the only workflow job template node with the `identifier`
+// in the scope, or a `*LookupError` wrapping `ErrNotFound` or `ErrAmbiguous`.
+// The identifiers are unique within a workflow job template only.
+func (jt *WorkflowJobTemplateNodeService) FindByName(ctx context.Context, identifier string, scope Scope) (*WorkflowJobTemplateNode, error) {
+	return findByName[WorkflowJobTemplateNode](ctx, jt.client, "workflow job template node", workflowJobTemplateNodeAPIEndpoint, "identifier", identifier, scope, workflowNodeScope)
+}
+
 // ListWorkflowJobTemplateNodes shows a list of job templates nodes.
-func (jt *WorkflowJobTemplateNodeService) ListWorkflowJobTemplateNodes(params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+func (jt *WorkflowJobTemplateNodeService) ListWorkflowJobTemplateNodes(params url.Values) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
 	result := new(ListWorkflowJobTemplateNodesResponse)
 
 	resp, err := jt.client.Requester.GetJSON(workflowJobTemplateNodeAPIEndpoint, result, params)
@@ -52,7 +67,7 @@ func (jt *WorkflowJobTemplateNodeService) ListWorkflowJobTemplateNodes(params ma
 }
 
 // CreateWorkflowJobTemplateNode creates a job template node, without any pe exisiting nodes.
-func (jt *WorkflowJobTemplateNodeService) CreateWorkflowJobTemplateNode(data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
+func (jt *WorkflowJobTemplateNodeService) CreateWorkflowJobTemplateNode(data map[string]interface{}, params url.Values) (*WorkflowJobTemplateNode, error) {
 	result := new(WorkflowJobTemplateNode)
 	mandatoryFields = []string{"workflow_job_template", "unified_job_template", "identifier"}
 	validate, status := ValidateParams(data, mandatoryFields)
@@ -60,6 +75,9 @@ func (jt *WorkflowJobTemplateNodeService) CreateWorkflowJobTemplateNode(data map
 		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
 		return nil, err
 	}
+	if err := validateResource(workflowJobTemplateNodeSchema, http.MethodPost, workflowJobTemplateNodeAPIEndpoint, data); err != nil {
+		return nil, err
+	}
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -75,9 +93,12 @@ func (jt *WorkflowJobTemplateNodeService) CreateWorkflowJobTemplateNode(data map
 }
 
 // UpdateWorkflowJobTemplateNode updates a job template node.
-func (jt *WorkflowJobTemplateNodeService) UpdateWorkflowJobTemplateNode(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
+func (jt *WorkflowJobTemplateNodeService) UpdateWorkflowJobTemplateNode(id int, data map[string]interface{}, params url.Values) (*WorkflowJobTemplateNode, error) {
 	result := new(WorkflowJobTemplateNode)
 	endpoint := fmt.Sprintf("%s%d", workflowJobTemplateNodeAPIEndpoint, id)
+	if err := validateResource(workflowJobTemplateNodeSchema, http.MethodPatch, endpoint, data); err != nil {
+		return nil, err
+	}
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
 )
 
 // WorkflowJobTemplateNodeStepService implements awx job template nodes apis.
@@ -14,12 +15,12 @@ type WorkflowJobTemplateNodeStepService struct {
 }
 
 // ListWorkflowJobTemplateNodes shows a list of job templates nodes.
-func (jt *WorkflowJobTemplateNodeStepService) ListWorkflowJobTemplateNodes(id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+func (jt *WorkflowJobTemplateNodeStepService) ListWorkflowJobTemplateNodes(id int, params url.Values) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
 	workflowJobTemplateNodesActionEndpoint := fmt.Sprintf(jt.endpoint, id)
 	return fetchWorkflowJobTemplateNode(jt.client, params, workflowJobTemplateNodesActionEndpoint)
 }
 
-func fetchWorkflowJobTemplateNode(client *Client, params map[string]string, workflowJobTemplateNodesActionEndpoint string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+func fetchWorkflowJobTemplateNode(client *Client, params url.Values, workflowJobTemplateNodesActionEndpoint string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
 	result := new(ListWorkflowJobTemplateNodesResponse)
 	resp, err := client.Requester.GetJSON(workflowJobTemplateNodesActionEndpoint, result, params)
 	if err != nil {
@@ -33,7 +34,7 @@ func fetchWorkflowJobTemplateNode(client *Client, params map[string]string, work
 	return result.Results, result, nil
 }
 
-func createWorkflowJobTemplateNode(client *Client, data map[string]interface{}, params map[string]string, workflowJobTemplateNodesActionEndpoint string) (*WorkflowJobTemplateNode, error) {
+func createWorkflowJobTemplateNode(client *Client, data map[string]interface{}, params url.Values, workflowJobTemplateNodesActionEndpoint string) (*WorkflowJobTemplateNode, error) {
 	result := new(WorkflowJobTemplateNode)
 	mandatoryFields = []string{"unified_job_template", "identifier"}
 	validate, status := ValidateParams(data, mandatoryFields)
@@ -57,7 +58,7 @@ func createWorkflowJobTemplateNode(client *Client, data map[string]interface{},
 }
 
 // CreateWorkflowJobTemplateNodeStep will be create a template node for a existing node
-func (jt *WorkflowJobTemplateNodeStepService) CreateWorkflowJobTemplateNodeStep(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
+func (jt *WorkflowJobTemplateNodeStepService) CreateWorkflowJobTemplateNodeStep(id int, data map[string]interface{}, params url.Values) (*WorkflowJobTemplateNode, error) {
 	workflowJobTemplateNodesActionEndpoint := fmt.Sprintf(jt.endpoint, id)
 	return createWorkflowJobTemplateNode(jt.client, data, params, workflowJobTemplateNodesActionEndpoint)
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 const workflowJobTemplateSchedulesAPIEndpoint = "/api/v2/workflow_job_templates/%d/schedules/"
@@ -14,7 +15,7 @@ type WorkflowJobTemplateScheduleService struct {
 }
 
 // ListWorkflowJobTemplateSchedules shows a list of schedules for a given workflow_job_template
-func (jt *WorkflowJobTemplateScheduleService) ListWorkflowJobTemplateSchedules(id int, params map[string]string) ([]*Schedule, *ListSchedulesResponse, error) {
+func (jt *WorkflowJobTemplateScheduleService) ListWorkflowJobTemplateSchedules(id int, params url.Values) ([]*Schedule, *ListSchedulesResponse, error) {
 	result := new(ListSchedulesResponse)
 	resp, err := jt.client.Requester.GetJSON(
 		fmt.Sprintf(workflowJobTemplateSchedulesAPIEndpoint, id),
@@ -31,7 +32,7 @@ func (jt *WorkflowJobTemplateScheduleService) ListWorkflowJobTemplateSchedules(i
 }
 
 // CreateWorkflowJobTemplateSchedule will create a schedule for an existing workflow_job_template
-func (jt *WorkflowJobTemplateScheduleService) CreateWorkflowJobTemplateSchedule(id int, data map[string]interface{}, params map[string]string) (*Schedule, error) {
+func (jt *WorkflowJobTemplateScheduleService) CreateWorkflowJobTemplateSchedule(id int, data map[string]interface{}, params url.Values) (*Schedule, error) {
 	mandatoryFields = []string{"name", "rrule"}
 	validate, status := ValidateParams(data, mandatoryFields)
 	if !status {
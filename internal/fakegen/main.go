@@ -0,0 +1,322 @@
+// Command fakegen generates the in-memory fakes of the awx services interfaces.
+//
+// It reads the interfaces declared in the awx package and the `AWX` struct
+// fields, and writes the `awxfake` package:
+//
+//	go run ../internal/fakegen -in interfaces.go -awx awx.go -out awxfake/fakes.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const awxImportPath = "github.com/denouche/goawx/client"
+
+type param struct {
+	name string
+	typ  string
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type iface struct {
+	name    string
+	methods []method
+}
+
+type field struct {
+	name  string
+	iface string
+}
+
+func main() {
+	in := flag.String("in", "interfaces.go", "file declaring the services interfaces")
+	awxFile := flag.String("awx", "awx.go", "file declaring the AWX struct")
+	out := flag.String("out", "awxfake/fakes.go", "generated file")
+	flag.Parse()
+
+	imports := map[string]bool{}
+	ifaces, err := parseInterfaces(*in, imports)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fields, err := parseAWXFields(*awxFile, ifaces)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := format.Source(generate(ifaces, fields, imports))
+	if err != nil {
+		log.Fatalf("format generated code: %v", err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseInterfaces(path string, imports map[string]bool) ([]iface, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaces []iface
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !ts.Name.IsExported() {
+				continue
+			}
+
+			i := iface{name: ts.Name.Name}
+			for _, m := range it.Methods.List {
+				ft, ok := m.Type.(*ast.FuncType)
+				if !ok {
+					return nil, fmt.Errorf("%s: embedded interfaces are not supported", ts.Name.Name)
+				}
+				meth := method{name: m.Names[0].Name}
+				for _, p := range ft.Params.List {
+					typ, err := typeString(p.Type, imports)
+					if err != nil {
+						return nil, err
+					}
+					if len(p.Names) == 0 {
+						meth.params = append(meth.params, param{name: fmt.Sprintf("p%d", len(meth.params)), typ: typ})
+					}
+					for _, name := range p.Names {
+						meth.params = append(meth.params, param{name: name.Name, typ: typ})
+					}
+				}
+				if ft.Results != nil {
+					for _, r := range ft.Results.List {
+						typ, err := typeString(r.Type, imports)
+						if err != nil {
+							return nil, err
+						}
+						for n := 0; n < len(r.Names) || n == 0 && len(r.Names) == 0; n++ {
+							meth.results = append(meth.results, typ)
+						}
+					}
+				}
+				i.methods = append(i.methods, meth)
+			}
+			ifaces = append(ifaces, i)
+		}
+	}
+
+	// the types are qualified by package names, the imports need their paths
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		name := importPath[strings.LastIndex(importPath, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if imports[name] {
+			delete(imports, name)
+			imports[importPath] = true
+		}
+	}
+	return ifaces, nil
+}
+
+// parseAWXFields returns the AWX struct fields typed with one of the interfaces.
+func parseAWXFields(path string, ifaces []iface) ([]field, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	known := map[string]bool{}
+	for _, i := range ifaces {
+		known[i.name] = true
+	}
+
+	var fields []field
+	ast.Inspect(file, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok || ts.Name.Name != "AWX" {
+			return true
+		}
+		for _, f := range ts.Type.(*ast.StructType).Fields.List {
+			ident, ok := f.Type.(*ast.Ident)
+			if !ok || !known[ident.Name] {
+				continue
+			}
+			for _, name := range f.Names {
+				fields = append(fields, field{name: name.Name, iface: ident.Name})
+			}
+		}
+		return false
+	})
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%s: no AWX service field found", path)
+	}
+	return fields, nil
+}
+
+// typeString prints a type expression, qualifying the awx package identifiers.
+func typeString(expr ast.Expr, imports map[string]bool) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if t.IsExported() {
+			return "awx." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		imports[pkg] = true
+		return pkg + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		elem, err := typeString(t.X, imports)
+		return "*" + elem, err
+	case *ast.ArrayType:
+		elem, err := typeString(t.Elt, imports)
+		return "[]" + elem, err
+	case *ast.Ellipsis:
+		elem, err := typeString(t.Elt, imports)
+		return "..." + elem, err
+	case *ast.MapType:
+		key, err := typeString(t.Key, imports)
+		if err != nil {
+			return "", err
+		}
+		value, err := typeString(t.Value, imports)
+		return "map[" + key + "]" + value, err
+	case *ast.InterfaceType:
+		if t.Methods == nil || len(t.Methods.List) == 0 {
+			return "interface{}", nil
+		}
+	}
+	return "", fmt.Errorf("unsupported type %T", expr)
+}
+
+func generate(ifaces []iface, fields []field, imports map[string]bool) []byte {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by internal/fakegen. DO NOT EDIT.\n\n")
+	b.WriteString("package awxfake\n\nimport (\n")
+	pkgs := make([]string, 0, len(imports))
+	for pkg := range imports {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+	for _, pkg := range pkgs {
+		fmt.Fprintf(&b, "\t%q\n", pkg)
+	}
+	fmt.Fprintf(&b, "\n\tawx %q\n)\n\n", awxImportPath)
+
+	// Fakes and NewAWX
+	b.WriteString("// Fakes holds the fake services of an AWX built by NewAWX.\ntype Fakes struct {\n")
+	for _, f := range fields {
+		fmt.Fprintf(&b, "\t%s *%s\n", f.name, f.iface)
+	}
+	b.WriteString("}\n\n")
+	b.WriteString("// NewAWX returns an AWX whose services are fakes, along with the fakes to script and inspect.\n")
+	b.WriteString("// The AWX has no http client, the raw request helpers can't be used with it.\n")
+	b.WriteString("func NewAWX() (*awx.AWX, *Fakes) {\n\tfakes := &Fakes{\n")
+	for _, f := range fields {
+		fmt.Fprintf(&b, "\t\t%s: &%s{},\n", f.name, f.iface)
+	}
+	b.WriteString("\t}\n\treturn &awx.AWX{\n")
+	for _, f := range fields {
+		fmt.Fprintf(&b, "\t\t%s: fakes.%s,\n", f.name, f.name)
+	}
+	b.WriteString("\t}, fakes\n}\n\n")
+
+	for _, i := range ifaces {
+		fmt.Fprintf(&b, "var _ awx.%s = (*%s)(nil)\n\n", i.name, i.name)
+		fmt.Fprintf(&b, "// %s is an in-memory fake of awx.%s.\n", i.name, i.name)
+		fmt.Fprintf(&b, "type %s struct {\n\tRecorder\n\n", i.name)
+		for _, m := range i.methods {
+			fmt.Fprintf(&b, "\t%sFunc func%s\n", m.name, m.signature(false))
+		}
+		b.WriteString("}\n\n")
+
+		for _, m := range i.methods {
+			fmt.Fprintf(&b, "// %s records the call and returns the scripted results, zero values by default.\n", m.name)
+			fmt.Fprintf(&b, "func (f *%s) %s%s {\n", i.name, m.name, m.signature(true))
+			args := make([]string, 0, len(m.params))
+			for _, p := range m.params {
+				args = append(args, p.name)
+			}
+			call := strings.Join(args, ", ")
+			recordArgs := ""
+			if call != "" {
+				recordArgs = ", " + call
+			}
+			fmt.Fprintf(&b, "\tf.record(%q%s)\n", m.name, recordArgs)
+			fmt.Fprintf(&b, "\tif fn := f.%sFunc; fn != nil {\n", m.name)
+			if len(m.results) > 0 {
+				fmt.Fprintf(&b, "\t\treturn fn(%s)\n\t}\n\treturn\n}\n\n", call)
+			} else {
+				fmt.Fprintf(&b, "\t\tfn(%s)\n\t}\n}\n\n", call)
+			}
+
+			if len(m.results) == 0 {
+				continue
+			}
+			results := make([]string, 0, len(m.results))
+			names := make([]string, 0, len(m.results))
+			for n, r := range m.results {
+				results = append(results, fmt.Sprintf("r%d %s", n, r))
+				names = append(names, fmt.Sprintf("r%d", n))
+			}
+			fmt.Fprintf(&b, "// %sReturns scripts the results of %s.\n", m.name, m.name)
+			fmt.Fprintf(&b, "func (f *%s) %sReturns(%s) {\n", i.name, m.name, strings.Join(results, ", "))
+			fmt.Fprintf(&b, "\tf.%sFunc = func%s {\n\t\treturn %s\n\t}\n}\n\n", m.name, m.signature(false), strings.Join(names, ", "))
+		}
+	}
+	return b.Bytes()
+}
+
+// signature prints the method parameters and results, with named results
+// when named is set so the zero values can be returned.
+func (m method) signature(named bool) string {
+	params := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		if named {
+			params = append(params, p.name+" "+p.typ)
+		} else {
+			params = append(params, p.typ)
+		}
+	}
+	results := make([]string, 0, len(m.results))
+	for n, r := range m.results {
+		if named {
+			results = append(results, fmt.Sprintf("r%d %s", n, r))
+		} else {
+			results = append(results, r)
+		}
+	}
+
+	sig := "(" + strings.Join(params, ", ") + ")"
+	switch {
+	case len(results) == 0:
+	case len(results) == 1 && !named:
+		sig += " " + results[0]
+	default:
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+	return sig
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGeneratedUpToDate checks the committed awx types match the fixtures.
+func TestGeneratedUpToDate(t *testing.T) {
+	generated, err := generate("testdata/options")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	committed, err := os.ReadFile(filepath.Join("..", "..", "client", "types_generated.go"))
+	if err != nil {
+		t.Fatalf("%s, run go generate ./client", err)
+	}
+	if !bytes.Equal(committed, generated) {
+		t.Errorf("client/types_generated.go is out of date, run go generate ./client")
+	}
+}
+
+func TestNullability(t *testing.T) {
+	res, err := loadResource("testdata/options/job_templates.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"name":                 "string",
+		"inventory":            "Nullable[int]",
+		"verbosity":            "int",
+		"created":              "time.Time",
+		"last_job_run":         "Nullable[time.Time]",
+		"extra_vars":           "ExtraVars",
+		"related":              "*Related",
+		"custom_virtualenv":    "Nullable[string]",
+		"ask_labels_on_launch": "bool",
+	}
+	for _, field := range res.GET {
+		if typ, ok := expected[field.Name]; ok && res.goType(field) != typ {
+			t.Errorf("%s: expecting %s but got %s", field.Name, typ, res.goType(field))
+		}
+	}
+
+	if strings.Join(res.Required, ",") != "name" {
+		t.Errorf("Expecting required fields [name] but got %v", res.Required)
+	}
+}
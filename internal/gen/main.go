@@ -0,0 +1,318 @@
+// Command gen generates the `awx` resource types from the AWX OPTIONS metadata:
+// a type and a schema per endpoint, which the `awx` services decode into and
+// validate their payloads against.
+//
+// The metadata of each endpoint, the response of `OPTIONS /api/v2/<endpoint>/`,
+// is committed as `testdata/options/<endpoint>.json` so the generation runs
+// offline. The `actions.GET` fields give the struct fields and their types,
+// the `actions.POST` fields give the required fields, the max lengths and the
+// nullability.
+//
+//	go run ../internal/gen -fixtures ../internal/gen/testdata/options -out types_generated.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// fieldMeta is the metadata of a field in an OPTIONS action.
+type fieldMeta struct {
+	Name      string
+	Type      string          `json:"type"`
+	Required  bool            `json:"required"`
+	ReadOnly  bool            `json:"read_only"`
+	Choices   [][]interface{} `json:"choices"`
+	Default   json.RawMessage `json:"default"`
+	MaxLength *int            `json:"max_length"`
+}
+
+// resource is the metadata of an endpoint.
+type resource struct {
+	TypeName string
+	Label    string
+	GET      []*fieldMeta
+	POST     map[string]*fieldMeta
+	// Writable lists the writable POST fields in metadata order.
+	Writable []*fieldMeta
+	// Required lists the required POST fields in metadata order.
+	Required []string
+}
+
+func main() {
+	fixtures := flag.String("fixtures", "testdata/options", "directory of the OPTIONS metadata fixtures")
+	out := flag.String("out", "types_generated.go", "output file")
+	flag.Parse()
+
+	content, err := generate(*fixtures)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, content, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the generated file, the resources in fixture name order.
+func generate(fixtures string) ([]byte, error) {
+	paths, err := filepath.Glob(filepath.Join(fixtures, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no fixture found in %s", fixtures)
+	}
+	sort.Strings(paths)
+
+	resources := make([]*resource, 0, len(paths))
+	for _, path := range paths {
+		res, err := loadResource(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		resources = append(resources, res)
+	}
+	src, err := format.Source(render(resources))
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+	return src, nil
+}
+
+func loadResource(path string) (*resource, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata struct {
+		Actions map[string]json.RawMessage `json:"actions"`
+		Types   []string                   `json:"types"`
+	}
+	if err := json.Unmarshal(content, &metadata); err != nil {
+		return nil, err
+	}
+	if len(metadata.Types) == 0 {
+		return nil, fmt.Errorf("no resource type")
+	}
+	if metadata.Actions["GET"] == nil {
+		return nil, fmt.Errorf("no GET action")
+	}
+
+	res := &resource{
+		TypeName: goName(metadata.Types[0]),
+		Label:    strings.ReplaceAll(metadata.Types[0], "_", " "),
+		POST:     map[string]*fieldMeta{},
+	}
+
+	if res.GET, err = orderedFields(metadata.Actions["GET"]); err != nil {
+		return nil, err
+	}
+	if raw := metadata.Actions["POST"]; raw != nil {
+		post, err := orderedFields(raw)
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range post {
+			res.POST[field.Name] = field
+			if field.ReadOnly {
+				continue
+			}
+			res.Writable = append(res.Writable, field)
+			if field.Required {
+				res.Required = append(res.Required, field.Name)
+			}
+		}
+	}
+	return res, nil
+}
+
+// orderedFields decodes the fields of an action, keeping the metadata order.
+func orderedFields(raw json.RawMessage) ([]*fieldMeta, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, fmt.Errorf("action fields are not an object")
+	}
+
+	var fields []*fieldMeta
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		field := &fieldMeta{Name: tok.(string)}
+		if err := dec.Decode(field); err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// goType returns the Go type of a GET field, a `Nullable` when the field is
+// nullable. The fields the `awx` package decodes into its own types keep them,
+// see fieldTypes.
+func (r *resource) goType(field *fieldMeta) string {
+	if typ, ok := fieldTypes[r.TypeName+"."+field.Name]; ok {
+		return typ
+	}
+	if typ, ok := fieldTypes[field.Name]; ok {
+		return typ
+	}
+
+	var typ string
+	switch field.Type {
+	case "integer", "id":
+		typ = "int"
+	case "float", "decimal":
+		typ = "float64"
+	case "boolean":
+		typ = "bool"
+	case "datetime":
+		typ = "time.Time"
+	case "choice":
+		typ = "string"
+		if len(field.Choices) > 0 && len(field.Choices[0]) > 0 {
+			if _, ok := field.Choices[0][0].(float64); ok {
+				typ = "int"
+			}
+		}
+	case "object", "json":
+		typ = "map[string]interface{}"
+	case "list":
+		typ = "[]interface{}"
+	case "field":
+		return "interface{}"
+	default:
+		typ = "string"
+	}
+
+	if r.nullable(field) {
+		return "Nullable[" + typ + "]"
+	}
+	return typ
+}
+
+// nullable reports whether a field may be null: optional foreign keys,
+// datetimes other than the creation and modification times, and fields
+// whose POST default is null.
+func (r *resource) nullable(field *fieldMeta) bool {
+	post := r.POST[field.Name]
+	if post != nil && string(post.Default) == "null" {
+		return true
+	}
+	switch field.Type {
+	case "id":
+		return post == nil || !post.Required
+	case "datetime":
+		return field.Name != "created" && field.Name != "modified"
+	}
+	return false
+}
+
+// schemaName returns the name of the variable holding the resource schema.
+func (r *resource) schemaName() string {
+	return strings.ToLower(r.TypeName[:1]) + r.TypeName[1:] + "Schema"
+}
+
+func render(resources []*resource) []byte {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by internal/gen from the AWX OPTIONS metadata. DO NOT EDIT.\n\n")
+	b.WriteString("package awx\n\n")
+	b.WriteString("import \"time\"\n\n")
+	for _, r := range resources {
+		renderType(&b, r)
+		renderSchema(&b, r)
+	}
+	return b.Bytes()
+}
+
+// renderType writes the resource struct, the GET fields then the legacy fields.
+func renderType(b *bytes.Buffer, r *resource) {
+	fmt.Fprintf(b, "// %s represents the awx api %s.\n", r.TypeName, r.Label)
+	fmt.Fprintf(b, "type %s struct {\n", r.TypeName)
+	for _, field := range r.GET {
+		writeField(b, field.Name, r.goType(field), choiceValues(field))
+	}
+	for _, field := range legacyFields[r.TypeName] {
+		writeField(b, field.Name, field.Type, "")
+	}
+	b.WriteString("}\n\n")
+}
+
+// writeField writes a struct field, the nullable fields are left out of the
+// payloads when unset.
+func writeField(b *bytes.Buffer, name, typ, comment string) {
+	tag := name
+	if strings.HasPrefix(typ, "Nullable[") {
+		tag += ",omitzero"
+	}
+	fmt.Fprintf(b, "\t%s %s `json:\"%s\"`", goName(name), typ, tag)
+	if comment != "" {
+		fmt.Fprintf(b, " // %s", comment)
+	}
+	b.WriteString("\n")
+}
+
+// renderSchema writes the required fields and the string max lengths of the
+// POST metadata, the payloads are checked against them before any request.
+func renderSchema(b *bytes.Buffer, r *resource) {
+	fmt.Fprintf(b, "// %s holds the required fields and the max lengths of the %s payloads.\n", r.schemaName(), r.Label)
+	fmt.Fprintf(b, "var %s = schema{\n", r.schemaName())
+	for _, field := range r.Writable {
+		var attrs []string
+		if field.MaxLength != nil && field.Type == "string" {
+			attrs = append(attrs, `Type: "string"`, fmt.Sprintf("MaxLength: maxLength(%d)", *field.MaxLength))
+		}
+		if field.Required {
+			attrs = append(attrs, "Required: true")
+		}
+		if len(attrs) > 0 {
+			fmt.Fprintf(b, "\t%q: {%s},\n", field.Name, strings.Join(attrs, ", "))
+		}
+	}
+	b.WriteString("}\n\n")
+}
+
+// choiceValues lists the values of a choice field, empty for other fields.
+func choiceValues(field *fieldMeta) string {
+	if field.Type != "choice" || len(field.Choices) < 2 {
+		return ""
+	}
+	values := make([]string, 0, len(field.Choices))
+	for _, choice := range field.Choices {
+		if len(choice) == 0 {
+			continue
+		}
+		if s, ok := choice[0].(string); ok {
+			values = append(values, fmt.Sprintf("%q", s))
+		} else {
+			values = append(values, fmt.Sprint(choice[0]))
+		}
+	}
+	return "one of " + strings.Join(values, ", ")
+}
+
+// initialisms are the name parts written in upper case.
+var initialisms = map[string]bool{"id": true, "url": true, "uuid": true}
+
+// goName converts a snake case AWX name into an exported Go name.
+func goName(name string) string {
+	parts := strings.Split(name, "_")
+	for i, part := range parts {
+		if initialisms[part] {
+			parts[i] = strings.ToUpper(part)
+		} else if part != "" {
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,40 @@
+package main
+
+// fieldTypes are the Go types of the fields the `awx` package decodes into its
+// own types, by `<type>.<field>` or by field name for every resource.
+var fieldTypes = map[string]string{
+	"related":        "*Related",
+	"summary_fields": "*Summary",
+	"extra_vars":     "ExtraVars",
+	"extra_data":     "ExtraVars",
+	"success_nodes":  "[]int",
+	"failure_nodes":  "[]int",
+	"always_nodes":   "[]int",
+
+	"NotificationTemplate.messages": "*NotificationMessages",
+	// null on the templates created before the execution environments
+	"JobTemplate.custom_virtualenv": "Nullable[string]",
+}
+
+// legacyField is a field older AWX versions return, absent from the metadata.
+type legacyField struct {
+	Name string
+	Type string
+}
+
+// legacyFields are appended to the generated types so the fields of older AWX
+// versions keep decoding.
+var legacyFields = map[string][]legacyField{
+	"Inventory": {
+		{Name: "organization_id", Type: "int"},
+		{Name: "groups_with_active_failures", Type: "int"},
+		{Name: "insights_credential", Type: "Nullable[int]"},
+	},
+	"JobTemplate": {
+		{Name: "credential", Type: "int"},
+		{Name: "vault_credential", Type: "Nullable[int]"},
+	},
+	"Project": {
+		{Name: "scm_delete_on_next_update", Type: "bool"},
+	},
+}